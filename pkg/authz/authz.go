@@ -0,0 +1,226 @@
+// Package authz проверяет доступ к RPC по политикам, объявленным для полных имён методов.
+package authz
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// Причины отказа; используются как метка метрики, поэтому набор фиксирован.
+const (
+	ReasonNoPolicy    = "no_policy"
+	ReasonRole        = "role"
+	ReasonClaim       = "claim"
+	ReasonMatch       = "match"
+	ReasonNoPrincipal = "unauthenticated"
+)
+
+// Subject — субъект, для которого проверяется доступ.
+type Subject struct {
+	ID     string
+	Roles  []string
+	Claims map[string]interface{}
+	// Peer — имя из проверенного клиентского сертификата mTLS, доступно как утверждение "peer".
+	Peer string
+}
+
+// Decision — результат проверки.
+type Decision struct {
+	Allowed bool
+	Reason  string
+}
+
+// Condition требует, чтобы поле запроса совпадало с утверждением субъекта.
+type Condition struct {
+	// Field — путь к полю запроса по именам из .proto, например "customer_id".
+	Field string `yaml:"field"`
+	// Claim — имя утверждения; "sub" означает идентификатор субъекта, "peer" — имя из сертификата mTLS.
+	Claim string `yaml:"claim"`
+}
+
+// Rule разрешает вызов, если выполнены все его условия. Пустой Roles означает любую роль.
+type Rule struct {
+	Roles  []string          `yaml:"roles"`
+	Claims map[string]string `yaml:"claims"`
+	Match  []Condition       `yaml:"match"`
+}
+
+// Policy — набор правил метода; достаточно одного выполненного правила.
+type Policy struct {
+	Method string `yaml:"method"`
+	Rules  []Rule `yaml:"rules"`
+}
+
+// Config — содержимое файла политик.
+type Config struct {
+	// Default — решение для методов без политики: "deny" (по умолчанию) или "allow".
+	Default string `yaml:"default"`
+	// Public — методы или префиксы сервисов ("/pkg.Service/"), не требующие проверки.
+	Public   []string `yaml:"public"`
+	Policies []Policy `yaml:"policies"`
+}
+
+// Engine вычисляет решения по загруженным политикам.
+type Engine struct {
+	allowByDefault bool
+	public         []string
+	policies       map[string]Policy
+}
+
+// Load читает политики из YAML-файла.
+func Load(path string) (*Engine, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("authz: read policies: %w", err)
+	}
+	return Parse(raw)
+}
+
+// Parse разбирает политики в формате YAML.
+func Parse(raw []byte) (*Engine, error) {
+	var cfg Config
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("authz: decode policies: %w", err)
+	}
+	return NewEngine(cfg)
+}
+
+// NewEngine проверяет конфигурацию и создаёт движок.
+func NewEngine(cfg Config) (*Engine, error) {
+	engine := &Engine{public: cfg.Public, policies: make(map[string]Policy, len(cfg.Policies))}
+
+	switch cfg.Default {
+	case "", "deny":
+	case "allow":
+		engine.allowByDefault = true
+	default:
+		return nil, fmt.Errorf("authz: unknown default decision %q", cfg.Default)
+	}
+
+	for _, policy := range cfg.Policies {
+		if !strings.HasPrefix(policy.Method, "/") || strings.Count(policy.Method, "/") != 2 {
+			return nil, fmt.Errorf("authz: method %q must be a full gRPC method name", policy.Method)
+		}
+		if _, ok := engine.policies[policy.Method]; ok {
+			return nil, fmt.Errorf("authz: duplicate policy for %s", policy.Method)
+		}
+		if len(policy.Rules) == 0 {
+			return nil, fmt.Errorf("authz: policy for %s has no rules", policy.Method)
+		}
+		for _, rule := range policy.Rules {
+			for _, cond := range rule.Match {
+				if cond.Field == "" || cond.Claim == "" {
+					return nil, fmt.Errorf("authz: policy for %s has incomplete match condition", policy.Method)
+				}
+			}
+		}
+		engine.policies[policy.Method] = policy
+	}
+	return engine, nil
+}
+
+// Public сообщает, что метод вызывается без проверки доступа.
+func (e *Engine) Public(method string) bool {
+	for _, allowed := range e.public {
+		if method == allowed || (strings.HasSuffix(allowed, "/") && strings.HasPrefix(method, allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+// NeedsRequest сообщает, что правила метода сверяют поля запроса. Для потоковых вызовов
+// такие методы проверяются по первому сообщению.
+func (e *Engine) NeedsRequest(method string) bool {
+	for _, rule := range e.policies[method].Rules {
+		if len(rule.Match) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize проверяет вызов метода субъектом. req может быть nil, если поля запроса
+// ещё недоступны; тогда правила с Match не выполняются.
+func (e *Engine) Authorize(method string, subject Subject, req proto.Message) Decision {
+	policy, ok := e.policies[method]
+	if !ok {
+		if e.allowByDefault {
+			return Decision{Allowed: true}
+		}
+		return Decision{Reason: ReasonNoPolicy}
+	}
+
+	// Если не выполнилось ни одно правило, возвращается причина «самого дальнего» отказа:
+	// так в логах видно, что роль подошла, но не совпал, например, идентификатор клиента.
+	reason := ReasonRole
+	for _, rule := range policy.Rules {
+		switch failed := rule.evaluate(subject, req); failed {
+		case "":
+			return Decision{Allowed: true}
+		case ReasonMatch:
+			reason = ReasonMatch
+		case ReasonClaim:
+			if reason == ReasonRole {
+				reason = ReasonClaim
+			}
+		}
+	}
+	return Decision{Reason: reason}
+}
+
+func (r Rule) evaluate(subject Subject, req proto.Message) string {
+	if len(r.Roles) > 0 && !hasAnyRole(subject.Roles, r.Roles) {
+		return ReasonRole
+	}
+	for claim, want := range r.Claims {
+		if got, ok := subject.claim(claim); !ok || got != want {
+			return ReasonClaim
+		}
+	}
+	for _, cond := range r.Match {
+		if req == nil {
+			return ReasonMatch
+		}
+		got, ok := subject.claim(cond.Claim)
+		if !ok || got == "" {
+			return ReasonMatch
+		}
+		if value, ok := fieldValue(req, cond.Field); !ok || value != got {
+			return ReasonMatch
+		}
+	}
+	return ""
+}
+
+func (s Subject) claim(name string) (string, bool) {
+	switch name {
+	case "sub":
+		return s.ID, s.ID != ""
+	case "peer":
+		return s.Peer, s.Peer != ""
+	}
+	switch v := s.Claims[name].(type) {
+	case string:
+		return v, true
+	case float64, int, int64, bool:
+		return fmt.Sprint(v), true
+	default:
+		return "", false
+	}
+}
+
+func hasAnyRole(have, want []string) bool {
+	for _, w := range want {
+		for _, h := range have {
+			if h == w {
+				return true
+			}
+		}
+	}
+	return false
+}
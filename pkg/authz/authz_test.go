@@ -0,0 +1,97 @@
+package authz
+
+import (
+	"testing"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const testPolicies = `
+default: deny
+public:
+  - /grpc.health.v1.Health/
+policies:
+  - method: /holo.customer.v1.CustomerService/GetCustomer
+    rules:
+      - roles: [front_desk, admin]
+      - roles: [customer]
+        match:
+          - field: service
+            claim: sub
+  - method: /holo.customer.v1.CustomerService/EraseCustomer
+    rules:
+      - roles: [admin]
+        claims:
+          mfa: "true"
+  - method: /holo.customer.v1.CustomerService/ImportCustomers
+    rules:
+      - roles: [service]
+        claims:
+          peer: spiffe://holo/crm-sync
+`
+
+func TestEngineAuthorize(t *testing.T) {
+	engine, err := Parse([]byte(testPolicies))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	get := "/holo.customer.v1.CustomerService/GetCustomer"
+	erase := "/holo.customer.v1.CustomerService/EraseCustomer"
+	importRows := "/holo.customer.v1.CustomerService/ImportCustomers"
+	own := &healthpb.HealthCheckRequest{Service: "c-1"}
+	foreign := &healthpb.HealthCheckRequest{Service: "c-2"}
+
+	cases := []struct {
+		name    string
+		method  string
+		subject Subject
+		req     *healthpb.HealthCheckRequest
+		allowed bool
+		reason  string
+	}{
+		{"staff reads any profile", get, Subject{ID: "s-1", Roles: []string{"front_desk"}}, foreign, true, ""},
+		{"customer reads own profile", get, Subject{ID: "c-1", Roles: []string{"customer"}}, own, true, ""},
+		{"customer reads foreign profile", get, Subject{ID: "c-1", Roles: []string{"customer"}}, foreign, false, ReasonMatch},
+		{"customer without request", get, Subject{ID: "c-1", Roles: []string{"customer"}}, nil, false, ReasonMatch},
+		{"trainer has no rule", get, Subject{ID: "t-1", Roles: []string{"trainer"}}, own, false, ReasonRole},
+		{"admin with mfa erases", erase, Subject{ID: "a-1", Roles: []string{"admin"}, Claims: map[string]interface{}{"mfa": true}}, nil, true, ""},
+		{"admin without mfa", erase, Subject{ID: "a-1", Roles: []string{"admin"}}, nil, false, ReasonClaim},
+		{"sync service over mtls imports", importRows, Subject{ID: "svc", Roles: []string{"service"}, Peer: "spiffe://holo/crm-sync"}, nil, true, ""},
+		{"service token without certificate", importRows, Subject{ID: "svc", Roles: []string{"service"}}, nil, false, ReasonClaim},
+		{"method without policy", "/holo.customer.v1.CustomerService/Unknown", Subject{ID: "a-1", Roles: []string{"admin"}}, nil, false, ReasonNoPolicy},
+	}
+	for _, tc := range cases {
+		var decision Decision
+		if tc.req == nil {
+			decision = engine.Authorize(tc.method, tc.subject, nil)
+		} else {
+			decision = engine.Authorize(tc.method, tc.subject, tc.req)
+		}
+		if decision.Allowed != tc.allowed || decision.Reason != tc.reason {
+			t.Fatalf("%s: unexpected decision %+v", tc.name, decision)
+		}
+	}
+
+	if !engine.Public("/grpc.health.v1.Health/Check") || engine.Public(get) {
+		t.Fatalf("unexpected public methods")
+	}
+	if !engine.NeedsRequest(get) || engine.NeedsRequest(erase) {
+		t.Fatalf("unexpected NeedsRequest result")
+	}
+}
+
+func TestParseRejectsInvalidPolicies(t *testing.T) {
+	invalid := map[string]string{
+		"default":   "default: maybe",
+		"method":    "policies:\n  - method: GetCustomer\n    rules: [{roles: [admin]}]",
+		"no rules":  "policies:\n  - method: /svc.S/M\n",
+		"duplicate": "policies:\n  - method: /svc.S/M\n    rules: [{roles: [admin]}]\n  - method: /svc.S/M\n    rules: [{roles: [admin]}]",
+		"match":     "policies:\n  - method: /svc.S/M\n    rules: [{match: [{field: id}]}]",
+	}
+	for name, raw := range invalid {
+		if _, err := Parse([]byte(raw)); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
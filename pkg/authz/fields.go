@@ -0,0 +1,57 @@
+package authz
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldValue возвращает скалярное поле запроса по пути через точку. Обёртки
+// google.protobuf.*Value разворачиваются, незаданные поля считаются отсутствующими.
+func fieldValue(msg proto.Message, path string) (string, bool) {
+	current := msg.ProtoReflect()
+	parts := strings.Split(path, ".")
+
+	for i, name := range parts {
+		fd := current.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.IsList() || fd.IsMap() || !current.Has(fd) {
+			return "", false
+		}
+		value := current.Get(fd)
+
+		if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+			current = value.Message()
+			if i == len(parts)-1 {
+				return unwrap(current)
+			}
+			continue
+		}
+		if i != len(parts)-1 {
+			return "", false
+		}
+		return scalar(value, fd), true
+	}
+	return "", false
+}
+
+func unwrap(msg protoreflect.Message) (string, bool) {
+	if !strings.HasPrefix(string(msg.Descriptor().FullName()), "google.protobuf.") {
+		return "", false
+	}
+	fd := msg.Descriptor().Fields().ByName("value")
+	if fd == nil {
+		return "", false
+	}
+	return scalar(msg.Get(fd), fd), true
+}
+
+func scalar(value protoreflect.Value, fd protoreflect.FieldDescriptor) string {
+	if fd.Kind() == protoreflect.EnumKind {
+		if ev := fd.Enum().Values().ByNumber(value.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+	}
+	return fmt.Sprint(value.Interface())
+}
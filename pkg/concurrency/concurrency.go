@@ -0,0 +1,244 @@
+// Package concurrency ограничивает число одновременно обрабатываемых запросов адаптивным
+// лимитом в духе Gradient/Vegas: лимит растёт, пока задержка держится у базовой, и
+// снижается, когда запросы начинают ждать в очередях (например, пула соединений Postgres).
+package concurrency
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Priority — класс важности метода. При перегрузке первыми отклоняются запросы
+// с наименьшей долей лимита.
+type Priority string
+
+const (
+	// Critical может занять весь лимит: пробы здоровья, путь отметки посетителя.
+	Critical Priority = "critical"
+	// Normal — класс по умолчанию.
+	Normal Priority = "normal"
+	// Sheddable — отчёты, выгрузки и импорт; отклоняются первыми.
+	Sheddable Priority = "sheddable"
+)
+
+// share — доля лимита, доступная классу.
+func (p Priority) share() float64 {
+	switch p {
+	case Critical:
+		return 1
+	case Sheddable:
+		return 0.5
+	default:
+		return 0.8
+	}
+}
+
+// Class назначает приоритет методу ("/pkg.Service/Method") или сервису ("/pkg.Service/").
+type Class struct {
+	Method   string   `mapstructure:"method"`
+	Priority Priority `mapstructure:"priority"`
+}
+
+// Config — параметры лимитера, загружаются через pkg/config.
+type Config struct {
+	InitialLimit int `mapstructure:"initial_limit"`
+	MinLimit     int `mapstructure:"min_limit"`
+	MaxLimit     int `mapstructure:"max_limit"`
+	// Smoothing — вес нового значения при пересчёте лимита, от 0 до 1.
+	Smoothing float64 `mapstructure:"smoothing"`
+	// Tolerance — во сколько раз текущая задержка может превысить базовую без снижения лимита.
+	Tolerance float64 `mapstructure:"tolerance"`
+	// WindowSize — число завершённых запросов между пересчётами лимита.
+	WindowSize int `mapstructure:"window_size"`
+	// LongWindow — число окон, за которое усредняется базовая задержка.
+	LongWindow int     `mapstructure:"long_window"`
+	Classes    []Class `mapstructure:"classes"`
+}
+
+func (c *Config) defaults() {
+	if c.MinLimit <= 0 {
+		c.MinLimit = 1
+	}
+	if c.MaxLimit <= 0 {
+		c.MaxLimit = 1000
+	}
+	if c.InitialLimit <= 0 {
+		c.InitialLimit = c.MinLimit
+	}
+	if c.Smoothing <= 0 || c.Smoothing > 1 {
+		c.Smoothing = 0.2
+	}
+	if c.Tolerance < 1 {
+		c.Tolerance = 1.5
+	}
+	if c.WindowSize <= 0 {
+		c.WindowSize = 20
+	}
+	if c.LongWindow <= 0 {
+		c.LongWindow = 100
+	}
+}
+
+// PriorityFor возвращает класс метода: точное совпадение важнее префикса сервиса.
+func (c Config) PriorityFor(method string) Priority {
+	best, bestLen := Normal, 0
+	for _, class := range c.Classes {
+		switch {
+		case class.Method == method:
+			return class.Priority
+		case strings.HasSuffix(class.Method, "/") && strings.HasPrefix(method, class.Method) && len(class.Method) > bestLen:
+			best, bestLen = class.Priority, len(class.Method)
+		}
+	}
+	return best
+}
+
+// Limiter выдаёт разрешения на обработку и пересчитывает лимит по задержкам.
+type Limiter struct {
+	cfg Config
+	now func() time.Time
+
+	mu       sync.Mutex
+	limit    float64
+	inFlight int
+
+	// Текущее окно: сумма задержек, число образцов, пик одновременных запросов и был ли сброс.
+	windowRTT   time.Duration
+	windowCount int
+	windowPeak  int
+	windowDrop  bool
+	longRTT     float64
+}
+
+// NewLimiter создаёт лимитер с начальным лимитом InitialLimit.
+func NewLimiter(cfg Config) *Limiter {
+	cfg.defaults()
+	return &Limiter{cfg: cfg, now: time.Now, limit: float64(cfg.InitialLimit)}
+}
+
+// WithClock переопределяет источник времени (для тестов).
+func (l *Limiter) WithClock(now func() time.Time) *Limiter {
+	l.now = now
+	return l
+}
+
+// Config возвращает конфигурацию с заполненными значениями по умолчанию.
+func (l *Limiter) Config() Config {
+	return l.cfg
+}
+
+// Acquire занимает место для запроса класса p. При отказе запрос нужно отклонить;
+// при успехе release вызывается по завершении, dropped означает сбой из-за перегрузки.
+func (l *Limiter) Acquire(p Priority) (release func(dropped bool), ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.admit(p) {
+		return nil, false
+	}
+
+	started := l.now()
+	var once sync.Once
+	return func(dropped bool) {
+		once.Do(func() { l.release(l.now().Sub(started), dropped) })
+	}, true
+}
+
+// AcquireStream занимает место для потокового вызова класса p на всё время потока.
+// Длительность потока задаёт клиент, а не нагрузка, поэтому она не попадает в окно
+// задержек и не влияет на пересчёт лимита.
+func (l *Limiter) AcquireStream(p Priority) (release func(), ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.admit(p) {
+		return nil, false
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			l.inFlight--
+			l.mu.Unlock()
+		})
+	}, true
+}
+
+// admit вызывается под l.mu.
+func (l *Limiter) admit(p Priority) bool {
+	if float64(l.inFlight) >= math.Max(1, math.Floor(l.limit*p.share())) {
+		return false
+	}
+	l.inFlight++
+	if l.inFlight > l.windowPeak {
+		l.windowPeak = l.inFlight
+	}
+	return true
+}
+
+// Limit возвращает текущий лимит.
+func (l *Limiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// InFlight возвращает число обрабатываемых запросов.
+func (l *Limiter) InFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inFlight
+}
+
+func (l *Limiter) release(rtt time.Duration, dropped bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+	l.windowRTT += rtt
+	l.windowCount++
+	l.windowDrop = l.windowDrop || dropped
+	if l.windowCount >= l.cfg.WindowSize {
+		l.update()
+	}
+}
+
+// update пересчитывает лимит по итогам окна:
+//
+//	gradient = clamp(Tolerance * longRTT / shortRTT, 0.5, 1)
+//	newLimit = limit * gradient + sqrt(limit)
+//
+// Слагаемое sqrt(limit) даёт запас на рост, а gradient < 1 сжимает лимит, когда
+// задержка уходит от базовой.
+func (l *Limiter) update() {
+	shortRTT := float64(l.windowRTT) / float64(l.windowCount)
+	peak, dropped := l.windowPeak, l.windowDrop
+	l.windowRTT, l.windowCount, l.windowPeak, l.windowDrop = 0, 0, l.inFlight, false
+
+	if l.longRTT == 0 {
+		l.longRTT = shortRTT
+	} else {
+		alpha := 2 / float64(l.cfg.LongWindow+1)
+		l.longRTT = l.longRTT*(1-alpha) + shortRTT*alpha
+		// После долгой перегрузки базовая задержка завышена; подтягиваем её быстрее.
+		if l.longRTT/shortRTT > 2 {
+			l.longRTT = l.longRTT*0.9 + shortRTT*0.1
+		}
+	}
+
+	gradient := 0.5
+	if !dropped && shortRTT > 0 {
+		gradient = math.Max(0.5, math.Min(1, l.cfg.Tolerance*l.longRTT/shortRTT))
+	}
+	// Лимит не растёт, если нагрузка его и не достигала.
+	if gradient == 1 && float64(peak) < l.limit/2 {
+		return
+	}
+
+	next := l.limit*gradient + math.Sqrt(l.limit)
+	next = l.limit*(1-l.cfg.Smoothing) + next*l.cfg.Smoothing
+	l.limit = math.Max(float64(l.cfg.MinLimit), math.Min(float64(l.cfg.MaxLimit), next))
+}
@@ -0,0 +1,128 @@
+package concurrency
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiterAdaptsToLatency(t *testing.T) {
+	now := time.Date(2026, 6, 1, 7, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(Config{InitialLimit: 10, MinLimit: 2, MaxLimit: 100, WindowSize: 5}).
+		WithClock(func() time.Time { return now })
+
+	// runWindow выполняет пачку одновременных запросов с задержкой latency.
+	runWindow := func(latency time.Duration) {
+		var releases []func(bool)
+		for i := 0; i < 8; i++ {
+			if release, ok := limiter.Acquire(Critical); ok {
+				releases = append(releases, release)
+			}
+		}
+		now = now.Add(latency)
+		for _, release := range releases {
+			release(false)
+		}
+	}
+
+	for i := 0; i < 20; i++ {
+		runWindow(10 * time.Millisecond)
+	}
+	grown := limiter.Limit()
+	if grown <= 10 {
+		t.Fatalf("expected limit to grow under stable latency, got %d", grown)
+	}
+
+	for i := 0; i < 20; i++ {
+		runWindow(100 * time.Millisecond)
+	}
+	if got := limiter.Limit(); got >= grown {
+		t.Fatalf("expected limit to shrink when latency grows, got %d (was %d)", got, grown)
+	}
+	if limiter.InFlight() != 0 {
+		t.Fatalf("expected all requests released, got %d in flight", limiter.InFlight())
+	}
+}
+
+func TestLimiterIgnoresStreamDuration(t *testing.T) {
+	now := time.Date(2026, 6, 1, 7, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(Config{InitialLimit: 10, MinLimit: 2, MaxLimit: 100, WindowSize: 5}).
+		WithClock(func() time.Time { return now })
+
+	unary := func(n int) {
+		for i := 0; i < n; i++ {
+			release, ok := limiter.Acquire(Normal)
+			if !ok {
+				t.Fatalf("unary call rejected")
+			}
+			now = now.Add(10 * time.Millisecond)
+			release(false)
+		}
+	}
+
+	closeStream, ok := limiter.AcquireStream(Normal)
+	if !ok {
+		t.Fatalf("stream rejected")
+	}
+	if limiter.InFlight() != 1 {
+		t.Fatalf("expected an open stream to hold a slot, got %d in flight", limiter.InFlight())
+	}
+	unary(20)
+	before := limiter.Limit()
+
+	// Поток открыт час; если бы его длительность попала в окно, лимит упал бы вдвое.
+	now = now.Add(time.Hour)
+	closeStream()
+	closeStream()
+	unary(20)
+
+	if got := limiter.Limit(); got != before {
+		t.Fatalf("expected stream duration to leave the limit at %d, got %d", before, got)
+	}
+	if limiter.InFlight() != 0 {
+		t.Fatalf("expected all calls released, got %d in flight", limiter.InFlight())
+	}
+}
+
+func TestLimiterShedsLowPriorityFirst(t *testing.T) {
+	limiter := NewLimiter(Config{InitialLimit: 10, MinLimit: 10, MaxLimit: 10})
+
+	admitted := func(p Priority) int {
+		n := 0
+		for {
+			if _, ok := limiter.Acquire(p); !ok {
+				return n
+			}
+			n++
+		}
+	}
+
+	if got := admitted(Sheddable); got != 5 {
+		t.Fatalf("expected sheddable to stop at half the limit, got %d", got)
+	}
+	if got := admitted(Normal); got != 3 {
+		t.Fatalf("expected normal to stop at 80%% of the limit, got %d", got)
+	}
+	if got := admitted(Critical); got != 2 {
+		t.Fatalf("expected critical to use the rest of the limit, got %d", got)
+	}
+}
+
+func TestConfigPriorityFor(t *testing.T) {
+	cfg := Config{Classes: []Class{
+		{Method: "/grpc.health.v1.Health/", Priority: Critical},
+		{Method: "/holo.customer.v1.CustomerService/", Priority: Normal},
+		{Method: "/holo.customer.v1.CustomerService/ListCustomers", Priority: Sheddable},
+	}}
+
+	cases := map[string]Priority{
+		"/grpc.health.v1.Health/Check":                    Critical,
+		"/holo.customer.v1.CustomerService/ListCustomers": Sheddable,
+		"/holo.customer.v1.CustomerService/GetCustomer":   Normal,
+		"/holo.other.v1.Other/Call":                       Normal,
+	}
+	for method, want := range cases {
+		if got := cfg.PriorityFor(method); got != want {
+			t.Fatalf("%s: expected %s, got %s", method, want, got)
+		}
+	}
+}
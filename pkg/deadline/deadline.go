@@ -0,0 +1,58 @@
+// Package deadline отслеживает, на каком этапе запроса истёк его дедлайн.
+//
+// Интерцептор таймаутов кладёт Tracker в контекст запроса, а адаптеры хранилищ и брокеров
+// вызывают Observe с названием этапа, когда их операция завершилась ошибкой.
+package deadline
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// StageHandler — этап по умолчанию, если ни один адаптер не сообщил об истечении дедлайна.
+const StageHandler = "handler"
+
+type trackerKey struct{}
+
+// Tracker запоминает первый этап, на котором истёк дедлайн.
+type Tracker struct {
+	mu    sync.Mutex
+	stage string
+}
+
+// WithTracker добавляет в контекст новый Tracker.
+func WithTracker(ctx context.Context) (context.Context, *Tracker) {
+	t := &Tracker{}
+	return context.WithValue(ctx, trackerKey{}, t), t
+}
+
+// Stage возвращает этап, исчерпавший дедлайн, или StageHandler.
+func (t *Tracker) Stage() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stage == "" {
+		return StageHandler
+	}
+	return t.stage
+}
+
+// Observe отмечает stage в трекере запроса, если err вызвана истечением дедлайна ctx.
+// Без трекера в контексте (фоновые задачи) вызов ничего не делает.
+func Observe(ctx context.Context, stage string, err error) {
+	if err == nil {
+		return
+	}
+	if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return
+	}
+	t, ok := ctx.Value(trackerKey{}).(*Tracker)
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stage == "" {
+		t.stage = stage
+	}
+}
@@ -0,0 +1,39 @@
+package deadline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestObserveRecordsFirstExpiredStage(t *testing.T) {
+	ctx, tracker := WithTracker(context.Background())
+	if got := tracker.Stage(); got != StageHandler {
+		t.Fatalf("expected default stage %q, got %q", StageHandler, got)
+	}
+
+	Observe(ctx, "postgres", errors.New("unique violation"))
+	if got := tracker.Stage(); got != StageHandler {
+		t.Fatalf("unrelated errors must be ignored, got %q", got)
+	}
+
+	Observe(ctx, "opensearch", fmt.Errorf("index: %w", context.DeadlineExceeded))
+	Observe(ctx, "kafka", context.DeadlineExceeded)
+	if got := tracker.Stage(); got != "opensearch" {
+		t.Fatalf("expected first expired stage, got %q", got)
+	}
+}
+
+func TestObserveUsesContextDeadline(t *testing.T) {
+	ctx, tracker := WithTracker(context.Background())
+	ctx, cancel := context.WithDeadline(ctx, time.Now().Add(-time.Second))
+	defer cancel()
+
+	// Драйверы не всегда оборачивают ошибку контекста, поэтому учитывается и ctx.Err().
+	Observe(ctx, "mongo", errors.New("connection closed"))
+	if got := tracker.Stage(); got != "mongo" {
+		t.Fatalf("expected mongo, got %q", got)
+	}
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BreakerConfig — параметры автоматического выключателя.
+type BreakerConfig struct {
+	Disabled bool `mapstructure:"disabled"`
+	// FailureThreshold — число сбоев подряд, после которого вызовы отклоняются без обращения к сервису.
+	FailureThreshold int `mapstructure:"failure_threshold"`
+	// OpenTimeout — пауза перед пробным вызовом.
+	OpenTimeout time.Duration `mapstructure:"open_timeout"`
+}
+
+func (c *BreakerConfig) defaults() {
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = 5
+	}
+	if c.OpenTimeout <= 0 {
+		c.OpenTimeout = 30 * time.Second
+	}
+}
+
+// State — состояние выключателя.
+type State int
+
+const (
+	StateClosed State = iota
+	StateOpen
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half_open"
+	default:
+		return "closed"
+	}
+}
+
+func (s State) gauge() float64 {
+	switch s {
+	case StateOpen:
+		return 1
+	case StateHalfOpen:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+// Breaker размыкается после серии сбоев сервиса и через OpenTimeout пропускает один
+// пробный вызов: успех замыкает его, сбой снова размыкает. Сбоем считаются только
+// Unavailable и DeadlineExceeded — ошибки бизнес-логики не говорят о недоступности.
+type Breaker struct {
+	cfg     BreakerConfig
+	now     func() time.Time
+	changed func(State)
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewBreaker создаёт замкнутый выключатель.
+func NewBreaker(cfg BreakerConfig) *Breaker {
+	cfg.defaults()
+	return &Breaker{cfg: cfg, now: time.Now, changed: func(State) {}}
+}
+
+// WithClock подменяет источник времени (для тестов).
+func (b *Breaker) WithClock(now func() time.Time) *Breaker {
+	b.now = now
+	return b
+}
+
+func (b *Breaker) onChange(fn func(State)) *Breaker {
+	b.changed = fn
+	return b
+}
+
+// State возвращает текущее состояние.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Allow сообщает, можно ли выполнить вызов. Разрешённый вызов нужно завершить через Record.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.cfg.OpenTimeout {
+			return false
+		}
+		b.setState(StateHalfOpen)
+		b.probing = true
+		return true
+	case StateHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Record учитывает результат вызова.
+func (b *Breaker) Record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !isFailure(err) {
+		b.failures = 0
+		b.probing = false
+		if b.state != StateClosed {
+			b.setState(StateClosed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.probing = false
+		b.openedAt = b.now()
+		if b.state != StateOpen {
+			b.setState(StateOpen)
+		}
+	}
+}
+
+func (b *Breaker) setState(state State) {
+	b.state = state
+	b.changed(state)
+}
+
+func isFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// UnaryClientInterceptor отклоняет вызовы с codes.Unavailable, пока выключатель разомкнут.
+func (b *Breaker) UnaryClientInterceptor(target string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !b.Allow() {
+			return status.Errorf(codes.Unavailable, "circuit breaker for %s is open", target)
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.Record(err)
+		return err
+	}
+}
+
+// StreamClientInterceptor учитывает только открытие потока.
+func (b *Breaker) StreamClientInterceptor(target string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if !b.Allow() {
+			return nil, status.Errorf(codes.Unavailable, "circuit breaker for %s is open", target)
+		}
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		b.Record(err)
+		return stream, err
+	}
+}
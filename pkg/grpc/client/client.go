@@ -0,0 +1,251 @@
+// Package client создаёт gRPC-соединения для вызовов между сервисами: балансировка,
+// повторы и дедлайны через service config, телеметрия, keepalive, mTLS и автоматический
+// выключатель.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/grpc/mtls"
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+// MethodConfig переопределяет дедлайн для метода ("/pkg.Service/Method") или сервиса ("/pkg.Service/").
+type MethodConfig struct {
+	Method  string        `mapstructure:"method"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// RetryConfig — политика повторов gRPC. Повторяются только вызовы с кодами из Codes;
+// MaxAttempts 1 отключает повторы, gRPC ограничивает число попыток пятью.
+type RetryConfig struct {
+	MaxAttempts       int           `mapstructure:"max_attempts"`
+	InitialBackoff    time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff        time.Duration `mapstructure:"max_backoff"`
+	BackoffMultiplier float64       `mapstructure:"backoff_multiplier"`
+	Codes             []string      `mapstructure:"codes"`
+}
+
+// KeepaliveConfig — проверка живости соединения. Сервер должен разрешать пинги не реже Time.
+type KeepaliveConfig struct {
+	Time                time.Duration `mapstructure:"time"`
+	Timeout             time.Duration `mapstructure:"timeout"`
+	PermitWithoutStream bool          `mapstructure:"permit_without_stream"`
+}
+
+// Config описывает соединение с одним сервисом.
+type Config struct {
+	// Target — "dns:///customer-service:50051" или "static:///10.0.0.1:50051,10.0.0.2:50051".
+	Target string `mapstructure:"target"`
+	// LoadBalancing — "round_robin" (по умолчанию) или "pick_first".
+	LoadBalancing string `mapstructure:"load_balancing"`
+	// Timeout — дедлайн вызова, если контекст не задаёт более короткий.
+	Timeout   time.Duration   `mapstructure:"timeout"`
+	Methods   []MethodConfig  `mapstructure:"methods"`
+	Retry     RetryConfig     `mapstructure:"retry"`
+	Keepalive KeepaliveConfig `mapstructure:"keepalive"`
+	Breaker   BreakerConfig   `mapstructure:"breaker"`
+	TLS       mtls.Config     `mapstructure:"tls"`
+	// ServerName — ожидаемое имя в сертификате сервера; по умолчанию хост из Target.
+	ServerName string `mapstructure:"server_name"`
+}
+
+func (c *Config) defaults() {
+	if c.LoadBalancing == "" {
+		c.LoadBalancing = "round_robin"
+	}
+	if c.Timeout == 0 {
+		c.Timeout = 5 * time.Second
+	}
+	if c.Retry.MaxAttempts == 0 {
+		c.Retry.MaxAttempts = 3
+	}
+	if c.Retry.InitialBackoff == 0 {
+		c.Retry.InitialBackoff = 100 * time.Millisecond
+	}
+	if c.Retry.MaxBackoff == 0 {
+		c.Retry.MaxBackoff = time.Second
+	}
+	if c.Retry.BackoffMultiplier == 0 {
+		c.Retry.BackoffMultiplier = 2
+	}
+	if len(c.Retry.Codes) == 0 {
+		c.Retry.Codes = []string{"UNAVAILABLE"}
+	}
+	if c.Keepalive.Time == 0 {
+		c.Keepalive.Time = time.Minute
+	}
+	if c.Keepalive.Timeout == 0 {
+		c.Keepalive.Timeout = 20 * time.Second
+	}
+	c.Breaker.defaults()
+}
+
+// Conn — соединение с сервисом; реализует grpc.ClientConnInterface для сгенерированных клиентов.
+type Conn struct {
+	*grpc.ClientConn
+	stop context.CancelFunc
+}
+
+// New открывает соединение с сервисом name. Имя используется в метриках и журналах.
+// Дополнительные opts добавляются после стандартных.
+func New(name string, cfg Config, collector *metrics.Collector, logger *zap.Logger, opts ...grpc.DialOption) (*Conn, error) {
+	if cfg.Target == "" {
+		return nil, errors.New("client: target is required")
+	}
+	cfg.defaults()
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	logger = logger.With(zap.String("target", name))
+
+	serviceConfig, err := buildServiceConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	creds := insecure.NewCredentials()
+	if cfg.TLS.Enabled {
+		reloader, err := mtls.NewReloader(cfg.TLS, logger)
+		if err != nil {
+			stop()
+			return nil, fmt.Errorf("client: %s tls: %w", name, err)
+		}
+		go reloader.Run(ctx)
+		creds = credentials.NewTLS(reloader.ClientTLSConfig(serverName(cfg)))
+	}
+
+	unary := []grpc.UnaryClientInterceptor{unaryTelemetry(name, collector)}
+	stream := []grpc.StreamClientInterceptor{streamTelemetry(name, collector)}
+	if !cfg.Breaker.Disabled {
+		breaker := NewBreaker(cfg.Breaker).onChange(func(state State) {
+			logger.Warn("circuit breaker state changed", zap.String("state", state.String()))
+			if collector != nil {
+				collector.TrackBreakerState(name, state.gauge())
+			}
+		})
+		unary = append(unary, breaker.UnaryClientInterceptor(name))
+		stream = append(stream, breaker.StreamClientInterceptor(name))
+	}
+
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                cfg.Keepalive.Time,
+			Timeout:             cfg.Keepalive.Timeout,
+			PermitWithoutStream: cfg.Keepalive.PermitWithoutStream,
+		}),
+		grpc.WithChainUnaryInterceptor(unary...),
+		grpc.WithChainStreamInterceptor(stream...),
+	}, opts...)
+
+	conn, err := grpc.NewClient(cfg.Target, dialOpts...)
+	if err != nil {
+		stop()
+		return nil, fmt.Errorf("client: dial %s: %w", name, err)
+	}
+	return &Conn{ClientConn: conn, stop: stop}, nil
+}
+
+// Close закрывает соединение и останавливает перечитывание сертификатов.
+func (c *Conn) Close() error {
+	c.stop()
+	return c.ClientConn.Close()
+}
+
+// serverName извлекает хост из цели вида "dns:///host:port".
+func serverName(cfg Config) string {
+	if cfg.ServerName != "" {
+		return cfg.ServerName
+	}
+	target := cfg.Target
+	if i := strings.LastIndex(target, "/"); i >= 0 {
+		target = target[i+1:]
+	}
+	target = strings.Split(target, ",")[0]
+	if i := strings.LastIndex(target, ":"); i >= 0 {
+		target = target[:i]
+	}
+	return target
+}
+
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig"`
+	MethodConfig        []methodConfig        `json:"methodConfig"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	Timeout     string       `json:"timeout,omitempty"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+type methodName struct {
+	Service string `json:"service,omitempty"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// buildServiceConfig собирает JSON service config. Запись для метода заменяет общую
+// целиком, поэтому политика повторов копируется в каждую.
+func buildServiceConfig(cfg Config) (string, error) {
+	var retry *retryPolicy
+	if cfg.Retry.MaxAttempts > 1 {
+		retry = &retryPolicy{
+			MaxAttempts:          cfg.Retry.MaxAttempts,
+			InitialBackoff:       durationString(cfg.Retry.InitialBackoff),
+			MaxBackoff:           durationString(cfg.Retry.MaxBackoff),
+			BackoffMultiplier:    cfg.Retry.BackoffMultiplier,
+			RetryableStatusCodes: cfg.Retry.Codes,
+		}
+	}
+
+	sc := serviceConfig{
+		LoadBalancingConfig: []map[string]struct{}{{cfg.LoadBalancing: {}}},
+		MethodConfig:        []methodConfig{{Name: []methodName{{}}, Timeout: durationString(cfg.Timeout), RetryPolicy: retry}},
+	}
+	for _, m := range cfg.Methods {
+		service, method, ok := strings.Cut(strings.TrimPrefix(m.Method, "/"), "/")
+		if !ok || service == "" {
+			return "", fmt.Errorf("client: invalid method %q", m.Method)
+		}
+		sc.MethodConfig = append(sc.MethodConfig, methodConfig{
+			Name:        []methodName{{Service: service, Method: method}},
+			Timeout:     durationString(m.Timeout),
+			RetryPolicy: retry,
+		})
+	}
+
+	raw, err := json.Marshal(sc)
+	if err != nil {
+		return "", fmt.Errorf("client: service config: %w", err)
+	}
+	return string(raw), nil
+}
+
+func durationString(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
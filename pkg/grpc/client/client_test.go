@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"github.com/evgeniySeleznev/nwHS/pkg/tracing"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testServer — health-сервер на bufconn; behave вызывается перед каждым обработчиком.
+type testServer struct {
+	listener *bufconn.Listener
+	calls    atomic.Int32
+
+	mu     sync.Mutex
+	dialed map[string]bool
+	behave func(ctx context.Context, call int32) error
+}
+
+func newTestServer(t *testing.T, behave func(ctx context.Context, call int32) error) *testServer {
+	t.Helper()
+	ts := &testServer{listener: bufconn.Listen(1 << 20), dialed: map[string]bool{}, behave: behave}
+	srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		call := ts.calls.Add(1)
+		if ts.behave != nil {
+			if err := ts.behave(ctx, call); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}))
+	healthpb.RegisterHealthServer(srv, grpchealth.NewServer())
+	go func() { _ = srv.Serve(ts.listener) }()
+	t.Cleanup(srv.Stop)
+	return ts
+}
+
+// dialer направляет все адреса цели в один bufconn и запоминает, какие адреса набирались.
+func (ts *testServer) dialer() grpc.DialOption {
+	return grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		ts.mu.Lock()
+		ts.dialed[addr] = true
+		ts.mu.Unlock()
+		return ts.listener.DialContext(ctx)
+	})
+}
+
+func (ts *testServer) connect(t *testing.T, cfg Config, collector *metrics.Collector) healthpb.HealthClient {
+	t.Helper()
+	if cfg.Target == "" {
+		cfg.Target = "static:///replica-a:50051,replica-b:50051"
+	}
+	conn, err := New("customer", cfg, collector, nil, ts.dialer())
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return healthpb.NewHealthClient(conn)
+}
+
+func TestClientBalancesOverStaticTargets(t *testing.T) {
+	ts := newTestServer(t, nil)
+	client := ts.connect(t, Config{}, nil)
+
+	for i := 0; i < 4; i++ {
+		if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+	}
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if !ts.dialed["replica-a:50051"] || !ts.dialed["replica-b:50051"] {
+		t.Fatalf("expected round_robin to connect every replica, dialed %v", ts.dialed)
+	}
+}
+
+func TestClientRetriesUnavailable(t *testing.T) {
+	ts := newTestServer(t, func(_ context.Context, call int32) error {
+		if call <= 2 {
+			return status.Error(codes.Unavailable, "warming up")
+		}
+		return nil
+	})
+	client := ts.connect(t, Config{Retry: RetryConfig{MaxAttempts: 3, InitialBackoff: time.Millisecond}}, nil)
+
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("expected retries to succeed, got %v", err)
+	}
+	if got := ts.calls.Load(); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestClientAppliesMethodDeadlines(t *testing.T) {
+	slow := func(ctx context.Context, _ int32) error {
+		select {
+		case <-time.After(100 * time.Millisecond):
+			return nil
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	short := newTestServer(t, slow).connect(t, Config{Timeout: 20 * time.Millisecond, Retry: RetryConfig{MaxAttempts: 1}}, nil)
+	if _, err := short.Check(context.Background(), &healthpb.HealthCheckRequest{}); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+
+	overridden := newTestServer(t, slow).connect(t, Config{
+		Timeout: 20 * time.Millisecond,
+		Methods: []MethodConfig{{Method: "/grpc.health.v1.Health/Check", Timeout: time.Second}},
+	}, nil)
+	if _, err := overridden.Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("expected method timeout override, got %v", err)
+	}
+}
+
+func TestClientBreakerStopsCallingFailingService(t *testing.T) {
+	ts := newTestServer(t, func(context.Context, int32) error {
+		return status.Error(codes.Unavailable, "down")
+	})
+	client := ts.connect(t, Config{
+		Retry:   RetryConfig{MaxAttempts: 1},
+		Breaker: BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute},
+	}, nil)
+
+	for i := 0; i < 3; i++ {
+		_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		if status.Code(err) != codes.Unavailable {
+			t.Fatalf("call %d: expected Unavailable, got %v", i, err)
+		}
+		if i == 2 && !strings.Contains(err.Error(), "circuit breaker") {
+			t.Fatalf("expected open breaker, got %v", err)
+		}
+	}
+	if got := ts.calls.Load(); got != 2 {
+		t.Fatalf("expected open breaker to skip the server, got %d calls", got)
+	}
+}
+
+func TestBreakerHalfOpenProbe(t *testing.T) {
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	breaker := NewBreaker(BreakerConfig{FailureThreshold: 1, OpenTimeout: time.Second}).WithClock(func() time.Time { return now })
+	unavailable := status.Error(codes.Unavailable, "down")
+
+	breaker.Record(unavailable)
+	if breaker.Allow() {
+		t.Fatalf("open breaker must reject calls")
+	}
+
+	now = now.Add(time.Second)
+	if !breaker.Allow() || breaker.State() != StateHalfOpen {
+		t.Fatalf("expected a half-open probe")
+	}
+	if breaker.Allow() {
+		t.Fatalf("only one probe may run at a time")
+	}
+	breaker.Record(status.Error(codes.NotFound, "no such customer"))
+	if breaker.State() != StateClosed || !breaker.Allow() {
+		t.Fatalf("business errors must close the breaker")
+	}
+}
+
+func TestClientPropagatesTraceAndRecordsMetrics(t *testing.T) {
+	provider := sdktrace.NewTracerProvider()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	var serverTrace trace.TraceID
+	ts := newTestServer(t, func(ctx context.Context, _ int32) error {
+		md, _ := metadata.FromIncomingContext(ctx)
+		serverTrace = trace.SpanContextFromContext(tracing.Propagator.Extract(ctx, tracing.MetadataCarrier(md))).TraceID()
+		return nil
+	})
+	registry := prometheus.NewRegistry()
+	client := ts.connect(t, Config{}, metrics.NewCollector(metrics.WithRegistry(registry)))
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "booking")
+	if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("call: %v", err)
+	}
+	parent.End()
+
+	if serverTrace != parent.SpanContext().TraceID() {
+		t.Fatalf("expected server to continue trace %s, got %s", parent.SpanContext().TraceID(), serverTrace)
+	}
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	if len(families) != 1 || families[0].GetName() != "holo_client_request_latency_seconds" {
+		t.Fatalf("expected client latency metric, got %v", families)
+	}
+}
+
+func TestServerName(t *testing.T) {
+	cases := map[string]string{
+		"dns:///customer-service.prod:50051":      "customer-service.prod",
+		"static:///10.0.0.1:50051,10.0.0.2:50051": "10.0.0.1",
+	}
+	for target, want := range cases {
+		if got := serverName(Config{Target: target}); got != want {
+			t.Fatalf("%s: expected %q, got %q", target, want, got)
+		}
+	}
+}
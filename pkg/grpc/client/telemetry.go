@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"github.com/evgeniySeleznev/nwHS/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// unaryTelemetry открывает клиентский спан, передаёт контекст трассировки в метаданных
+// и записывает latency в holo_client_request_latency_seconds.
+func unaryTelemetry(target string, collector *metrics.Collector) grpc.UnaryClientInterceptor {
+	tracer := otel.Tracer("grpc-client")
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		started := time.Now()
+		ctx, span := startSpan(ctx, tracer, target, method)
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		finish(span, collector, target, method, started, err)
+		return err
+	}
+}
+
+// streamTelemetry — вариант unaryTelemetry для потоков; спан закрывается, когда RecvMsg
+// вернёт ошибку или io.EOF.
+func streamTelemetry(target string, collector *metrics.Collector) grpc.StreamClientInterceptor {
+	tracer := otel.Tracer("grpc-client")
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		started := time.Now()
+		ctx, span := startSpan(ctx, tracer, target, method)
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			finish(span, collector, target, method, started, err)
+			return nil, err
+		}
+		return &telemetryStream{ClientStream: stream, done: func(err error) {
+			finish(span, collector, target, method, started, err)
+		}}, nil
+	}
+}
+
+func startSpan(ctx context.Context, tracer trace.Tracer, target, method string) (context.Context, trace.Span) {
+	ctx, span := tracer.Start(ctx, method, trace.WithSpanKind(trace.SpanKindClient))
+	span.SetAttributes(
+		semconv.RPCSystemKey.String("grpc"),
+		semconv.RPCServiceKey.String(target),
+		semconv.RPCMethodKey.String(method),
+	)
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	tracing.Propagator.Inject(ctx, tracing.MetadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+func finish(span trace.Span, collector *metrics.Collector, target, method string, started time.Time, err error) {
+	code := status.Code(err)
+	span.SetAttributes(attribute.String("rpc.status_code", code.String()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	} else {
+		span.SetStatus(otelcodes.Ok, "")
+	}
+	span.End()
+
+	if collector != nil {
+		collector.TrackClientDuration(target, method, code.String(), started)
+	}
+}
+
+type telemetryStream struct {
+	grpc.ClientStream
+	once sync.Once
+	done func(error)
+}
+
+func (s *telemetryStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		result := err
+		if errors.Is(err, io.EOF) {
+			result = nil
+		}
+		s.once.Do(func() { s.done(result) })
+	}
+	return err
+}
@@ -0,0 +1,211 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultPublicMethods — служебные сервисы, доступные без токена: проверки здоровья
+// опрашивает kubelet, reflection нужен grpcurl и отладочным клиентам.
+var DefaultPublicMethods = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+// AuthConfig настраивает проверку bearer-токенов.
+type AuthConfig struct {
+	Keys     KeyProvider
+	Issuer   string
+	Audience string
+	// Leeway — допустимое расхождение часов при проверке exp/nbf/iat.
+	Leeway time.Duration
+	// PublicMethods — полные имена методов ("/pkg.Service/Method") или префиксы
+	// сервисов ("/pkg.Service/"), которые вызываются без аутентификации.
+	PublicMethods []string
+	// RolesClaim и TenantClaim — имена утверждений с ролями и арендатором.
+	RolesClaim  string
+	TenantClaim string
+}
+
+// Principal — проверенный субъект запроса.
+type Principal struct {
+	Subject  string
+	Roles    []string
+	TenantID string
+	Claims   map[string]interface{}
+}
+
+// HasRole сообщает, есть ли у субъекта роль.
+func (p Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+// WithPrincipal кладёт субъекта в контекст.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext возвращает субъекта, проверенного интерцептором аутентификации.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// UnaryAuthInterceptor проверяет JWT из метаданных authorization и кладёт субъекта в контекст.
+func UnaryAuthInterceptor(cfg AuthConfig, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	auth := newAuthenticator(cfg, logger)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := auth.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor — вариант UnaryAuthInterceptor для потоковых вызовов.
+func StreamAuthInterceptor(cfg AuthConfig, logger *zap.Logger) grpc.StreamServerInterceptor {
+	auth := newAuthenticator(cfg, logger)
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := auth.authenticate(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+type authenticator struct {
+	cfg    AuthConfig
+	parser *jwt.Parser
+	log    *zap.Logger
+}
+
+func newAuthenticator(cfg AuthConfig, logger *zap.Logger) *authenticator {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if cfg.RolesClaim == "" {
+		cfg.RolesClaim = "roles"
+	}
+	if cfg.TenantClaim == "" {
+		cfg.TenantClaim = "tenant_id"
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	return &authenticator{cfg: cfg, parser: jwt.NewParser(opts...), log: logger}
+}
+
+func (a *authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if a.public(method) {
+		return ctx, nil
+	}
+
+	raw, err := bearerToken(ctx)
+	if err != nil {
+		return ctx, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = a.parser.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return a.cfg.Keys.Key(ctx, kid)
+	})
+	if err != nil {
+		// Причина пишется в лог, клиенту отдаётся общий ответ.
+		a.log.Warn("authentication failed", zap.String("method", method), zap.Error(err))
+		return ctx, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	subject, _ := claims.GetSubject()
+	if subject == "" {
+		return ctx, status.Error(codes.Unauthenticated, "token has no subject")
+	}
+	tenantID, _ := claims[a.cfg.TenantClaim].(string)
+
+	return WithPrincipal(ctx, Principal{
+		Subject:  subject,
+		Roles:    stringList(claims[a.cfg.RolesClaim]),
+		TenantID: tenantID,
+		Claims:   claims,
+	}), nil
+}
+
+func (a *authenticator) public(method string) bool {
+	for _, allowed := range a.cfg.PublicMethods {
+		if method == allowed || (strings.HasSuffix(allowed, "/") && strings.HasPrefix(method, allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", fmt.Errorf("missing bearer token")
+	}
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || strings.TrimSpace(token) == "" {
+		return "", fmt.Errorf("malformed authorization header")
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// stringList принимает роли как массив строк или как строку через пробел (формат scope).
+func stringList(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// contextStream подменяет контекст серверного потока.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,221 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func rsaJWK(t *testing.T, kid string, key *rsa.PrivateKey) map[string]string {
+	t.Helper()
+	return map[string]string{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func writeJWKS(t *testing.T, path string, keys ...map[string]string) {
+	t.Helper()
+	raw, err := json.Marshal(map[string]interface{}{"keys": keys})
+	if err != nil {
+		t.Fatalf("marshal jwks: %v", err)
+	}
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		t.Fatalf("write jwks: %v", err)
+	}
+}
+
+func signToken(t *testing.T, kid string, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":       "https://id.holo.test",
+		"aud":       "customer-service",
+		"sub":       "staff-1",
+		"exp":       now.Add(time.Hour).Unix(),
+		"iat":       now.Unix(),
+		"roles":     []string{"front_desk", "manager"},
+		"tenant_id": "11111111-1111-1111-1111-111111111111",
+	}
+}
+
+func authContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, rsaJWK(t, "k1", key))
+
+	interceptor := UnaryAuthInterceptor(AuthConfig{
+		Keys:          NewFileJWKS(path, time.Hour),
+		Issuer:        "https://id.holo.test",
+		Audience:      "customer-service",
+		PublicMethods: DefaultPublicMethods,
+	}, zap.NewNop())
+
+	var got Principal
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		got, _ = PrincipalFromContext(ctx)
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/holo.customer.v1.CustomerService/GetCustomer"}
+
+	if _, err := interceptor(authContext(signToken(t, "k1", key, validClaims())), nil, info, handler); err != nil {
+		t.Fatalf("valid token rejected: %v", err)
+	}
+	if got.Subject != "staff-1" || !got.HasRole("manager") || got.TenantID != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("unexpected principal %+v", got)
+	}
+
+	expired := validClaims()
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	wrongAudience := validClaims()
+	wrongAudience["aud"] = "billing-service"
+	wrongIssuer := validClaims()
+	wrongIssuer["iss"] = "https://evil.test"
+
+	cases := map[string]context.Context{
+		"missing":        context.Background(),
+		"malformed":      metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic abc")),
+		"expired":        authContext(signToken(t, "k1", key, expired)),
+		"wrong audience": authContext(signToken(t, "k1", key, wrongAudience)),
+		"wrong issuer":   authContext(signToken(t, "k1", key, wrongIssuer)),
+	}
+	for name, ctx := range cases {
+		if _, err := interceptor(ctx, nil, info, handler); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("%s: expected Unauthenticated, got %v", name, err)
+		}
+	}
+
+	health := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+	if _, err := interceptor(context.Background(), nil, health, handler); err != nil {
+		t.Fatalf("public method rejected: %v", err)
+	}
+}
+
+func TestJWKSPicksUpRotatedKey(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, rsaJWK(t, "old", oldKey))
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	keys := NewFileJWKS(path, time.Hour).WithClock(func() time.Time { return now })
+	if _, err := keys.Key(context.Background(), "old"); err != nil {
+		t.Fatalf("old key: %v", err)
+	}
+
+	writeJWKS(t, path, rsaJWK(t, "old", oldKey), map[string]string{
+		"kty": "EC",
+		"kid": "new",
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(newKey.X.FillBytes(make([]byte, 32))),
+		"y":   base64.RawURLEncoding.EncodeToString(newKey.Y.FillBytes(make([]byte, 32))),
+	})
+
+	got, err := keys.Key(context.Background(), "new")
+	if err != nil {
+		t.Fatalf("rotated key: %v", err)
+	}
+	if !newKey.PublicKey.Equal(got) {
+		t.Fatalf("unexpected rotated key")
+	}
+
+	// Повторный неизвестный kid в пределах minForcedRefresh не вызывает перезагрузку.
+	if _, err := keys.Key(context.Background(), "forged"); err != ErrUnknownKey {
+		t.Fatalf("expected ErrUnknownKey, got %v", err)
+	}
+}
+
+func TestJWKSServesKnownKeysDuringReload(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	raw, err := json.Marshal(map[string]interface{}{"keys": []map[string]string{rsaJWK(t, "old", key)}})
+	if err != nil {
+		t.Fatalf("marshal jwks: %v", err)
+	}
+
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	var calls atomic.Int32
+	keys := newJWKS(func(ctx context.Context) ([]byte, error) {
+		if calls.Add(1) == 2 {
+			close(started)
+			<-unblock
+		}
+		return raw, nil
+	}, time.Hour)
+	if err := keys.Refresh(context.Background()); err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+
+	forced := make(chan error, 1)
+	go func() {
+		_, err := keys.Key(context.Background(), "forged")
+		forced <- err
+	}()
+	<-started
+
+	// Пока провайдер отвечает на внеплановую перезагрузку, известный ключ выдаётся без ожидания.
+	lookup := make(chan error, 1)
+	go func() {
+		_, err := keys.Key(context.Background(), "old")
+		lookup <- err
+	}()
+	select {
+	case err := <-lookup:
+		if err != nil {
+			t.Fatalf("known key: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("known key lookup blocked by a pending reload")
+	}
+
+	close(unblock)
+	if err := <-forced; err != ErrUnknownKey {
+		t.Fatalf("expected ErrUnknownKey, got %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/authz"
+	"github.com/evgeniySeleznev/nwHS/pkg/grpc/mtls"
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryAuthzInterceptor проверяет доступ субъекта из UnaryAuthInterceptor по политикам engine.
+// Отказы пишутся в лог и учитываются в метрике holo_authz_denied_total.
+func UnaryAuthzInterceptor(service string, engine *authz.Engine, collector *metrics.Collector, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	guard := newAuthzGuard(service, engine, collector, logger)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if guard.engine.Public(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		msg, _ := req.(proto.Message)
+		if err := guard.check(ctx, info.FullMethod, msg); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthzInterceptor — вариант UnaryAuthzInterceptor для потоковых вызовов. Если правила
+// метода сверяют поля запроса, проверка откладывается до первого входящего сообщения.
+func StreamAuthzInterceptor(service string, engine *authz.Engine, collector *metrics.Collector, logger *zap.Logger) grpc.StreamServerInterceptor {
+	guard := newAuthzGuard(service, engine, collector, logger)
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if guard.engine.Public(info.FullMethod) {
+			return handler(srv, stream)
+		}
+		if guard.engine.NeedsRequest(info.FullMethod) {
+			wrapped := &authzStream{ServerStream: stream, guard: guard, method: info.FullMethod}
+			err := handler(srv, wrapped)
+			if checkErr := wrapped.check(nil); checkErr != nil {
+				return checkErr
+			}
+			return err
+		}
+		if err := guard.check(stream.Context(), info.FullMethod, nil); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+type authzGuard struct {
+	service   string
+	engine    *authz.Engine
+	collector *metrics.Collector
+	log       *zap.Logger
+}
+
+func newAuthzGuard(service string, engine *authz.Engine, collector *metrics.Collector, logger *zap.Logger) *authzGuard {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &authzGuard{service: service, engine: engine, collector: collector, log: logger}
+}
+
+func (g *authzGuard) check(ctx context.Context, method string, req proto.Message) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		g.deny(method, "", authz.ReasonNoPrincipal)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	subject := authz.Subject{
+		ID:     principal.Subject,
+		Roles:  principal.Roles,
+		Claims: principal.Claims,
+	}
+	if identity, ok := mtls.PeerIdentity(ctx); ok {
+		subject.Peer = identity.Name()
+	}
+	decision := g.engine.Authorize(method, subject, req)
+	if !decision.Allowed {
+		g.deny(method, principal.Subject, decision.Reason)
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+func (g *authzGuard) deny(method, subject, reason string) {
+	g.log.Warn("rpc access denied",
+		zap.String("method", method),
+		zap.String("subject", subject),
+		zap.String("reason", reason),
+	)
+	if g.collector != nil {
+		g.collector.TrackDenied(g.service, method, reason)
+	}
+}
+
+// authzStream проверяет доступ по первому входящему сообщению потока. Если сообщения
+// нет (клиент сразу закрыл поток или обработчик не читает его), проверка проходит
+// без запроса, и правила match отказывают: ответ без проверки не уходит.
+type authzStream struct {
+	grpc.ServerStream
+	guard   *authzGuard
+	method  string
+	checked bool
+	err     error
+}
+
+func (s *authzStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		if checkErr := s.check(nil); checkErr != nil {
+			return checkErr
+		}
+		return err
+	}
+	msg, _ := m.(proto.Message)
+	return s.check(msg)
+}
+
+func (s *authzStream) SendMsg(m interface{}) error {
+	if err := s.check(nil); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// check выполняет проверку один раз и запоминает её результат.
+func (s *authzStream) check(req proto.Message) error {
+	if s.checked {
+		return s.err
+	}
+	s.checked = true
+	s.err = s.guard.check(s.Context(), s.method, req)
+	return s.err
+}
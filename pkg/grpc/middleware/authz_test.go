@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/authz"
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUnaryAuthzInterceptor(t *testing.T) {
+	engine, err := authz.NewEngine(authz.Config{
+		Public: []string{"/grpc.health.v1.Health/"},
+		Policies: []authz.Policy{{
+			Method: "/svc.Customers/Get",
+			Rules: []authz.Rule{
+				{Roles: []string{"customer"}, Match: []authz.Condition{{Field: "service", Claim: "sub"}}},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("engine: %v", err)
+	}
+	registry := prometheus.NewRegistry()
+	collector := metrics.NewCollector(metrics.WithRegistry(registry))
+	interceptor := UnaryAuthzInterceptor("customer", engine, collector, zap.NewNop())
+
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/svc.Customers/Get"}
+	customer := WithPrincipal(context.Background(), Principal{Subject: "c-1", Roles: []string{"customer"}})
+
+	if _, err := interceptor(customer, &healthpb.HealthCheckRequest{Service: "c-1"}, info, handler); err != nil {
+		t.Fatalf("own request denied: %v", err)
+	}
+	if _, err := interceptor(customer, &healthpb.HealthCheckRequest{Service: "c-2"}, info, handler); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", err)
+	}
+	if _, err := interceptor(context.Background(), &healthpb.HealthCheckRequest{}, info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated, got %v", err)
+	}
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, handler); err != nil {
+		t.Fatalf("public method denied: %v", err)
+	}
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	denied := 0.0
+	for _, family := range families {
+		if family.GetName() == "holo_authz_denied_total" {
+			for _, m := range family.GetMetric() {
+				denied += m.GetCounter().GetValue()
+			}
+		}
+	}
+	if denied != 2 {
+		t.Fatalf("expected two counted denials, got %v", denied)
+	}
+}
+
+func TestStreamAuthzInterceptorWithoutMessages(t *testing.T) {
+	engine, err := authz.NewEngine(authz.Config{
+		Policies: []authz.Policy{{
+			Method: "/svc.Customers/Import",
+			Rules: []authz.Rule{
+				{Roles: []string{"customer"}, Match: []authz.Condition{{Field: "value", Claim: "sub"}}},
+				{Roles: []string{"admin"}},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("engine: %v", err)
+	}
+	interceptor := StreamAuthzInterceptor("customer", engine, nil, zap.NewNop())
+	info := &grpc.StreamServerInfo{FullMethod: "/svc.Customers/Import", IsClientStream: true}
+
+	// importHandler, как ImportCustomers, продолжает работу после io.EOF.
+	importHandler := func(_ interface{}, stream grpc.ServerStream) error {
+		if err := stream.RecvMsg(&wrapperspb.StringValue{}); err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		return stream.SendMsg(&wrapperspb.StringValue{Value: "report"})
+	}
+	silentHandler := func(_ interface{}, stream grpc.ServerStream) error {
+		return stream.SendMsg(&wrapperspb.StringValue{Value: "report"})
+	}
+
+	customer := WithPrincipal(context.Background(), Principal{Subject: "c-1", Roles: []string{"customer"}})
+	admin := WithPrincipal(context.Background(), Principal{Subject: "a-1", Roles: []string{"admin"}})
+
+	cases := []struct {
+		name    string
+		ctx     context.Context
+		in      []string
+		handler grpc.StreamHandler
+		want    codes.Code
+	}{
+		{"customer sends nothing", customer, nil, importHandler, codes.PermissionDenied},
+		{"handler never reads", customer, []string{"c-1"}, silentHandler, codes.PermissionDenied},
+		{"customer sends own row", customer, []string{"c-1"}, importHandler, codes.OK},
+		{"customer sends foreign row", customer, []string{"c-2"}, importHandler, codes.PermissionDenied},
+		{"admin sends nothing", admin, nil, importHandler, codes.OK},
+	}
+	for _, tc := range cases {
+		stream := &fakeServerStream{ctx: tc.ctx, in: tc.in}
+		err := interceptor(nil, stream, info, tc.handler)
+		if status.Code(err) != tc.want {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+		if tc.want != codes.OK && len(stream.sent) != 0 {
+			t.Fatalf("%s: expected no response on a denied stream, got %v", tc.name, stream.sent)
+		}
+	}
+}
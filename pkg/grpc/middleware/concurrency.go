@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/concurrency"
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryConcurrencyInterceptor пропускает не больше адаптивного лимита одновременных вызовов.
+// Сверх доли лимита своего класса вызов сразу получает codes.Unavailable, чтобы клиент
+// повторил его позже или на другой реплике, а не ждал в очереди к перегруженной базе.
+func UnaryConcurrencyInterceptor(service string, limiter *concurrency.Limiter, collector *metrics.Collector, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	guard := newConcurrencyGuard(service, limiter, collector, logger)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		priority := guard.cfg.PriorityFor(info.FullMethod)
+		release, ok := guard.limiter.Acquire(priority)
+		if !ok {
+			return nil, guard.shed(info.FullMethod, priority)
+		}
+		guard.track()
+		resp, err := handler(ctx, req)
+		// Истёкший дедлайн считается признаком перегрузки и заставляет лимитер снизить лимит.
+		release(status.Code(err) == codes.DeadlineExceeded)
+		guard.track()
+		return resp, err
+	}
+}
+
+// StreamConcurrencyInterceptor — вариант UnaryConcurrencyInterceptor для потоковых вызовов;
+// место занято, пока поток открыт, но длительность потока не учитывается в задержках,
+// по которым пересчитывается лимит.
+func StreamConcurrencyInterceptor(service string, limiter *concurrency.Limiter, collector *metrics.Collector, logger *zap.Logger) grpc.StreamServerInterceptor {
+	guard := newConcurrencyGuard(service, limiter, collector, logger)
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		priority := guard.cfg.PriorityFor(info.FullMethod)
+		release, ok := guard.limiter.AcquireStream(priority)
+		if !ok {
+			return guard.shed(info.FullMethod, priority)
+		}
+		guard.track()
+		err := handler(srv, stream)
+		release()
+		guard.track()
+		return err
+	}
+}
+
+type concurrencyGuard struct {
+	service   string
+	limiter   *concurrency.Limiter
+	cfg       concurrency.Config
+	collector *metrics.Collector
+	log       *zap.Logger
+}
+
+func newConcurrencyGuard(service string, limiter *concurrency.Limiter, collector *metrics.Collector, logger *zap.Logger) *concurrencyGuard {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &concurrencyGuard{service: service, limiter: limiter, cfg: limiter.Config(), collector: collector, log: logger}
+}
+
+// shed отклоняет вызов сверх доли лимита его класса.
+func (g *concurrencyGuard) shed(method string, priority concurrency.Priority) error {
+	g.log.Warn("request shed by concurrency limit",
+		zap.String("method", method),
+		zap.String("priority", string(priority)),
+		zap.Int("limit", g.limiter.Limit()),
+	)
+	if g.collector != nil {
+		g.collector.TrackShed(g.service, method, string(priority))
+	}
+	return status.Error(codes.Unavailable, "server is overloaded, retry later")
+}
+
+func (g *concurrencyGuard) track() {
+	if g.collector != nil {
+		g.collector.TrackConcurrency(g.service, g.limiter.Limit(), g.limiter.InFlight())
+	}
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/concurrency"
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryConcurrencyInterceptorShedsLowPriorityFirst(t *testing.T) {
+	limiter := concurrency.NewLimiter(concurrency.Config{
+		InitialLimit: 2, MinLimit: 2, MaxLimit: 2,
+		Classes: []concurrency.Class{
+			{Method: "/svc.S/", Priority: concurrency.Sheddable},
+			{Method: "/svc.S/Get", Priority: concurrency.Critical},
+		},
+	})
+	registry := prometheus.NewRegistry()
+	interceptor := UnaryConcurrencyInterceptor("customer", limiter, metrics.NewCollector(metrics.WithRegistry(registry)), zap.NewNop())
+
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc.S/Get"},
+			func(context.Context, interface{}) (interface{}, error) {
+				close(started)
+				<-unblock
+				return "ok", nil
+			})
+		done <- err
+	}()
+	<-started
+
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc.S/Export"}, handler); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected sheddable call to get Unavailable, got %v", err)
+	}
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc.S/Get"}, handler); err != nil {
+		t.Fatalf("critical call rejected: %v", err)
+	}
+
+	close(unblock)
+	if err := <-done; err != nil {
+		t.Fatalf("held call failed: %v", err)
+	}
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	var shed, inFlight float64 = 0, -1
+	for _, family := range families {
+		switch family.GetName() {
+		case "holo_concurrency_shed_total":
+			shed = family.GetMetric()[0].GetCounter().GetValue()
+		case "holo_concurrency_in_flight":
+			inFlight = family.GetMetric()[0].GetGauge().GetValue()
+		}
+	}
+	if shed != 1 {
+		t.Fatalf("expected one shed call, got %v", shed)
+	}
+	if inFlight != 0 {
+		t.Fatalf("expected in-flight gauge to drop to 0, got %v", inFlight)
+	}
+}
+
+func TestStreamConcurrencyInterceptorKeepsStreamsOutOfLatency(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		now = time.Date(2026, 6, 1, 7, 0, 0, 0, time.UTC)
+	)
+	clock := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	advance := func(d time.Duration) {
+		mu.Lock()
+		now = now.Add(d)
+		mu.Unlock()
+	}
+
+	limiter := concurrency.NewLimiter(concurrency.Config{InitialLimit: 10, MinLimit: 2, MaxLimit: 100, WindowSize: 5}).WithClock(clock)
+	unary := UnaryConcurrencyInterceptor("customer", limiter, nil, zap.NewNop())
+	stream := StreamConcurrencyInterceptor("customer", limiter, nil, zap.NewNop())
+
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- stream(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/svc.S/Export"},
+			func(interface{}, grpc.ServerStream) error {
+				close(started)
+				<-unblock
+				return nil
+			})
+	}()
+	<-started
+
+	call := func(n int) {
+		for i := 0; i < n; i++ {
+			_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc.S/Get"},
+				func(context.Context, interface{}) (interface{}, error) {
+					advance(10 * time.Millisecond)
+					return "ok", nil
+				})
+			if err != nil {
+				t.Fatalf("unary call failed: %v", err)
+			}
+		}
+	}
+
+	call(20)
+	before := limiter.Limit()
+
+	// Длинный поток рядом с короткими вызовами не должен выглядеть как рост задержки.
+	advance(time.Hour)
+	close(unblock)
+	if err := <-done; err != nil {
+		t.Fatalf("stream failed: %v", err)
+	}
+	call(20)
+
+	if got := limiter.Limit(); got != before {
+		t.Fatalf("expected stream duration to leave the limit at %d, got %d", before, got)
+	}
+	if limiter.InFlight() != 0 {
+		t.Fatalf("expected all calls released, got %d in flight", limiter.InFlight())
+	}
+}
@@ -0,0 +1,269 @@
+package middleware
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrUnknownKey возвращается, если в наборе нет ключа с указанным kid.
+var ErrUnknownKey = errors.New("jwks: unknown key id")
+
+// minForcedRefresh ограничивает внеплановые перезагрузки набора при неизвестном kid,
+// чтобы поток токенов с поддельным kid не превращался в поток запросов к провайдеру.
+const minForcedRefresh = 30 * time.Second
+
+// KeyProvider выдаёт публичный ключ проверки подписи по kid.
+type KeyProvider interface {
+	Key(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// JWKS — набор ключей в формате RFC 7517, загружаемый из URL или файла.
+// Набор перечитывается раз в refresh и внепланово при появлении неизвестного kid,
+// поэтому ротация ключей у провайдера не требует перезапуска сервиса.
+// При ошибке загрузки продолжают использоваться ранее загруженные ключи.
+type JWKS struct {
+	load    func(ctx context.Context) ([]byte, error)
+	refresh time.Duration
+	now     func() time.Time
+
+	mu         sync.Mutex
+	keys       map[string]crypto.PublicKey
+	loadedAt   time.Time
+	lastForced time.Time
+}
+
+// NewRemoteJWKS создаёт набор, загружаемый по HTTP(S).
+func NewRemoteJWKS(url string, client *http.Client, refresh time.Duration) *JWKS {
+	if client == nil {
+		client = &http.Client{Timeout: 5 * time.Second}
+	}
+	return newJWKS(func(ctx context.Context) ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	}, refresh)
+}
+
+// NewFileJWKS создаёт набор, читаемый из локального файла (например, смонтированного секрета).
+func NewFileJWKS(path string, refresh time.Duration) *JWKS {
+	return newJWKS(func(context.Context) ([]byte, error) {
+		return os.ReadFile(path)
+	}, refresh)
+}
+
+func newJWKS(load func(ctx context.Context) ([]byte, error), refresh time.Duration) *JWKS {
+	return &JWKS{load: load, refresh: refresh, now: time.Now}
+}
+
+// WithClock переопределяет источник времени (для тестов).
+func (j *JWKS) WithClock(now func() time.Time) *JWKS {
+	j.now = now
+	return j
+}
+
+// Refresh принудительно перечитывает набор ключей.
+func (j *JWKS) Refresh(ctx context.Context) error {
+	j.mu.Lock()
+	j.loadedAt = j.now()
+	j.mu.Unlock()
+	return j.reload(ctx)
+}
+
+// Key возвращает ключ по kid. Пустой kid допустим, если в наборе ровно один ключ.
+// Загрузка набора идёт без удержания j.mu, поэтому медленный провайдер не
+// задерживает проверку токенов уже известными ключами.
+func (j *JWKS) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	j.mu.Lock()
+	now := j.now()
+	// Плановую перезагрузку выполняет первый заметивший её запрос; остальные
+	// продолжают работать с текущим набором.
+	stale := j.keys == nil || now.Sub(j.loadedAt) >= j.refresh
+	if stale {
+		j.loadedAt = now
+	}
+	j.mu.Unlock()
+
+	if stale {
+		if err := j.reload(ctx); err != nil && !j.loaded() {
+			return nil, err
+		}
+	}
+
+	j.mu.Lock()
+	if key, ok := j.lookup(kid); ok {
+		j.mu.Unlock()
+		return key, nil
+	}
+	if now.Sub(j.lastForced) < minForcedRefresh {
+		j.mu.Unlock()
+		return nil, ErrUnknownKey
+	}
+	j.lastForced = now
+	j.mu.Unlock()
+
+	if err := j.reload(ctx); err != nil {
+		return nil, err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if key, ok := j.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+// lookup вызывается под j.mu.
+func (j *JWKS) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(j.keys) == 1 {
+		for _, key := range j.keys {
+			return key, true
+		}
+	}
+	key, ok := j.keys[kid]
+	return key, ok
+}
+
+func (j *JWKS) loaded() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.keys != nil
+}
+
+// reload загружает и разбирает набор без блокировки и подменяет ключи под j.mu.
+// При ошибке ранее загруженные ключи остаются в силе.
+func (j *JWKS) reload(ctx context.Context) error {
+	raw, err := j.load(ctx)
+	if err != nil {
+		return fmt.Errorf("jwks: load: %w", err)
+	}
+	keys, err := parseJWKS(raw)
+	if err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	j.keys = keys
+	j.mu.Unlock()
+	return nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func parseJWKS(raw []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(raw, &set); err != nil {
+		return nil, fmt.Errorf("jwks: decode: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("jwks: key %q: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("jwks: no signing keys")
+	}
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		// ECDH проверяет, что точка лежит на кривой.
+		if _, err := key.ECDH(); err != nil {
+			return nil, fmt.Errorf("invalid EC key: %w", err)
+		}
+		return key, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(raw) == 0 {
+		return nil, errors.New("invalid key parameter")
+	}
+	return new(big.Int).SetBytes(raw), nil
+}
@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// LoggingConfig управляет журналированием вызовов.
+type LoggingConfig struct {
+	// Payloads добавляет в запись метаданные и тела унарных запросов и ответов.
+	// Перед записью они проходят через Redactor.
+	Payloads bool
+	// Redactor по умолчанию маскирует только поля с debug_redact и DefaultSensitiveMetadata.
+	Redactor *Redactor
+}
+
+// UnaryLoggingInterceptor пишет по одной записи на вызов: метод, адрес клиента,
+// длительность, статус и размеры сообщений.
+func UnaryLoggingInterceptor(cfg LoggingConfig, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	lg := newCallLogger(cfg, logger)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		started := time.Now()
+		resp, err := handler(ctx, req)
+
+		fields := lg.fields(ctx, info.FullMethod, started, err)
+		fields = append(fields, zap.Int("request_size", messageSize(req)), zap.Int("response_size", messageSize(resp)))
+		if lg.cfg.Payloads {
+			fields = append(fields, lg.payload("request", req), lg.payload("response", resp))
+		}
+		lg.log.Info("grpc call", fields...)
+		return resp, err
+	}
+}
+
+// StreamLoggingInterceptor — вариант UnaryLoggingInterceptor для потоковых вызовов.
+// Вместо тел сообщений пишутся их количество и суммарный размер.
+func StreamLoggingInterceptor(cfg LoggingConfig, logger *zap.Logger) grpc.StreamServerInterceptor {
+	lg := newCallLogger(cfg, logger)
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		started := time.Now()
+		wrapped := &loggingStream{ServerStream: stream}
+		err := handler(srv, wrapped)
+
+		fields := lg.fields(stream.Context(), info.FullMethod, started, err)
+		fields = append(fields,
+			zap.Int64("messages_received", wrapped.received.Load()),
+			zap.Int64("messages_sent", wrapped.sent.Load()),
+			zap.Int64("request_size", wrapped.receivedBytes.Load()),
+			zap.Int64("response_size", wrapped.sentBytes.Load()),
+		)
+		lg.log.Info("grpc stream", fields...)
+		return err
+	}
+}
+
+type callLogger struct {
+	cfg LoggingConfig
+	log *zap.Logger
+}
+
+func newCallLogger(cfg LoggingConfig, logger *zap.Logger) *callLogger {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if cfg.Redactor == nil {
+		cfg.Redactor = NewRedactor(nil, nil)
+	}
+	return &callLogger{cfg: cfg, log: logger}
+}
+
+func (l *callLogger) fields(ctx context.Context, method string, started time.Time, err error) []zap.Field {
+	fields := []zap.Field{
+		zap.String("method", method),
+		zap.Duration("duration", time.Since(started)),
+		zap.String("status", status.Code(err).String()),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields = append(fields, zap.String("peer", p.Addr.String()))
+	}
+	if l.cfg.Payloads {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			fields = append(fields, zap.Any("metadata", l.cfg.Redactor.Metadata(md)))
+		}
+	}
+	return fields
+}
+
+func (l *callLogger) payload(key string, m interface{}) zap.Field {
+	msg, ok := m.(proto.Message)
+	if !ok || msg == nil || !msg.ProtoReflect().IsValid() {
+		return zap.Skip()
+	}
+	raw, err := protojson.Marshal(l.cfg.Redactor.Message(msg))
+	if err != nil {
+		return zap.String(key, "marshal error: "+err.Error())
+	}
+	return zap.Reflect(key, json.RawMessage(raw))
+}
+
+func messageSize(m interface{}) int {
+	if msg, ok := m.(proto.Message); ok && msg != nil {
+		return proto.Size(msg)
+	}
+	return 0
+}
+
+type loggingStream struct {
+	grpc.ServerStream
+	sent, received           atomic.Int64
+	sentBytes, receivedBytes atomic.Int64
+}
+
+func (s *loggingStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.sent.Add(1)
+	s.sentBytes.Add(int64(messageSize(m)))
+	return nil
+}
+
+func (s *loggingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.received.Add(1)
+	s.receivedBytes.Add(int64(messageSize(m)))
+	return nil
+}
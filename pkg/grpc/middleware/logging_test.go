@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// customerDescriptor описывает сообщение
+//
+//	message Customer {
+//	  string name = 1;
+//	  string email = 2 [debug_redact = true];
+//	  string phone = 3;
+//	  map<string, string> answers = 4 [debug_redact = true];
+//	  repeated Customer related = 5;
+//	}
+func customerDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+	redact := &descriptorpb.FieldOptions{DebugRedact: proto.Bool(true)}
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, opts *descriptorpb.FieldOptions) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     typ.Enum(),
+			Options:  opts,
+		}
+	}
+	answers := field("answers", 4, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, redact)
+	answers.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	answers.TypeName = proto.String(".test.Customer.AnswersEntry")
+	related := field("related", 5, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, nil)
+	related.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	related.TypeName = proto.String(".test.Customer")
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test/customer.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Customer"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, nil),
+				field("email", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, redact),
+				field("phone", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, nil),
+				answers,
+				related,
+			},
+			NestedType: []*descriptorpb.DescriptorProto{{
+				Name:    proto.String("AnswersEntry"),
+				Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("key", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, nil),
+					field("value", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, nil),
+				},
+			}},
+		}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("build descriptor: %v", err)
+	}
+	return file.Messages().ByName("Customer")
+}
+
+func newCustomer(desc protoreflect.MessageDescriptor, name, email, phone string) *dynamicpb.Message {
+	m := dynamicpb.NewMessage(desc)
+	fields := desc.Fields()
+	m.Set(fields.ByName("name"), protoreflect.ValueOfString(name))
+	m.Set(fields.ByName("email"), protoreflect.ValueOfString(email))
+	m.Set(fields.ByName("phone"), protoreflect.ValueOfString(phone))
+	return m
+}
+
+func TestRedactorMasksMarkedAndConfiguredFields(t *testing.T) {
+	desc := customerDescriptor(t)
+	fields := desc.Fields()
+	msg := newCustomer(desc, "Anna", "anna@example.com", "+79990001122")
+	msg.Mutable(fields.ByName("answers")).Map().Set(protoreflect.ValueOfString("q1").MapKey(), protoreflect.ValueOfString("asthma"))
+	msg.Mutable(fields.ByName("related")).List().Append(protoreflect.ValueOfMessage(newCustomer(desc, "Ivan", "ivan@example.com", "+79990003344")))
+
+	redacted := NewRedactor([]string{"test.Customer.phone"}, nil).Message(msg).ProtoReflect()
+
+	if got := redacted.Get(fields.ByName("name")).String(); got != "Anna" {
+		t.Fatalf("plain field must be kept, got %q", got)
+	}
+	for _, name := range []protoreflect.Name{"email", "phone"} {
+		if got := redacted.Get(fields.ByName(name)).String(); got != Redacted {
+			t.Fatalf("%s must be redacted, got %q", name, got)
+		}
+	}
+	if got := redacted.Get(fields.ByName("answers")).Map().Get(protoreflect.ValueOfString("q1").MapKey()).String(); got != Redacted {
+		t.Fatalf("map values must be redacted, got %q", got)
+	}
+	if got := redacted.Get(fields.ByName("related")).List().Get(0).Message().Get(fields.ByName("email")).String(); got != Redacted {
+		t.Fatalf("nested messages must be redacted, got %q", got)
+	}
+	if got := msg.Get(fields.ByName("email")).String(); got != "anna@example.com" {
+		t.Fatalf("original message must not change, got %q", got)
+	}
+}
+
+func TestUnaryLoggingInterceptorStripsCredentials(t *testing.T) {
+	desc := customerDescriptor(t)
+	core, logs := observer.New(zapcore.InfoLevel)
+	interceptor := UnaryLoggingInterceptor(LoggingConfig{Payloads: true}, zap.New(core))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret", "x-request-id", "req-1"))
+	req := newCustomer(desc, "Anna", "anna@example.com", "+79990001122")
+	handler := func(context.Context, interface{}) (interface{}, error) { return req, nil }
+
+	if _, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/test.S/Register"}, handler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("expected one log entry, got %d", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	md := fields["metadata"].(map[string]string)
+	if md["authorization"] != Redacted || md["x-request-id"] != "req-1" {
+		t.Fatalf("unexpected metadata %v", md)
+	}
+	payload := string(fields["request"].(json.RawMessage))
+	if strings.Contains(payload, "anna@example.com") || !strings.Contains(payload, "Anna") {
+		t.Fatalf("unexpected request payload %s", payload)
+	}
+	if fields["status"] != "OK" || fields["request_size"].(int64) == 0 {
+		t.Fatalf("unexpected call fields %v", fields)
+	}
+}
@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"github.com/evgeniySeleznev/nwHS/pkg/ratelimit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterHeader — заголовок ответа с числом секунд до следующей попытки.
+const RetryAfterHeader = "retry-after"
+
+// UnaryRateLimitInterceptor ограничивает частоту вызовов корзиной на пару (субъект, метод).
+// Субъект берётся из UnaryAuthInterceptor, для анонимных вызовов — адрес клиента.
+// Ошибка хранилища не блокирует запрос: лимитер не должен становиться точкой отказа.
+func UnaryRateLimitInterceptor(service string, limiter ratelimit.Limiter, cfg ratelimit.Config, collector *metrics.Collector, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	guard := newRateGuard(service, limiter, cfg, collector, logger)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := guard.check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamRateLimitInterceptor — вариант UnaryRateLimitInterceptor для потоковых вызовов;
+// токен списывается при открытии потока.
+func StreamRateLimitInterceptor(service string, limiter ratelimit.Limiter, cfg ratelimit.Config, collector *metrics.Collector, logger *zap.Logger) grpc.StreamServerInterceptor {
+	guard := newRateGuard(service, limiter, cfg, collector, logger)
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := guard.check(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+type rateGuard struct {
+	service   string
+	limiter   ratelimit.Limiter
+	cfg       ratelimit.Config
+	collector *metrics.Collector
+	log       *zap.Logger
+}
+
+func newRateGuard(service string, limiter ratelimit.Limiter, cfg ratelimit.Config, collector *metrics.Collector, logger *zap.Logger) *rateGuard {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &rateGuard{service: service, limiter: limiter, cfg: cfg, collector: collector, log: logger}
+}
+
+func (g *rateGuard) check(ctx context.Context, method string) error {
+	subject, bucket := callerKey(ctx)
+	limit := g.cfg.LimitFor(method, subject)
+	if limit.Unlimited() {
+		return nil
+	}
+
+	res, err := g.limiter.Allow(ctx, bucket+"|"+method, limit)
+	if err != nil {
+		g.log.Error("rate limiter unavailable, request allowed", zap.String("method", method), zap.Error(err))
+		return nil
+	}
+	if res.Allowed {
+		return nil
+	}
+
+	retryAfter := int(math.Ceil(res.RetryAfter.Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.Itoa(retryAfter)))
+
+	g.log.Warn("rate limit exceeded", zap.String("method", method), zap.String("subject", subject))
+	if g.collector != nil {
+		g.collector.TrackRateLimited(g.service, method)
+	}
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %ds", retryAfter)
+}
+
+// forwardedForKey — метаданные, в которые grpc-gateway кладёт адрес HTTP-клиента.
+const forwardedForKey = "x-forwarded-for"
+
+// callerKey определяет, чья корзина расходуется. subject сверяется с правилами лимитов,
+// bucket — ключ корзины: одинаковый sub у разных арендаторов и издателей не делит корзину.
+func callerKey(ctx context.Context) (subject, bucket string) {
+	if principal, ok := PrincipalFromContext(ctx); ok {
+		issuer, _ := principal.Claims["iss"].(string)
+		return principal.Subject, "sub:" + principal.TenantID + "|" + issuer + "|" + principal.Subject
+	}
+	if ip := clientIP(ctx); ip != "" {
+		return "ip:" + ip, "ip:" + ip
+	}
+	return "anonymous", "anonymous"
+}
+
+// clientIP возвращает адрес клиента. Вызов с того же хоста — это REST-шлюз сервиса:
+// для него берётся последний адрес x-forwarded-for, который шлюз дописал сам,
+// иначе все анонимные REST-клиенты делили бы корзину адреса шлюза.
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host := hostOf(p.Addr)
+	if p.LocalAddr == nil || !sameHost(host, hostOf(p.LocalAddr)) {
+		return host
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwarded := md.Get(forwardedForKey)
+	if len(forwarded) == 0 {
+		return host
+	}
+	hops := strings.Split(forwarded[len(forwarded)-1], ",")
+	if last := strings.TrimSpace(hops[len(hops)-1]); last != "" {
+		return last
+	}
+	return host
+}
+
+func hostOf(addr net.Addr) string {
+	if host, _, err := net.SplitHostPort(addr.String()); err == nil {
+		return host
+	}
+	return addr.String()
+}
+
+func sameHost(remote, local string) bool {
+	if remote == local {
+		return true
+	}
+	ip := net.ParseIP(remote)
+	return ip != nil && ip.IsLoopback()
+}
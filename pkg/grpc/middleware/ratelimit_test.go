@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"github.com/evgeniySeleznev/nwHS/pkg/ratelimit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestUnaryRateLimitInterceptor(t *testing.T) {
+	cfg := ratelimit.Config{
+		Rules: []ratelimit.Rule{{Method: "/grpc.health.v1.Health/Check", Limit: ratelimit.Limit{Rate: 0.5, Burst: 1}}},
+	}
+	listener := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.UnaryInterceptor(
+		UnaryRateLimitInterceptor("customer", ratelimit.NewMemory(), cfg, metrics.NewCollector(), zap.NewNop()),
+	))
+	healthpb.RegisterHealthServer(srv, grpchealth.NewServer())
+	go func() { _ = srv.Serve(listener) }()
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("first call rejected: %v", err)
+	}
+
+	var header metadata.MD
+	_, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{}, grpc.Header(&header))
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	if got := header.Get(RetryAfterHeader); len(got) != 1 || got[0] != "2" {
+		t.Fatalf("expected retry-after 2, got %v", got)
+	}
+}
+
+func TestCallerKey(t *testing.T) {
+	principal := func(tenant, issuer string) context.Context {
+		return WithPrincipal(context.Background(), Principal{Subject: "42", TenantID: tenant, Claims: map[string]interface{}{"iss": issuer}})
+	}
+	subject, brandA := callerKey(principal("brand-a", "https://idp.example.com"))
+	_, brandB := callerKey(principal("brand-b", "https://idp.example.com"))
+	_, otherIssuer := callerKey(principal("brand-a", "https://partner.example.com"))
+	if subject != "42" {
+		t.Fatalf("expected rules to match on the bare subject, got %q", subject)
+	}
+	if brandA == brandB || brandA == otherIssuer {
+		t.Fatalf("expected separate buckets per tenant and issuer, got %q, %q, %q", brandA, brandB, otherIssuer)
+	}
+
+	call := func(remote, local string, forwarded ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr:      &net.TCPAddr{IP: net.ParseIP(remote), Port: 50000},
+			LocalAddr: &net.TCPAddr{IP: net.ParseIP(local), Port: 9090},
+		})
+		if len(forwarded) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(forwardedForKey, forwarded[0]))
+		}
+		return ctx
+	}
+	cases := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"direct client", call("198.51.100.2", "10.0.0.5"), "ip:198.51.100.2"},
+		{"direct client spoofs forwarded", call("198.51.100.2", "10.0.0.5", "203.0.113.7"), "ip:198.51.100.2"},
+		{"gateway over loopback", call("127.0.0.1", "127.0.0.1", "203.0.113.7"), "ip:203.0.113.7"},
+		{"gateway keeps its own hop", call("127.0.0.1", "127.0.0.1", "192.0.2.1, 203.0.113.7"), "ip:203.0.113.7"},
+		{"gateway over host address", call("10.0.0.5", "10.0.0.5", "203.0.113.7"), "ip:203.0.113.7"},
+		{"local call without gateway", call("127.0.0.1", "127.0.0.1"), "ip:127.0.0.1"},
+	}
+	for _, tc := range cases {
+		if _, bucket := callerKey(tc.ctx); bucket != tc.want {
+			t.Fatalf("%s: expected %q, got %q", tc.name, tc.want, bucket)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	sentryobs "github.com/evgeniySeleznev/nwHS/pkg/observability/sentry"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errPanic — ответ клиенту при панике; подробности остаются в журнале и Sentry.
+var errPanic = status.Error(grpccodes.Internal, "internal error")
+
+// UnaryRecoveryInterceptor превращает панику обработчика в codes.Internal вместо падения процесса.
+// Ставится после UnaryTelemetryInterceptor, чтобы вызов попал в метрики и спан с ошибкой.
+func UnaryRecoveryInterceptor(service string, collector *metrics.Collector, sentryClient *sentryobs.Client, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	rec := newRecoverer(service, collector, sentryClient, logger)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				rec.handle(ctx, info.FullMethod, r)
+				resp, err = nil, errPanic
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecoveryInterceptor — вариант UnaryRecoveryInterceptor для потоковых вызовов.
+func StreamRecoveryInterceptor(service string, collector *metrics.Collector, sentryClient *sentryobs.Client, logger *zap.Logger) grpc.StreamServerInterceptor {
+	rec := newRecoverer(service, collector, sentryClient, logger)
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				rec.handle(stream.Context(), info.FullMethod, r)
+				err = errPanic
+			}
+		}()
+		return handler(srv, stream)
+	}
+}
+
+type recoverer struct {
+	service   string
+	collector *metrics.Collector
+	sentry    *sentryobs.Client
+	log       *zap.Logger
+}
+
+func newRecoverer(service string, collector *metrics.Collector, sentryClient *sentryobs.Client, logger *zap.Logger) *recoverer {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &recoverer{service: service, collector: collector, sentry: sentryClient, log: logger}
+}
+
+// handle вызывается из отложенной функции, поэтому стек ещё указывает на место паники.
+func (r *recoverer) handle(ctx context.Context, method string, recovered interface{}) {
+	r.log.Error("grpc handler panic",
+		zap.String("method", method),
+		zap.Any("panic", recovered),
+		zap.Stack("stack"),
+	)
+
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(fmt.Errorf("panic: %v", recovered), trace.WithStackTrace(true))
+	span.SetStatus(codes.Error, "panic")
+
+	if r.sentry.Enabled() {
+		r.sentry.CapturePanic(recovered, map[string]string{"grpc.method": method, "service": r.service})
+	}
+	if r.collector != nil {
+		r.collector.TrackPanic(r.service, method)
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryRecoveryInterceptor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+	core, logs := observer.New(zapcore.ErrorLevel)
+	registry := prometheus.NewRegistry()
+	interceptor := UnaryRecoveryInterceptor("customer", metrics.NewCollector(metrics.WithRegistry(registry)), nil, zap.New(core))
+
+	ctx, span := tracer.Start(context.Background(), "call")
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		var m map[string]int
+		m["boom"]++
+		return nil, nil
+	}
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/svc.S/Get"}, handler)
+	span.End()
+
+	st, _ := status.FromError(err)
+	if st.Code() != codes.Internal || st.Message() != "internal error" {
+		t.Fatalf("expected opaque Internal error, got %v", err)
+	}
+	if entries := logs.All(); len(entries) != 1 || !strings.Contains(entries[0].ContextMap()["stack"].(string), "recovery_test.go") {
+		t.Fatalf("expected panic logged with stack, got %v", entries)
+	}
+	if got := recorder.Ended()[0].Status().Code; got != otelcodes.Error {
+		t.Fatalf("expected span marked as error, got %v", got)
+	}
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	if len(families) != 1 || families[0].GetName() != "holo_panics_total" || families[0].GetMetric()[0].GetCounter().GetValue() != 1 {
+		t.Fatalf("expected one recorded panic, got %v", families)
+	}
+}
+
+func TestStreamRecoveryInterceptor(t *testing.T) {
+	interceptor := StreamRecoveryInterceptor("customer", nil, nil, zap.NewNop())
+	stream := &fakeServerStream{ctx: context.Background()}
+	handler := func(interface{}, grpc.ServerStream) error {
+		panic("stream exploded")
+	}
+
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/svc.S/Import"}, handler)
+	if status.Code(err) != codes.Internal || strings.Contains(err.Error(), "exploded") {
+		t.Fatalf("expected opaque Internal error, got %v", err)
+	}
+}
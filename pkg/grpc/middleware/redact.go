@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Redacted заменяет значения чувствительных полей и метаданных в журналах.
+const Redacted = "[REDACTED]"
+
+// DefaultSensitiveMetadata — ключи метаданных с учётными данными.
+var DefaultSensitiveMetadata = []string{"authorization", "cookie", "set-cookie", "proxy-authorization", "x-api-key"}
+
+// Redactor маскирует чувствительные поля сообщений перед записью в журнал.
+// Поле считается чувствительным, если помечено опцией debug_redact или его короткое
+// либо полное имя перечислено в fields.
+type Redactor struct {
+	fields   map[string]struct{}
+	metadata map[string]struct{}
+}
+
+// NewRedactor создаёт маскировщик с дополнительными полями и ключами метаданных
+// сверх DefaultSensitiveMetadata.
+func NewRedactor(fields, metadataKeys []string) *Redactor {
+	r := &Redactor{fields: make(map[string]struct{}), metadata: make(map[string]struct{})}
+	for _, f := range fields {
+		r.fields[f] = struct{}{}
+	}
+	for _, key := range append(append([]string{}, DefaultSensitiveMetadata...), metadataKeys...) {
+		r.metadata[strings.ToLower(key)] = struct{}{}
+	}
+	return r
+}
+
+// Message возвращает копию m с замаскированными чувствительными полями.
+// Строки заменяются на Redacted, остальные типы очищаются; исходное сообщение не меняется.
+func (r *Redactor) Message(m proto.Message) proto.Message {
+	if m == nil {
+		return nil
+	}
+	clone := proto.Clone(m)
+	r.redact(clone.ProtoReflect())
+	return clone
+}
+
+// Metadata возвращает метаданные в виде строк без учётных данных.
+func (r *Redactor) Metadata(md metadata.MD) map[string]string {
+	out := make(map[string]string, len(md))
+	for key, values := range md {
+		if _, ok := r.metadata[key]; ok {
+			out[key] = Redacted
+			continue
+		}
+		out[key] = strings.Join(values, ",")
+	}
+	return out
+}
+
+func (r *Redactor) redact(m protoreflect.Message) {
+	var sensitive []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case r.sensitive(fd):
+			sensitive = append(sensitive, fd)
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					r.redact(mv.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				for i := 0; i < v.List().Len(); i++ {
+					r.redact(v.List().Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			r.redact(v.Message())
+		}
+		return true
+	})
+	for _, fd := range sensitive {
+		mask(m, fd)
+	}
+}
+
+func (r *Redactor) sensitive(fd protoreflect.FieldDescriptor) bool {
+	if opts, ok := fd.Options().(*descriptorpb.FieldOptions); ok && opts.GetDebugRedact() {
+		return true
+	}
+	if _, ok := r.fields[string(fd.Name())]; ok {
+		return true
+	}
+	_, ok := r.fields[string(fd.FullName())]
+	return ok
+}
+
+// mask сохраняет форму поля там, где это возможно, чтобы по журналу было видно,
+// что значение передавалось.
+func mask(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	switch {
+	case fd.IsMap():
+		if fd.MapValue().Kind() != protoreflect.StringKind {
+			m.Clear(fd)
+			return
+		}
+		values := m.Mutable(fd).Map()
+		values.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			values.Set(k, protoreflect.ValueOfString(Redacted))
+			return true
+		})
+	case fd.IsList():
+		if fd.Kind() != protoreflect.StringKind {
+			m.Clear(fd)
+			return
+		}
+		values := m.Mutable(fd).List()
+		for i := 0; i < values.Len(); i++ {
+			values.Set(i, protoreflect.ValueOfString(Redacted))
+		}
+	case fd.Kind() == protoreflect.StringKind:
+		m.Set(fd, protoreflect.ValueOfString(Redacted))
+	case fd.Message() != nil && fd.Message().FullName() == "google.protobuf.StringValue":
+		inner := m.Mutable(fd).Message()
+		inner.Set(inner.Descriptor().Fields().ByName("value"), protoreflect.ValueOfString(Redacted))
+	default:
+		m.Clear(fd)
+	}
+}
@@ -2,17 +2,24 @@ package middleware
 
 import (
 	"context"
+	"errors"
+	"io"
+	"sync/atomic"
 	"time"
 
 	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
 	sentryobs "github.com/evgeniySeleznev/nwHS/pkg/observability/sentry"
+	"github.com/evgeniySeleznev/nwHS/pkg/tracing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // UnaryTelemetryInterceptor добавляет метрики, ошибки и трассировку к gRPC обработчикам.
@@ -27,7 +34,7 @@ func UnaryTelemetryInterceptor(service string, collector *metrics.Collector, sen
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		started := time.Now()
 
-		ctx, span := tracer.Start(ctx, info.FullMethod)
+		ctx, span := tracer.Start(extractTrace(ctx), info.FullMethod, trace.WithSpanKind(trace.SpanKindServer))
 		span.SetAttributes(
 			semconv.RPCSystemKey.String("grpc"),
 			semconv.RPCServiceKey.String(service),
@@ -36,25 +43,137 @@ func UnaryTelemetryInterceptor(service string, collector *metrics.Collector, sen
 
 		resp, err := handler(ctx, req)
 
-		code := status.Code(err)
-		span.SetAttributes(attribute.String("rpc.status_code", code.String()))
-
-		if err != nil {
-			lg.Error("grpc handler error", zap.String("method", info.FullMethod), zap.String("status", code.String()), zap.Error(err))
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-			if sentryClient != nil && sentryClient.Enabled() {
-				sentryClient.CaptureError(err)
-			}
-		} else {
-			span.SetStatus(codes.Ok, "")
+		code := finishSpan(span, err, info.FullMethod, sentryClient, lg)
+		if collector != nil {
+			collector.TrackDuration(service, info.FullMethod, code, started)
 		}
 
+		span.End()
+		return resp, err
+	}
+}
+
+// StreamTelemetryInterceptor — вариант UnaryTelemetryInterceptor для потоковых вызовов.
+// Спан и длительность охватывают весь поток; каждое сообщение добавляет событие в спан
+// и учитывается в holo_stream_messages_total и holo_stream_message_size_bytes.
+func StreamTelemetryInterceptor(service string, collector *metrics.Collector, sentryClient *sentryobs.Client, logger *zap.Logger) grpc.StreamServerInterceptor {
+	lg := logger
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+
+	tracer := otel.Tracer(service)
+
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		started := time.Now()
+
+		ctx, span := tracer.Start(extractTrace(stream.Context()), info.FullMethod, trace.WithSpanKind(trace.SpanKindServer))
+		span.SetAttributes(
+			semconv.RPCSystemKey.String("grpc"),
+			semconv.RPCServiceKey.String(service),
+			semconv.RPCMethodKey.String(info.FullMethod),
+			attribute.Bool("rpc.grpc.client_stream", info.IsClientStream),
+			attribute.Bool("rpc.grpc.server_stream", info.IsServerStream),
+		)
+
+		wrapped := &telemetryStream{
+			ServerStream: stream,
+			ctx:          ctx,
+			span:         span,
+			service:      service,
+			method:       info.FullMethod,
+			collector:    collector,
+		}
+		err := handler(srv, wrapped)
+
+		span.SetAttributes(
+			attribute.Int64("rpc.messages_sent", wrapped.sent.Load()),
+			attribute.Int64("rpc.messages_received", wrapped.received.Load()),
+		)
+		code := finishSpan(span, err, info.FullMethod, sentryClient, lg)
 		if collector != nil {
-			collector.TrackDuration(service, info.FullMethod, code.String(), started)
+			collector.TrackDuration(service, info.FullMethod, code, started)
 		}
 
 		span.End()
-		return resp, err
+		return err
+	}
+}
+
+// extractTrace продолжает трассу вызывающего сервиса, если он передал traceparent.
+func extractTrace(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return tracing.Propagator.Extract(ctx, tracing.MetadataCarrier(md))
+}
+
+// finishSpan фиксирует итог вызова в спане, журнале и Sentry и возвращает код статуса.
+func finishSpan(span trace.Span, err error, method string, sentryClient *sentryobs.Client, lg *zap.Logger) string {
+	code := status.Code(err)
+	span.SetAttributes(attribute.String("rpc.status_code", code.String()))
+
+	if err != nil {
+		lg.Error("grpc handler error", zap.String("method", method), zap.String("status", code.String()), zap.Error(err))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if sentryClient != nil && sentryClient.Enabled() {
+			sentryClient.CaptureError(err)
+		}
+	} else {
+		span.SetStatus(codes.Ok, "")
+	}
+	return code.String()
+}
+
+// telemetryStream считает сообщения потока. SendMsg и RecvMsg могут вызываться
+// из разных горутин, поэтому счётчики атомарные.
+type telemetryStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	span      trace.Span
+	service   string
+	method    string
+	collector *metrics.Collector
+	sent      atomic.Int64
+	received  atomic.Int64
+}
+
+func (s *telemetryStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *telemetryStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.observe(semconv.MessageTypeSent, "sent", s.sent.Add(1), m)
+	return nil
+}
+
+func (s *telemetryStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		if !errors.Is(err, io.EOF) {
+			s.span.AddEvent("message", trace.WithAttributes(semconv.MessageTypeReceived, attribute.String("error", err.Error())))
+		}
+		return err
+	}
+	s.observe(semconv.MessageTypeReceived, "received", s.received.Add(1), m)
+	return nil
+}
+
+func (s *telemetryStream) observe(kind attribute.KeyValue, direction string, seq int64, m interface{}) {
+	size := 0
+	if msg, ok := m.(proto.Message); ok {
+		size = proto.Size(msg)
+	}
+	s.span.AddEvent("message", trace.WithAttributes(
+		kind,
+		semconv.MessageID(int(seq)),
+		semconv.MessageUncompressedSize(size),
+	))
+	if s.collector != nil {
+		s.collector.TrackStreamMessage(s.service, s.method, direction, size)
 	}
 }
@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// fakeServerStream отдаёт заранее заданные входящие сообщения и копит исходящие.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	in   []string
+	sent []interface{}
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+func (s *fakeServerStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	if len(s.in) == 0 {
+		return io.EOF
+	}
+	m.(*wrapperspb.StringValue).Value = s.in[0]
+	s.in = s.in[1:]
+	return nil
+}
+
+func TestStreamTelemetryInterceptor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	registry := prometheus.NewRegistry()
+	collector := metrics.NewCollector(metrics.WithRegistry(registry))
+	interceptor := StreamTelemetryInterceptor("customer", collector, nil, zap.NewNop())
+
+	stream := &fakeServerStream{ctx: context.Background(), in: []string{"a", "bb"}}
+	info := &grpc.StreamServerInfo{FullMethod: "/svc.S/Import", IsClientStream: true}
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		for {
+			msg := &wrapperspb.StringValue{}
+			if err := ss.RecvMsg(msg); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return err
+			}
+		}
+		if err := ss.SendMsg(wrapperspb.String("done")); err != nil {
+			return err
+		}
+		return status.Error(codes.InvalidArgument, "bad row")
+	}
+
+	if err := interceptor(nil, stream, info, handler); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected handler error to pass through, got %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span for the stream, got %d", len(spans))
+	}
+	if got := len(spans[0].Events()); got != 4 {
+		t.Fatalf("expected 3 message events and 1 error event, got %d", got)
+	}
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	counts := map[string]float64{}
+	for _, family := range families {
+		switch family.GetName() {
+		case "holo_stream_messages_total":
+			for _, m := range family.GetMetric() {
+				for _, label := range m.GetLabel() {
+					if label.GetName() == "direction" {
+						counts[label.GetValue()] = m.GetCounter().GetValue()
+					}
+				}
+			}
+		case "holo_requests_total":
+			if label := family.GetMetric()[0].GetLabel(); label[2].GetValue() != "InvalidArgument" {
+				t.Fatalf("expected status label InvalidArgument, got %v", label)
+			}
+		}
+	}
+	if counts["received"] != 2 || counts["sent"] != 1 {
+		t.Fatalf("unexpected message counts %v", counts)
+	}
+}
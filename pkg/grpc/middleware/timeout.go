@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/deadline"
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MethodTimeout переопределяет таймауты для одного метода.
+// Unbounded снимает серверный бюджет для долгоживущих потоков вроде
+// grpc.health.v1.Health/Watch; дедлайн клиента при этом сохраняется.
+type MethodTimeout struct {
+	Method    string
+	Default   time.Duration
+	Max       time.Duration
+	Unbounded bool
+}
+
+// TimeoutConfig задаёт бюджет вызова: Default применяется, если клиент не прислал дедлайн,
+// Max ограничивает слишком длинный клиентский дедлайн. Нулевое значение не ограничивает.
+type TimeoutConfig struct {
+	Default time.Duration
+	Max     time.Duration
+	Methods []MethodTimeout
+}
+
+func (c TimeoutConfig) limits(method string) (time.Duration, time.Duration) {
+	def, max := c.Default, c.Max
+	for _, m := range c.Methods {
+		if m.Method != method {
+			continue
+		}
+		if m.Unbounded {
+			return 0, 0
+		}
+		if m.Default > 0 {
+			def = m.Default
+		}
+		if m.Max > 0 {
+			max = m.Max
+		}
+	}
+	return def, max
+}
+
+// apply выставляет дедлайн вызова. Дедлайн контекста доходит до Postgres, Kafka,
+// OpenSearch и Mongo, потому что их клиенты получают контекст запроса.
+func (c TimeoutConfig) apply(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	def, max := c.limits(method)
+	budget := def
+	if d, ok := ctx.Deadline(); ok {
+		budget = 0
+		if max > 0 && time.Until(d) > max {
+			budget = max
+		}
+	} else if budget <= 0 {
+		budget = max
+	}
+	if budget <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, budget)
+}
+
+// UnaryTimeoutInterceptor ограничивает время обработчика и учитывает истёкшие дедлайны
+// в holo_deadline_exceeded_total с этапом, сообщённым через deadline.Observe.
+func UnaryTimeoutInterceptor(service string, cfg TimeoutConfig, collector *metrics.Collector) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, cancel := cfg.apply(ctx, info.FullMethod)
+		defer cancel()
+		ctx, tracker := deadline.WithTracker(ctx)
+
+		resp, err := handler(ctx, req)
+		return resp, deadlineResult(ctx, err, service, info.FullMethod, tracker, collector)
+	}
+}
+
+// StreamTimeoutInterceptor — вариант UnaryTimeoutInterceptor для потоковых вызовов;
+// бюджет распространяется на весь поток.
+func StreamTimeoutInterceptor(service string, cfg TimeoutConfig, collector *metrics.Collector) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := cfg.apply(stream.Context(), info.FullMethod)
+		defer cancel()
+		ctx, tracker := deadline.WithTracker(ctx)
+
+		err := handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+		return deadlineResult(ctx, err, service, info.FullMethod, tracker, collector)
+	}
+}
+
+// deadlineResult приводит ошибку, вызванную истечением дедлайна, к codes.DeadlineExceeded:
+// адаптеры оборачивают context.DeadlineExceeded по-разному, а клиенту нужен единый код.
+func deadlineResult(ctx context.Context, err error, service, method string, tracker *deadline.Tracker, collector *metrics.Collector) error {
+	if err == nil {
+		return nil
+	}
+	expired := status.Code(err) == codes.DeadlineExceeded ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(ctx.Err(), context.DeadlineExceeded)
+	if !expired {
+		return err
+	}
+	if collector != nil {
+		collector.TrackDeadlineExceeded(service, method, tracker.Stage())
+	}
+	if status.Code(err) == codes.DeadlineExceeded {
+		return err
+	}
+	return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+}
@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/deadline"
+	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTimeoutConfigApply(t *testing.T) {
+	cfg := TimeoutConfig{
+		Default: time.Second,
+		Max:     5 * time.Second,
+		Methods: []MethodTimeout{{Method: "/svc.S/Import", Default: time.Minute, Max: 10 * time.Minute}},
+	}
+
+	remaining := func(ctx context.Context, method string) time.Duration {
+		ctx, cancel := cfg.apply(ctx, method)
+		defer cancel()
+		d, ok := ctx.Deadline()
+		if !ok {
+			t.Fatalf("%s: expected deadline", method)
+		}
+		return time.Until(d).Round(time.Second)
+	}
+
+	if got := remaining(context.Background(), "/svc.S/Get"); got != time.Second {
+		t.Fatalf("expected default budget, got %v", got)
+	}
+	if got := remaining(context.Background(), "/svc.S/Import"); got != time.Minute {
+		t.Fatalf("expected per-method default, got %v", got)
+	}
+
+	long, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	if got := remaining(long, "/svc.S/Get"); got != 5*time.Second {
+		t.Fatalf("expected client deadline clamped to max, got %v", got)
+	}
+
+	short, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if got := remaining(short, "/svc.S/Get"); got != 2*time.Second {
+		t.Fatalf("expected shorter client deadline kept, got %v", got)
+	}
+}
+
+func TestUnaryTimeoutInterceptorReportsStage(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	interceptor := UnaryTimeoutInterceptor("customer", TimeoutConfig{Default: 10 * time.Millisecond}, metrics.NewCollector(metrics.WithRegistry(registry)))
+
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		<-ctx.Done()
+		err := fmt.Errorf("index customer: %w", ctx.Err())
+		deadline.Observe(ctx, "opensearch", err)
+		return nil, err
+	}
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc.S/Register"}, handler)
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	if len(families) != 1 || families[0].GetName() != "holo_deadline_exceeded_total" {
+		t.Fatalf("expected deadline metric, got %v", families)
+	}
+	for _, label := range families[0].GetMetric()[0].GetLabel() {
+		if label.GetName() == "stage" && label.GetValue() != "opensearch" {
+			t.Fatalf("expected opensearch stage, got %q", label.GetValue())
+		}
+	}
+}
+
+func TestStreamTimeoutInterceptorLongStreams(t *testing.T) {
+	cfg := TimeoutConfig{
+		Default: 10 * time.Millisecond,
+		Max:     20 * time.Millisecond,
+		Methods: []MethodTimeout{
+			{Method: "/svc.S/Export", Default: time.Minute, Max: time.Hour},
+			{Method: "/grpc.health.v1.Health/Watch", Unbounded: true},
+		},
+	}
+	interceptor := StreamTimeoutInterceptor("customer", cfg, nil)
+
+	handler := func(_ interface{}, stream grpc.ServerStream) error {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-time.After(50 * time.Millisecond):
+		}
+		return nil
+	}
+
+	for _, method := range []string{"/svc.S/Export", "/grpc.health.v1.Health/Watch"} {
+		stream := &fakeServerStream{ctx: context.Background()}
+		if err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: method}, handler); err != nil {
+			t.Fatalf("%s: expected stream to outlive the unary default, got %v", method, err)
+		}
+	}
+
+	watch := func(_ interface{}, stream grpc.ServerStream) error {
+		if _, ok := stream.Context().Deadline(); ok {
+			return fmt.Errorf("unexpected deadline on watch stream")
+		}
+		return nil
+	}
+	stream := &fakeServerStream{ctx: context.Background()}
+	if err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/grpc.health.v1.Health/Watch"}, watch); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	stream = &fakeServerStream{ctx: context.Background()}
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/svc.S/Get"}, handler)
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("expected other streams to keep the default budget, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package mtls
+
+import (
+	"context"
+	"crypto/x509"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// Identity — проверенная личность клиента из его сертификата.
+type Identity struct {
+	CommonName string
+	DNSNames   []string
+	// URIs содержит URI SAN, например SPIFFE ID "spiffe://holo/ns/prod/sa/billing".
+	URIs []string
+}
+
+// Name возвращает основное имя: URI SAN, затем DNS SAN, затем CN.
+func (i Identity) Name() string {
+	if len(i.URIs) > 0 {
+		return i.URIs[0]
+	}
+	return i.hostName()
+}
+
+func (i Identity) hostName() string {
+	if len(i.DNSNames) > 0 {
+		return i.DNSNames[0]
+	}
+	return i.CommonName
+}
+
+func identityOf(cert *x509.Certificate) Identity {
+	id := Identity{CommonName: cert.Subject.CommonName, DNSNames: cert.DNSNames}
+	for _, uri := range cert.URIs {
+		id.URIs = append(id.URIs, uri.String())
+	}
+	return id
+}
+
+// PeerIdentity возвращает личность клиента вызова, если его сертификат прошёл проверку.
+func PeerIdentity(ctx context.Context) (Identity, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return Identity{}, false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return Identity{}, false
+	}
+	return identityOf(info.State.VerifiedChains[0][0]), true
+}
+
+// UnaryStripSelfInterceptor убирает личность клиента из контекста, если он предъявил
+// сертификат с именем самого сервиса. С таким сертификатом в собственный gRPC-порт
+// ходит REST-шлюз: его вызовы выполняются от имени пользователя из токена и не
+// должны получать права, выданные соседним сервисам по "peer".
+func (r *Reloader) UnaryStripSelfInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(r.stripSelf(ctx), req)
+	}
+}
+
+// StreamStripSelfInterceptor — вариант UnaryStripSelfInterceptor для потоковых вызовов.
+func (r *Reloader) StreamStripSelfInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := stream.Context()
+		if stripped := r.stripSelf(ctx); stripped != ctx {
+			stream = &contextStream{ServerStream: stream, ctx: stripped}
+		}
+		return handler(srv, stream)
+	}
+}
+
+func (r *Reloader) stripSelf(ctx context.Context) context.Context {
+	id, ok := PeerIdentity(ctx)
+	if !ok || id.Name() != identityOf(r.bundle().leaf).Name() {
+		return ctx
+	}
+
+	p, _ := peer.FromContext(ctx)
+	info := p.AuthInfo.(credentials.TLSInfo)
+	info.State.VerifiedChains = nil
+	stripped := *p
+	stripped.AuthInfo = info
+	return peer.NewContext(ctx, &stripped)
+}
+
+// contextStream подменяет контекст серверного потока.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
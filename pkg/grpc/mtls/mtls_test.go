@@ -0,0 +1,217 @@
+package mtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ca key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "holo test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("ca cert: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue выпускает сертификат, пригодный и для сервера, и для клиента.
+func (ca *testCA) issue(t *testing.T, cn string, dns []string, uri string) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("leaf key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     dns,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if uri != "" {
+		parsed, _ := url.Parse(uri)
+		tmpl.URIs = []*url.URL{parsed}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("leaf cert: %v", err)
+	}
+	rawKey, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: rawKey})
+}
+
+func writeFile(t *testing.T, path string, data []byte, mtime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+	if err := os.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatalf("chtimes %s: %v", path, err)
+	}
+}
+
+// serve поднимает health-сервер с TLS и возвращает функцию для вызова Check.
+func serve(t *testing.T, reloader *Reloader, seen chan<- Identity) func(creds credentials.TransportCredentials) (*peer.Peer, error) {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(
+		grpc.Creds(reloader.ServerCredentials()),
+		grpc.ChainUnaryInterceptor(
+			reloader.UnaryStripSelfInterceptor(),
+			func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				if id, ok := PeerIdentity(ctx); ok {
+					seen <- id
+				}
+				return handler(ctx, req)
+			},
+		),
+	)
+	healthpb.RegisterHealthServer(srv, grpchealth.NewServer())
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	return func(creds credentials.TransportCredentials) (*peer.Peer, error) {
+		conn, err := grpc.NewClient("passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+			grpc.WithTransportCredentials(creds),
+		)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		var p peer.Peer
+		_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{}, grpc.Peer(&p))
+		return &p, err
+	}
+}
+
+func TestReloaderMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	cfg := Config{
+		CertFile:          filepath.Join(dir, "tls.crt"),
+		KeyFile:           filepath.Join(dir, "tls.key"),
+		ClientCAFile:      filepath.Join(dir, "ca.crt"),
+		RequireClientCert: true,
+	}
+	mtime := time.Now().Add(-time.Minute)
+	serverCert, serverKey := ca.issue(t, "v1", []string{"customer-service"}, "")
+	writeFile(t, cfg.CertFile, serverCert, mtime)
+	writeFile(t, cfg.KeyFile, serverKey, mtime)
+	writeFile(t, cfg.ClientCAFile, ca.pem, mtime)
+
+	reloader, err := NewReloader(cfg, nil)
+	if err != nil {
+		t.Fatalf("new reloader: %v", err)
+	}
+	seen := make(chan Identity, 4)
+	call := serve(t, reloader, seen)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	clientCert, clientKey := ca.issue(t, "billing", nil, "spiffe://holo/billing")
+	pair, err := tls.X509KeyPair(clientCert, clientKey)
+	if err != nil {
+		t.Fatalf("client pair: %v", err)
+	}
+	client := credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{pair}, RootCAs: roots, ServerName: "customer-service"})
+
+	if _, err := call(client); err != nil {
+		t.Fatalf("mtls call: %v", err)
+	}
+	if id := <-seen; id.Name() != "spiffe://holo/billing" || id.CommonName != "billing" {
+		t.Fatalf("unexpected peer identity %+v", id)
+	}
+
+	anonymous := credentials.NewTLS(&tls.Config{RootCAs: roots, ServerName: "customer-service"})
+	if _, err := call(anonymous); err == nil {
+		t.Fatalf("expected call without client certificate to fail")
+	}
+
+	// Сервис обращается сам к себе (REST-шлюз) со своим же сертификатом; личность
+	// сервиса не должна доставаться вызовам шлюза.
+	if _, err := call(credentials.NewTLS(reloader.ClientTLSConfig(""))); err != nil {
+		t.Fatalf("self call: %v", err)
+	}
+	select {
+	case id := <-seen:
+		t.Fatalf("expected self identity to be stripped, got %+v", id)
+	default:
+	}
+
+	rotatedCert, rotatedKey := ca.issue(t, "v2", []string{"customer-service"}, "")
+	writeFile(t, cfg.CertFile, rotatedCert, time.Now())
+	writeFile(t, cfg.KeyFile, rotatedKey, time.Now())
+	if changed, err := reloader.Reload(); err != nil || !changed {
+		t.Fatalf("expected certificates to reload, changed=%v err=%v", changed, err)
+	}
+	if changed, _ := reloader.Reload(); changed {
+		t.Fatalf("unchanged files must not trigger reload")
+	}
+
+	p, err := call(client)
+	if err != nil {
+		t.Fatalf("call after rotation: %v", err)
+	}
+	<-seen
+	if cn := p.AuthInfo.(credentials.TLSInfo).State.PeerCertificates[0].Subject.CommonName; cn != "v2" {
+		t.Fatalf("expected rotated server certificate, got %q", cn)
+	}
+}
+
+func TestNewReloaderRequiresClientCA(t *testing.T) {
+	for _, cfg := range []Config{
+		{CertFile: "a", KeyFile: "b", RequireClientCert: true},
+		{CertFile: "a", KeyFile: "b"},
+	} {
+		if _, err := NewReloader(cfg, nil); err == nil {
+			t.Fatalf("expected error without client CA for %+v", cfg)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Package health собирает проверки зависимостей сервиса и публикует их как
+// HTTP-пробы Kubernetes и grpc.health.v1.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Status — итоговое состояние проверки или сервиса.
+type Status string
+
+const (
+	StatusUp       Status = "up"
+	StatusDegraded Status = "degraded"
+	StatusDown     Status = "down"
+)
+
+// ErrDegraded помечает частичную работоспособность зависимости (например, жёлтый
+// кластер OpenSearch): такая ошибка не переводит сервис в down даже для критичной проверки.
+var ErrDegraded = errors.New("degraded")
+
+// CheckFunc проверяет доступность зависимости.
+type CheckFunc func(ctx context.Context) error
+
+// Result — результат одной проверки.
+type Result struct {
+	Status     Status    `json:"status"`
+	Critical   bool      `json:"critical"`
+	Error      string    `json:"error,omitempty"`
+	DurationMS int64     `json:"duration_ms"`
+	CheckedAt  time.Time `json:"checked_at"`
+}
+
+// Report — сводное состояние сервиса.
+type Report struct {
+	Status Status            `json:"status"`
+	Checks map[string]Result `json:"checks"`
+}
+
+// Option настраивает отдельную проверку.
+type Option func(*check)
+
+// NonCritical помечает зависимость, без которой сервис работает в урезанном режиме:
+// её сбой переводит сервис в degraded, а не в down.
+func NonCritical() Option {
+	return func(c *check) { c.critical = false }
+}
+
+// WithTimeout переопределяет таймаут проверки.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *check) { c.timeout = timeout }
+}
+
+// WithCacheTTL переопределяет время, в течение которого результат переиспользуется.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(c *check) { c.ttl = ttl }
+}
+
+type check struct {
+	name     string
+	fn       CheckFunc
+	critical bool
+	timeout  time.Duration
+	ttl      time.Duration
+
+	// mu сериализует запуски: параллельные пробы ждут текущую проверку и берут её результат.
+	mu      sync.Mutex
+	last    Result
+	expires time.Time
+}
+
+// Registry хранит проверки зависимостей.
+type Registry struct {
+	mu      sync.RWMutex
+	checks  []*check
+	timeout time.Duration
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+// NewRegistry создаёт реестр с таймаутом и временем кэширования по умолчанию.
+func NewRegistry(timeout, cacheTTL time.Duration) *Registry {
+	return &Registry{timeout: timeout, ttl: cacheTTL, now: time.Now}
+}
+
+// WithClock переопределяет источник времени (для тестов).
+func (r *Registry) WithClock(now func() time.Time) *Registry {
+	r.now = now
+	return r
+}
+
+// Register добавляет проверку. По умолчанию зависимость считается критичной.
+func (r *Registry) Register(name string, fn CheckFunc, opts ...Option) {
+	c := &check{name: name, fn: fn, critical: true, timeout: r.timeout, ttl: r.ttl}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks = append(r.checks, c)
+}
+
+// Check выполняет все проверки параллельно, используя кэш там, где результат свеж.
+func (r *Registry) Check(ctx context.Context) Report {
+	r.mu.RLock()
+	checks := append([]*check(nil), r.checks...)
+	r.mu.RUnlock()
+
+	results := make([]Result, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = r.run(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusUp, Checks: make(map[string]Result, len(checks))}
+	for i, c := range checks {
+		report.Checks[c.name] = results[i]
+		report.Status = worst(report.Status, results[i].Status)
+	}
+	return report
+}
+
+// Names возвращает имена зарегистрированных проверок в алфавитном порядке.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.checks))
+	for _, c := range r.checks {
+		names = append(names, c.name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *Registry) run(ctx context.Context, c *check) Result {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := r.now()
+	if now.Before(c.expires) {
+		return c.last
+	}
+
+	// Результат кэшируется для всех вызывающих, поэтому отмена одного запроса не должна его портить.
+	checkCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+	defer cancel()
+
+	started := time.Now()
+	err := safeRun(checkCtx, c.fn)
+	result := Result{
+		Status:     StatusUp,
+		Critical:   c.critical,
+		DurationMS: time.Since(started).Milliseconds(),
+		CheckedAt:  now,
+	}
+	if err != nil {
+		result.Error = err.Error()
+		switch {
+		case errors.Is(err, ErrDegraded), !c.critical:
+			result.Status = StatusDegraded
+		default:
+			result.Status = StatusDown
+		}
+	}
+
+	c.last = result
+	c.expires = now.Add(c.ttl)
+	return result
+}
+
+// safeRun не даёт зависшей или паникующей проверке заблокировать пробу.
+func safeRun(ctx context.Context, fn CheckFunc) error {
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if rec := recover(); rec != nil {
+				done <- fmt.Errorf("health check panicked: %v", rec)
+			}
+		}()
+		done <- fn(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("health check timed out: %w", ctx.Err())
+	}
+}
+
+func worst(a, b Status) Status {
+	rank := map[Status]int{StatusUp: 0, StatusDegraded: 1, StatusDown: 2}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
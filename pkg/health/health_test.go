@@ -0,0 +1,128 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestRegistryAggregatesStatuses(t *testing.T) {
+	registry := NewRegistry(time.Second, 0)
+	registry.Register("postgres", func(context.Context) error { return nil })
+	registry.Register("search", func(context.Context) error { return errors.New("connection refused") }, NonCritical())
+
+	report := registry.Check(context.Background())
+	if report.Status != StatusDegraded {
+		t.Fatalf("expected degraded, got %s", report.Status)
+	}
+	if report.Checks["search"].Error == "" || report.Checks["search"].Critical {
+		t.Fatalf("unexpected search result %+v", report.Checks["search"])
+	}
+
+	registry.Register("kafka", func(context.Context) error { return errors.New("no brokers") })
+	if report := registry.Check(context.Background()); report.Status != StatusDown {
+		t.Fatalf("expected down, got %s", report.Status)
+	}
+}
+
+func TestRegistryDegradedErrorOnCriticalCheck(t *testing.T) {
+	registry := NewRegistry(time.Second, 0)
+	registry.Register("opensearch", func(context.Context) error { return fmt.Errorf("cluster yellow: %w", ErrDegraded) })
+
+	if report := registry.Check(context.Background()); report.Status != StatusDegraded {
+		t.Fatalf("expected degraded, got %s", report.Status)
+	}
+}
+
+func TestRegistryCachesResults(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	registry := NewRegistry(time.Second, 5*time.Second).WithClock(func() time.Time { return now })
+
+	calls := 0
+	registry.Register("postgres", func(context.Context) error {
+		calls++
+		return nil
+	})
+
+	registry.Check(context.Background())
+	registry.Check(context.Background())
+	if calls != 1 {
+		t.Fatalf("expected cached result, got %d calls", calls)
+	}
+
+	now = now.Add(6 * time.Second)
+	registry.Check(context.Background())
+	if calls != 2 {
+		t.Fatalf("expected check to rerun after ttl, got %d calls", calls)
+	}
+}
+
+func TestRegistryTimesOutAndRecovers(t *testing.T) {
+	registry := NewRegistry(20*time.Millisecond, 0)
+	registry.Register("mongo", func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	})
+	registry.Register("kafka", func(context.Context) error { panic("boom") })
+
+	report := registry.Check(context.Background())
+	if report.Checks["mongo"].Status != StatusDown || report.Checks["kafka"].Status != StatusDown {
+		t.Fatalf("expected timed out and panicking checks to be down, got %+v", report.Checks)
+	}
+}
+
+func TestReadinessHandler(t *testing.T) {
+	registry := NewRegistry(time.Second, 0)
+	healthy := true
+	registry.Register("postgres", func(context.Context) error {
+		if healthy {
+			return nil
+		}
+		return errors.New("down")
+	})
+
+	rec := httptest.NewRecorder()
+	registry.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	healthy = false
+	rec = httptest.NewRecorder()
+	registry.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected liveness to ignore dependencies, got %d", rec.Code)
+	}
+}
+
+func TestSyncUpdatesGRPCHealth(t *testing.T) {
+	registry := NewRegistry(time.Second, 0)
+	registry.Register("postgres", func(context.Context) error { return errors.New("down") })
+	srv := grpchealth.NewServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	registry.Sync(ctx, srv, time.Minute, "holo.customer.v1.CustomerService")
+
+	resp, err := srv.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "holo.customer.v1.CustomerService"})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected NOT_SERVING, got %s", resp.GetStatus())
+	}
+}
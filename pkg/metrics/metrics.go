@@ -14,6 +14,17 @@ type Collector struct {
 	registry *prometheus.Registry
 	latency  *prometheus.HistogramVec
 	counter  *prometheus.CounterVec
+	denied   *prometheus.CounterVec
+	limited  *prometheus.CounterVec
+	messages *prometheus.CounterVec
+	msgSize  *prometheus.HistogramVec
+	panics   *prometheus.CounterVec
+	deadline *prometheus.CounterVec
+	client   *prometheus.HistogramVec
+	breaker  *prometheus.GaugeVec
+	limit    *prometheus.GaugeVec
+	inFlight *prometheus.GaugeVec
+	shed     *prometheus.CounterVec
 }
 
 // Option конфигурирует сборщик метрик.
@@ -51,6 +62,74 @@ func NewCollector(opts ...Option) *Collector {
 		Help:      "Total number of requests by service and endpoint.",
 	}, []string{"service", "endpoint", "status"})
 
+	collector.denied = promauto.With(collector.registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: "holo",
+		Name:      "authz_denied_total",
+		Help:      "Total number of RPCs denied by authorization policies.",
+	}, []string{"service", "endpoint", "reason"})
+
+	collector.limited = promauto.With(collector.registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: "holo",
+		Name:      "rate_limited_total",
+		Help:      "Total number of RPCs rejected by rate limits.",
+	}, []string{"service", "endpoint"})
+
+	collector.messages = promauto.With(collector.registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: "holo",
+		Name:      "stream_messages_total",
+		Help:      "Total number of streaming RPC messages by direction.",
+	}, []string{"service", "endpoint", "direction"})
+
+	collector.msgSize = promauto.With(collector.registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "holo",
+		Name:      "stream_message_size_bytes",
+		Help:      "Histogram of streaming RPC message sizes.",
+		Buckets:   prometheus.ExponentialBuckets(64, 4, 10),
+	}, []string{"service", "endpoint", "direction"})
+
+	collector.panics = promauto.With(collector.registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: "holo",
+		Name:      "panics_total",
+		Help:      "Total number of panics recovered in RPC handlers.",
+	}, []string{"service", "endpoint"})
+
+	collector.deadline = promauto.With(collector.registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: "holo",
+		Name:      "deadline_exceeded_total",
+		Help:      "Total number of RPCs that ran out of deadline, by the stage that exhausted it.",
+	}, []string{"service", "endpoint", "stage"})
+
+	collector.client = promauto.With(collector.registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "holo",
+		Name:      "client_request_latency_seconds",
+		Help:      "Histogram of outgoing RPC latencies by target service.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 15),
+	}, []string{"target", "endpoint", "status"})
+
+	collector.breaker = promauto.With(collector.registry).NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "holo",
+		Name:      "client_breaker_open",
+		Help:      "Circuit breaker state by target service: 0 closed, 1 open, 0.5 half-open.",
+	}, []string{"target"})
+
+	collector.limit = promauto.With(collector.registry).NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "holo",
+		Name:      "concurrency_limit",
+		Help:      "Current adaptive concurrency limit.",
+	}, []string{"service"})
+
+	collector.inFlight = promauto.With(collector.registry).NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "holo",
+		Name:      "concurrency_in_flight",
+		Help:      "Number of RPCs currently being handled.",
+	}, []string{"service"})
+
+	collector.shed = promauto.With(collector.registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: "holo",
+		Name:      "concurrency_shed_total",
+		Help:      "Total number of RPCs rejected by the concurrency limiter.",
+	}, []string{"service", "endpoint", "priority"})
+
 	return collector
 }
 
@@ -60,6 +139,53 @@ func (c *Collector) TrackDuration(service, endpoint, status string, started time
 	c.counter.WithLabelValues(service, endpoint, status).Inc()
 }
 
+// TrackDenied учитывает отказ в доступе к методу.
+func (c *Collector) TrackDenied(service, endpoint, reason string) {
+	c.denied.WithLabelValues(service, endpoint, reason).Inc()
+}
+
+// TrackRateLimited учитывает запрос, отклонённый ограничением частоты.
+func (c *Collector) TrackRateLimited(service, endpoint string) {
+	c.limited.WithLabelValues(service, endpoint).Inc()
+}
+
+// TrackStreamMessage учитывает сообщение потокового вызова; direction — "sent" или "received".
+func (c *Collector) TrackStreamMessage(service, endpoint, direction string, size int) {
+	c.messages.WithLabelValues(service, endpoint, direction).Inc()
+	c.msgSize.WithLabelValues(service, endpoint, direction).Observe(float64(size))
+}
+
+// TrackPanic учитывает перехваченную панику обработчика.
+func (c *Collector) TrackPanic(service, endpoint string) {
+	c.panics.WithLabelValues(service, endpoint).Inc()
+}
+
+// TrackDeadlineExceeded учитывает вызов с истёкшим дедлайном и этап, на котором это случилось.
+func (c *Collector) TrackDeadlineExceeded(service, endpoint, stage string) {
+	c.deadline.WithLabelValues(service, endpoint, stage).Inc()
+}
+
+// TrackClientDuration записывает latency исходящего вызова к сервису target.
+func (c *Collector) TrackClientDuration(target, endpoint, status string, started time.Time) {
+	c.client.WithLabelValues(target, endpoint, status).Observe(time.Since(started).Seconds())
+}
+
+// TrackBreakerState публикует состояние автоматического выключателя клиента.
+func (c *Collector) TrackBreakerState(target string, value float64) {
+	c.breaker.WithLabelValues(target).Set(value)
+}
+
+// TrackConcurrency публикует текущий лимит и число обрабатываемых запросов.
+func (c *Collector) TrackConcurrency(service string, limit, inFlight int) {
+	c.limit.WithLabelValues(service).Set(float64(limit))
+	c.inFlight.WithLabelValues(service).Set(float64(inFlight))
+}
+
+// TrackShed учитывает запрос, отклонённый лимитером одновременных вызовов.
+func (c *Collector) TrackShed(service, endpoint, priority string) {
+	c.shed.WithLabelValues(service, endpoint, priority).Inc()
+}
+
 // Handler возвращает http.Handler для /metrics.
 func (c *Collector) Handler() http.Handler {
 	return promhttp.HandlerFor(c.registry, promhttp.HandlerOpts{})
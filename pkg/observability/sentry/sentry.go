@@ -59,6 +59,18 @@ func (c *Client) CaptureError(err error) {
 	sentry.CaptureException(err)
 }
 
+// CapturePanic отправляет перехваченную панику со стеком и тегами события.
+func (c *Client) CapturePanic(recovered interface{}, tags map[string]string) {
+	if !c.Enabled() || recovered == nil {
+		return
+	}
+	hub := sentry.CurrentHub().Clone()
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetTags(tags)
+	})
+	hub.Recover(recovered)
+}
+
 // CaptureMessage отправляет произвольное сообщение в Sentry.
 func (c *Client) CaptureMessage(msg string) {
 	if !c.enabled || msg == "" {
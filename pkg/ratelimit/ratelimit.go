@@ -0,0 +1,75 @@
+// Package ratelimit реализует ограничение частоты вызовов по алгоритму token bucket
+// с хранением корзин в памяти процесса или в Redis.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit — параметры корзины: Rate токенов в секунду и ёмкость Burst.
+// Нулевой Rate означает отсутствие ограничения.
+type Limit struct {
+	Rate  float64 `mapstructure:"rate"`
+	Burst int     `mapstructure:"burst"`
+}
+
+// Unlimited сообщает, что ограничение не задано.
+func (l Limit) Unlimited() bool {
+	return l.Rate <= 0
+}
+
+func (l Limit) burst() float64 {
+	if l.Burst < 1 {
+		return 1
+	}
+	return float64(l.Burst)
+}
+
+// Rule переопределяет лимит для метода, субъекта или их пары. Пустое поле совпадает с любым значением.
+type Rule struct {
+	Method  string `mapstructure:"method"`
+	Subject string `mapstructure:"subject"`
+	Limit   `mapstructure:",squash"`
+}
+
+// Config описывает лимиты и загружается через pkg/config. Корзина заводится на каждую
+// пару (субъект, метод); правила лишь выбирают её параметры.
+type Config struct {
+	Default Limit  `mapstructure:"default"`
+	Rules   []Rule `mapstructure:"rules"`
+}
+
+// LimitFor выбирает самое специфичное правило: субъект и метод, затем метод, затем
+// субъект, затем Default.
+func (c Config) LimitFor(method, subject string) Limit {
+	best, bestScore := c.Default, 0
+	for _, rule := range c.Rules {
+		if (rule.Method != "" && rule.Method != method) || (rule.Subject != "" && rule.Subject != subject) {
+			continue
+		}
+		score := 1
+		if rule.Subject != "" {
+			score++
+		}
+		if rule.Method != "" {
+			score += 2
+		}
+		if score > bestScore {
+			best, bestScore = rule.Limit, score
+		}
+	}
+	return best
+}
+
+// Result — решение по одному запросу.
+type Result struct {
+	Allowed bool
+	// RetryAfter — через сколько в корзине появится токен; заполняется при отказе.
+	RetryAfter time.Duration
+}
+
+// Limiter списывает токен из корзины key.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestConfigLimitForPrefersSpecificRule(t *testing.T) {
+	cfg := Config{
+		Default: Limit{Rate: 50, Burst: 100},
+		Rules: []Rule{
+			{Method: "/svc.S/Register", Limit: Limit{Rate: 5, Burst: 10}},
+			{Subject: "partner-1", Limit: Limit{Rate: 20, Burst: 20}},
+			{Method: "/svc.S/Register", Subject: "partner-1", Limit: Limit{Rate: 1, Burst: 2}},
+		},
+	}
+
+	cases := []struct {
+		method, subject string
+		want            float64
+	}{
+		{"/svc.S/Get", "staff-1", 50},
+		{"/svc.S/Register", "staff-1", 5},
+		{"/svc.S/Get", "partner-1", 20},
+		{"/svc.S/Register", "partner-1", 1},
+	}
+	for _, tc := range cases {
+		if got := cfg.LimitFor(tc.method, tc.subject).Rate; got != tc.want {
+			t.Fatalf("%s %s: expected rate %v, got %v", tc.subject, tc.method, tc.want, got)
+		}
+	}
+}
+
+func TestMemoryTokenBucket(t *testing.T) {
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	limiter := NewMemory().WithClock(func() time.Time { return now })
+	limit := Limit{Rate: 2, Burst: 3}
+
+	for i := 0; i < 3; i++ {
+		if res, _ := limiter.Allow(context.Background(), "k", limit); !res.Allowed {
+			t.Fatalf("request %d within burst rejected", i)
+		}
+	}
+	res, _ := limiter.Allow(context.Background(), "k", limit)
+	if res.Allowed || res.RetryAfter != 500*time.Millisecond {
+		t.Fatalf("expected rejection with 500ms retry, got %+v", res)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if res, _ := limiter.Allow(context.Background(), "k", limit); !res.Allowed {
+		t.Fatalf("expected token to be refilled")
+	}
+	if res, _ := limiter.Allow(context.Background(), "other", limit); !res.Allowed {
+		t.Fatalf("buckets must be independent")
+	}
+
+	now = now.Add(time.Hour)
+	limiter.Allow(context.Background(), "k", limit)
+	if len(limiter.buckets) != 1 {
+		t.Fatalf("expected idle buckets to be swept, got %d", len(limiter.buckets))
+	}
+}
+
+func TestRedisTokenBucket(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+
+	limiter := NewRedis(client, "rl:")
+	limit := Limit{Rate: 1, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		res, err := limiter.Allow(context.Background(), "partner|register", limit)
+		if err != nil {
+			t.Fatalf("allow: %v", err)
+		}
+		if !res.Allowed {
+			t.Fatalf("request %d within burst rejected", i)
+		}
+	}
+	res, err := limiter.Allow(context.Background(), "partner|register", limit)
+	if err != nil {
+		t.Fatalf("allow: %v", err)
+	}
+	if res.Allowed || res.RetryAfter <= 0 || res.RetryAfter > time.Second {
+		t.Fatalf("expected rejection with retry up to 1s, got %+v", res)
+	}
+	if ttl := server.TTL("rl:partner|register"); ttl <= 0 {
+		t.Fatalf("expected bucket key to expire, got ttl %v", ttl)
+	}
+}
@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/grpc/metadata"
+)
+
+// Propagator — формат передачи контекста трассировки между сервисами (W3C traceparent и baggage).
+var Propagator propagation.TextMapPropagator = propagation.NewCompositeTextMapPropagator(
+	propagation.TraceContext{},
+	propagation.Baggage{},
+)
+
+// MetadataCarrier позволяет переносить контекст трассировки в gRPC-метаданных.
+type MetadataCarrier metadata.MD
+
+// Get возвращает первое значение ключа.
+func (c MetadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Set заменяет значение ключа.
+func (c MetadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// Keys перечисляет ключи метаданных.
+func (c MetadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -52,6 +52,7 @@ func InitProvider(ctx context.Context, cfg Config) (Provider, error) {
 	)
 
 	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(Propagator)
 
 	return provider, nil
 }
@@ -0,0 +1,1354 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: customer/v1/customer.proto
+
+// Контракт customer-service. Несовместимые изменения выпускаются в новом
+// пакете (holo.customer.v2), v1 поддерживается до отключения клиентов.
+// Персональные данные, медицинские ответы и подписанные ссылки помечены
+// debug_redact и маскируются при журналировании.
+
+package customerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CustomerService_RegisterCustomer_FullMethodName           = "/holo.customer.v1.CustomerService/RegisterCustomer"
+	CustomerService_GetCustomer_FullMethodName                = "/holo.customer.v1.CustomerService/GetCustomer"
+	CustomerService_UpdateCustomer_FullMethodName             = "/holo.customer.v1.CustomerService/UpdateCustomer"
+	CustomerService_GetCustomerAsOf_FullMethodName            = "/holo.customer.v1.CustomerService/GetCustomerAsOf"
+	CustomerService_GetCustomerHistory_FullMethodName         = "/holo.customer.v1.CustomerService/GetCustomerHistory"
+	CustomerService_ListCustomers_FullMethodName              = "/holo.customer.v1.CustomerService/ListCustomers"
+	CustomerService_ExportCustomers_FullMethodName            = "/holo.customer.v1.CustomerService/ExportCustomers"
+	CustomerService_ImportCustomers_FullMethodName            = "/holo.customer.v1.CustomerService/ImportCustomers"
+	CustomerService_EraseCustomer_FullMethodName              = "/holo.customer.v1.CustomerService/EraseCustomer"
+	CustomerService_FindDuplicateCandidates_FullMethodName    = "/holo.customer.v1.CustomerService/FindDuplicateCandidates"
+	CustomerService_MergeCustomers_FullMethodName             = "/holo.customer.v1.CustomerService/MergeCustomers"
+	CustomerService_CreateHousehold_FullMethodName            = "/holo.customer.v1.CustomerService/CreateHousehold"
+	CustomerService_AddHouseholdMember_FullMethodName         = "/holo.customer.v1.CustomerService/AddHouseholdMember"
+	CustomerService_RemoveHouseholdMember_FullMethodName      = "/holo.customer.v1.CustomerService/RemoveHouseholdMember"
+	CustomerService_TransferHouseholdMember_FullMethodName    = "/holo.customer.v1.CustomerService/TransferHouseholdMember"
+	CustomerService_GetCustomerHousehold_FullMethodName       = "/holo.customer.v1.CustomerService/GetCustomerHousehold"
+	CustomerService_UpdateConsent_FullMethodName              = "/holo.customer.v1.CustomerService/UpdateConsent"
+	CustomerService_UpdatePreferences_FullMethodName          = "/holo.customer.v1.CustomerService/UpdatePreferences"
+	CustomerService_ListContactableCustomers_FullMethodName   = "/holo.customer.v1.CustomerService/ListContactableCustomers"
+	CustomerService_ListReferrals_FullMethodName              = "/holo.customer.v1.CustomerService/ListReferrals"
+	CustomerService_AddTimelineEntry_FullMethodName           = "/holo.customer.v1.CustomerService/AddTimelineEntry"
+	CustomerService_EditTimelineEntry_FullMethodName          = "/holo.customer.v1.CustomerService/EditTimelineEntry"
+	CustomerService_DeleteTimelineEntry_FullMethodName        = "/holo.customer.v1.CustomerService/DeleteTimelineEntry"
+	CustomerService_GetCustomerTimeline_FullMethodName        = "/holo.customer.v1.CustomerService/GetCustomerTimeline"
+	CustomerService_PublishHealthQuestionnaire_FullMethodName = "/holo.customer.v1.CustomerService/PublishHealthQuestionnaire"
+	CustomerService_SubmitHealthQuestionnaire_FullMethodName  = "/holo.customer.v1.CustomerService/SubmitHealthQuestionnaire"
+	CustomerService_GetHealthStatus_FullMethodName            = "/holo.customer.v1.CustomerService/GetHealthStatus"
+	CustomerService_GetHealthSubmission_FullMethodName        = "/holo.customer.v1.CustomerService/GetHealthSubmission"
+	CustomerService_UploadAttachment_FullMethodName           = "/holo.customer.v1.CustomerService/UploadAttachment"
+	CustomerService_ListAttachments_FullMethodName            = "/holo.customer.v1.CustomerService/ListAttachments"
+	CustomerService_GetAttachmentURL_FullMethodName           = "/holo.customer.v1.CustomerService/GetAttachmentURL"
+	CustomerService_DeleteAttachment_FullMethodName           = "/holo.customer.v1.CustomerService/DeleteAttachment"
+	CustomerService_QueryAuditLog_FullMethodName              = "/holo.customer.v1.CustomerService/QueryAuditLog"
+)
+
+// CustomerServiceClient is the client API for CustomerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CustomerService управляет профилями клиентов сети.
+type CustomerServiceClient interface {
+	// Профиль клиента.
+	RegisterCustomer(ctx context.Context, in *RegisterCustomerRequest, opts ...grpc.CallOption) (*RegisterCustomerResponse, error)
+	GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*GetCustomerResponse, error)
+	UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*UpdateCustomerResponse, error)
+	GetCustomerAsOf(ctx context.Context, in *GetCustomerAsOfRequest, opts ...grpc.CallOption) (*GetCustomerResponse, error)
+	GetCustomerHistory(ctx context.Context, in *GetCustomerHistoryRequest, opts ...grpc.CallOption) (*GetCustomerHistoryResponse, error)
+	ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error)
+	ExportCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CustomerSummary], error)
+	ImportCustomers(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCustomersRequest, ImportCustomersResponse], error)
+	EraseCustomer(ctx context.Context, in *EraseCustomerRequest, opts ...grpc.CallOption) (*EraseCustomerResponse, error)
+	// Дубликаты.
+	FindDuplicateCandidates(ctx context.Context, in *FindDuplicateCandidatesRequest, opts ...grpc.CallOption) (*FindDuplicateCandidatesResponse, error)
+	MergeCustomers(ctx context.Context, in *MergeCustomersRequest, opts ...grpc.CallOption) (*MergeCustomersResponse, error)
+	// Домохозяйства.
+	CreateHousehold(ctx context.Context, in *CreateHouseholdRequest, opts ...grpc.CallOption) (*CreateHouseholdResponse, error)
+	AddHouseholdMember(ctx context.Context, in *AddHouseholdMemberRequest, opts ...grpc.CallOption) (*HouseholdMemberResponse, error)
+	RemoveHouseholdMember(ctx context.Context, in *RemoveHouseholdMemberRequest, opts ...grpc.CallOption) (*HouseholdMemberResponse, error)
+	TransferHouseholdMember(ctx context.Context, in *TransferHouseholdMemberRequest, opts ...grpc.CallOption) (*HouseholdMemberResponse, error)
+	GetCustomerHousehold(ctx context.Context, in *GetCustomerHouseholdRequest, opts ...grpc.CallOption) (*Household, error)
+	// Согласия и предпочтения коммуникаций.
+	UpdateConsent(ctx context.Context, in *UpdateConsentRequest, opts ...grpc.CallOption) (*UpdateConsentResponse, error)
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error)
+	ListContactableCustomers(ctx context.Context, in *ListContactableCustomersRequest, opts ...grpc.CallOption) (*ListContactableCustomersResponse, error)
+	// Реферальная программа.
+	ListReferrals(ctx context.Context, in *ListReferralsRequest, opts ...grpc.CallOption) (*ListReferralsResponse, error)
+	// Лента взаимодействий.
+	AddTimelineEntry(ctx context.Context, in *AddTimelineEntryRequest, opts ...grpc.CallOption) (*AddTimelineEntryResponse, error)
+	EditTimelineEntry(ctx context.Context, in *EditTimelineEntryRequest, opts ...grpc.CallOption) (*EditTimelineEntryResponse, error)
+	DeleteTimelineEntry(ctx context.Context, in *DeleteTimelineEntryRequest, opts ...grpc.CallOption) (*DeleteTimelineEntryResponse, error)
+	GetCustomerTimeline(ctx context.Context, in *GetCustomerTimelineRequest, opts ...grpc.CallOption) (*GetCustomerTimelineResponse, error)
+	// Анкеты здоровья.
+	PublishHealthQuestionnaire(ctx context.Context, in *PublishHealthQuestionnaireRequest, opts ...grpc.CallOption) (*PublishHealthQuestionnaireResponse, error)
+	SubmitHealthQuestionnaire(ctx context.Context, in *SubmitHealthQuestionnaireRequest, opts ...grpc.CallOption) (*SubmitHealthQuestionnaireResponse, error)
+	GetHealthStatus(ctx context.Context, in *GetHealthStatusRequest, opts ...grpc.CallOption) (*HealthStatus, error)
+	GetHealthSubmission(ctx context.Context, in *GetHealthStatusRequest, opts ...grpc.CallOption) (*GetHealthSubmissionResponse, error)
+	// Вложения.
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment], error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	GetAttachmentURL(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*GetAttachmentURLResponse, error)
+	DeleteAttachment(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	// Журнал аудита.
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+}
+
+type customerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCustomerServiceClient(cc grpc.ClientConnInterface) CustomerServiceClient {
+	return &customerServiceClient{cc}
+}
+
+func (c *customerServiceClient) RegisterCustomer(ctx context.Context, in *RegisterCustomerRequest, opts ...grpc.CallOption) (*RegisterCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_RegisterCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*GetCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*UpdateCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_UpdateCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetCustomerAsOf(ctx context.Context, in *GetCustomerAsOfRequest, opts ...grpc.CallOption) (*GetCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetCustomerAsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetCustomerHistory(ctx context.Context, in *GetCustomerHistoryRequest, opts ...grpc.CallOption) (*GetCustomerHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerHistoryResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetCustomerHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomersResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ExportCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CustomerSummary], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CustomerService_ServiceDesc.Streams[0], CustomerService_ExportCustomers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListCustomersRequest, CustomerSummary]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_ExportCustomersClient = grpc.ServerStreamingClient[CustomerSummary]
+
+func (c *customerServiceClient) ImportCustomers(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCustomersRequest, ImportCustomersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CustomerService_ServiceDesc.Streams[1], CustomerService_ImportCustomers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportCustomersRequest, ImportCustomersResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_ImportCustomersClient = grpc.ClientStreamingClient[ImportCustomersRequest, ImportCustomersResponse]
+
+func (c *customerServiceClient) EraseCustomer(ctx context.Context, in *EraseCustomerRequest, opts ...grpc.CallOption) (*EraseCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_EraseCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) FindDuplicateCandidates(ctx context.Context, in *FindDuplicateCandidatesRequest, opts ...grpc.CallOption) (*FindDuplicateCandidatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDuplicateCandidatesResponse)
+	err := c.cc.Invoke(ctx, CustomerService_FindDuplicateCandidates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) MergeCustomers(ctx context.Context, in *MergeCustomersRequest, opts ...grpc.CallOption) (*MergeCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCustomersResponse)
+	err := c.cc.Invoke(ctx, CustomerService_MergeCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) CreateHousehold(ctx context.Context, in *CreateHouseholdRequest, opts ...grpc.CallOption) (*CreateHouseholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateHouseholdResponse)
+	err := c.cc.Invoke(ctx, CustomerService_CreateHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) AddHouseholdMember(ctx context.Context, in *AddHouseholdMemberRequest, opts ...grpc.CallOption) (*HouseholdMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseholdMemberResponse)
+	err := c.cc.Invoke(ctx, CustomerService_AddHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) RemoveHouseholdMember(ctx context.Context, in *RemoveHouseholdMemberRequest, opts ...grpc.CallOption) (*HouseholdMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseholdMemberResponse)
+	err := c.cc.Invoke(ctx, CustomerService_RemoveHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) TransferHouseholdMember(ctx context.Context, in *TransferHouseholdMemberRequest, opts ...grpc.CallOption) (*HouseholdMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseholdMemberResponse)
+	err := c.cc.Invoke(ctx, CustomerService_TransferHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetCustomerHousehold(ctx context.Context, in *GetCustomerHouseholdRequest, opts ...grpc.CallOption) (*Household, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Household)
+	err := c.cc.Invoke(ctx, CustomerService_GetCustomerHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) UpdateConsent(ctx context.Context, in *UpdateConsentRequest, opts ...grpc.CallOption) (*UpdateConsentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateConsentResponse)
+	err := c.cc.Invoke(ctx, CustomerService_UpdateConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePreferencesResponse)
+	err := c.cc.Invoke(ctx, CustomerService_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListContactableCustomers(ctx context.Context, in *ListContactableCustomersRequest, opts ...grpc.CallOption) (*ListContactableCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContactableCustomersResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListContactableCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListReferrals(ctx context.Context, in *ListReferralsRequest, opts ...grpc.CallOption) (*ListReferralsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReferralsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListReferrals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) AddTimelineEntry(ctx context.Context, in *AddTimelineEntryRequest, opts ...grpc.CallOption) (*AddTimelineEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTimelineEntryResponse)
+	err := c.cc.Invoke(ctx, CustomerService_AddTimelineEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) EditTimelineEntry(ctx context.Context, in *EditTimelineEntryRequest, opts ...grpc.CallOption) (*EditTimelineEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditTimelineEntryResponse)
+	err := c.cc.Invoke(ctx, CustomerService_EditTimelineEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) DeleteTimelineEntry(ctx context.Context, in *DeleteTimelineEntryRequest, opts ...grpc.CallOption) (*DeleteTimelineEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTimelineEntryResponse)
+	err := c.cc.Invoke(ctx, CustomerService_DeleteTimelineEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetCustomerTimeline(ctx context.Context, in *GetCustomerTimelineRequest, opts ...grpc.CallOption) (*GetCustomerTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerTimelineResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetCustomerTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) PublishHealthQuestionnaire(ctx context.Context, in *PublishHealthQuestionnaireRequest, opts ...grpc.CallOption) (*PublishHealthQuestionnaireResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishHealthQuestionnaireResponse)
+	err := c.cc.Invoke(ctx, CustomerService_PublishHealthQuestionnaire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) SubmitHealthQuestionnaire(ctx context.Context, in *SubmitHealthQuestionnaireRequest, opts ...grpc.CallOption) (*SubmitHealthQuestionnaireResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitHealthQuestionnaireResponse)
+	err := c.cc.Invoke(ctx, CustomerService_SubmitHealthQuestionnaire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetHealthStatus(ctx context.Context, in *GetHealthStatusRequest, opts ...grpc.CallOption) (*HealthStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthStatus)
+	err := c.cc.Invoke(ctx, CustomerService_GetHealthStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetHealthSubmission(ctx context.Context, in *GetHealthStatusRequest, opts ...grpc.CallOption) (*GetHealthSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHealthSubmissionResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetHealthSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CustomerService_ServiceDesc.Streams[2], CustomerService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, Attachment]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment]
+
+func (c *customerServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetAttachmentURL(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*GetAttachmentURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttachmentURLResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetAttachmentURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) DeleteAttachment(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, CustomerService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, CustomerService_QueryAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CustomerServiceServer is the server API for CustomerService service.
+// All implementations must embed UnimplementedCustomerServiceServer
+// for forward compatibility.
+//
+// CustomerService управляет профилями клиентов сети.
+type CustomerServiceServer interface {
+	// Профиль клиента.
+	RegisterCustomer(context.Context, *RegisterCustomerRequest) (*RegisterCustomerResponse, error)
+	GetCustomer(context.Context, *GetCustomerRequest) (*GetCustomerResponse, error)
+	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error)
+	GetCustomerAsOf(context.Context, *GetCustomerAsOfRequest) (*GetCustomerResponse, error)
+	GetCustomerHistory(context.Context, *GetCustomerHistoryRequest) (*GetCustomerHistoryResponse, error)
+	ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error)
+	ExportCustomers(*ListCustomersRequest, grpc.ServerStreamingServer[CustomerSummary]) error
+	ImportCustomers(grpc.ClientStreamingServer[ImportCustomersRequest, ImportCustomersResponse]) error
+	EraseCustomer(context.Context, *EraseCustomerRequest) (*EraseCustomerResponse, error)
+	// Дубликаты.
+	FindDuplicateCandidates(context.Context, *FindDuplicateCandidatesRequest) (*FindDuplicateCandidatesResponse, error)
+	MergeCustomers(context.Context, *MergeCustomersRequest) (*MergeCustomersResponse, error)
+	// Домохозяйства.
+	CreateHousehold(context.Context, *CreateHouseholdRequest) (*CreateHouseholdResponse, error)
+	AddHouseholdMember(context.Context, *AddHouseholdMemberRequest) (*HouseholdMemberResponse, error)
+	RemoveHouseholdMember(context.Context, *RemoveHouseholdMemberRequest) (*HouseholdMemberResponse, error)
+	TransferHouseholdMember(context.Context, *TransferHouseholdMemberRequest) (*HouseholdMemberResponse, error)
+	GetCustomerHousehold(context.Context, *GetCustomerHouseholdRequest) (*Household, error)
+	// Согласия и предпочтения коммуникаций.
+	UpdateConsent(context.Context, *UpdateConsentRequest) (*UpdateConsentResponse, error)
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error)
+	ListContactableCustomers(context.Context, *ListContactableCustomersRequest) (*ListContactableCustomersResponse, error)
+	// Реферальная программа.
+	ListReferrals(context.Context, *ListReferralsRequest) (*ListReferralsResponse, error)
+	// Лента взаимодействий.
+	AddTimelineEntry(context.Context, *AddTimelineEntryRequest) (*AddTimelineEntryResponse, error)
+	EditTimelineEntry(context.Context, *EditTimelineEntryRequest) (*EditTimelineEntryResponse, error)
+	DeleteTimelineEntry(context.Context, *DeleteTimelineEntryRequest) (*DeleteTimelineEntryResponse, error)
+	GetCustomerTimeline(context.Context, *GetCustomerTimelineRequest) (*GetCustomerTimelineResponse, error)
+	// Анкеты здоровья.
+	PublishHealthQuestionnaire(context.Context, *PublishHealthQuestionnaireRequest) (*PublishHealthQuestionnaireResponse, error)
+	SubmitHealthQuestionnaire(context.Context, *SubmitHealthQuestionnaireRequest) (*SubmitHealthQuestionnaireResponse, error)
+	GetHealthStatus(context.Context, *GetHealthStatusRequest) (*HealthStatus, error)
+	GetHealthSubmission(context.Context, *GetHealthStatusRequest) (*GetHealthSubmissionResponse, error)
+	// Вложения.
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]) error
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	GetAttachmentURL(context.Context, *AttachmentRequest) (*GetAttachmentURLResponse, error)
+	DeleteAttachment(context.Context, *AttachmentRequest) (*DeleteAttachmentResponse, error)
+	// Журнал аудита.
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	mustEmbedUnimplementedCustomerServiceServer()
+}
+
+// UnimplementedCustomerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCustomerServiceServer struct{}
+
+func (UnimplementedCustomerServiceServer) RegisterCustomer(context.Context, *RegisterCustomerRequest) (*RegisterCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetCustomer(context.Context, *GetCustomerRequest) (*GetCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetCustomerAsOf(context.Context, *GetCustomerAsOfRequest) (*GetCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerAsOf not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetCustomerHistory(context.Context, *GetCustomerHistoryRequest) (*GetCustomerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerHistory not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomers not implemented")
+}
+func (UnimplementedCustomerServiceServer) ExportCustomers(*ListCustomersRequest, grpc.ServerStreamingServer[CustomerSummary]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCustomers not implemented")
+}
+func (UnimplementedCustomerServiceServer) ImportCustomers(grpc.ClientStreamingServer[ImportCustomersRequest, ImportCustomersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCustomers not implemented")
+}
+func (UnimplementedCustomerServiceServer) EraseCustomer(context.Context, *EraseCustomerRequest) (*EraseCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) FindDuplicateCandidates(context.Context, *FindDuplicateCandidatesRequest) (*FindDuplicateCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicateCandidates not implemented")
+}
+func (UnimplementedCustomerServiceServer) MergeCustomers(context.Context, *MergeCustomersRequest) (*MergeCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCustomers not implemented")
+}
+func (UnimplementedCustomerServiceServer) CreateHousehold(context.Context, *CreateHouseholdRequest) (*CreateHouseholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHousehold not implemented")
+}
+func (UnimplementedCustomerServiceServer) AddHouseholdMember(context.Context, *AddHouseholdMemberRequest) (*HouseholdMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHouseholdMember not implemented")
+}
+func (UnimplementedCustomerServiceServer) RemoveHouseholdMember(context.Context, *RemoveHouseholdMemberRequest) (*HouseholdMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHouseholdMember not implemented")
+}
+func (UnimplementedCustomerServiceServer) TransferHouseholdMember(context.Context, *TransferHouseholdMemberRequest) (*HouseholdMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferHouseholdMember not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetCustomerHousehold(context.Context, *GetCustomerHouseholdRequest) (*Household, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerHousehold not implemented")
+}
+func (UnimplementedCustomerServiceServer) UpdateConsent(context.Context, *UpdateConsentRequest) (*UpdateConsentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConsent not implemented")
+}
+func (UnimplementedCustomerServiceServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListContactableCustomers(context.Context, *ListContactableCustomersRequest) (*ListContactableCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContactableCustomers not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListReferrals(context.Context, *ListReferralsRequest) (*ListReferralsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReferrals not implemented")
+}
+func (UnimplementedCustomerServiceServer) AddTimelineEntry(context.Context, *AddTimelineEntryRequest) (*AddTimelineEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTimelineEntry not implemented")
+}
+func (UnimplementedCustomerServiceServer) EditTimelineEntry(context.Context, *EditTimelineEntryRequest) (*EditTimelineEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditTimelineEntry not implemented")
+}
+func (UnimplementedCustomerServiceServer) DeleteTimelineEntry(context.Context, *DeleteTimelineEntryRequest) (*DeleteTimelineEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTimelineEntry not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetCustomerTimeline(context.Context, *GetCustomerTimelineRequest) (*GetCustomerTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerTimeline not implemented")
+}
+func (UnimplementedCustomerServiceServer) PublishHealthQuestionnaire(context.Context, *PublishHealthQuestionnaireRequest) (*PublishHealthQuestionnaireResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishHealthQuestionnaire not implemented")
+}
+func (UnimplementedCustomerServiceServer) SubmitHealthQuestionnaire(context.Context, *SubmitHealthQuestionnaireRequest) (*SubmitHealthQuestionnaireResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitHealthQuestionnaire not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetHealthStatus(context.Context, *GetHealthStatusRequest) (*HealthStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealthStatus not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetHealthSubmission(context.Context, *GetHealthStatusRequest) (*GetHealthSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealthSubmission not implemented")
+}
+func (UnimplementedCustomerServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetAttachmentURL(context.Context, *AttachmentRequest) (*GetAttachmentURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachmentURL not implemented")
+}
+func (UnimplementedCustomerServiceServer) DeleteAttachment(context.Context, *AttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedCustomerServiceServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedCustomerServiceServer) mustEmbedUnimplementedCustomerServiceServer() {}
+func (UnimplementedCustomerServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeCustomerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CustomerServiceServer will
+// result in compilation errors.
+type UnsafeCustomerServiceServer interface {
+	mustEmbedUnimplementedCustomerServiceServer()
+}
+
+func RegisterCustomerServiceServer(s grpc.ServiceRegistrar, srv CustomerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCustomerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CustomerService_ServiceDesc, srv)
+}
+
+func _CustomerService_RegisterCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).RegisterCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_RegisterCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).RegisterCustomer(ctx, req.(*RegisterCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetCustomer(ctx, req.(*GetCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UpdateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UpdateCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UpdateCustomer(ctx, req.(*UpdateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetCustomerAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerAsOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetCustomerAsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetCustomerAsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetCustomerAsOf(ctx, req.(*GetCustomerAsOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetCustomerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetCustomerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetCustomerHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetCustomerHistory(ctx, req.(*GetCustomerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListCustomers(ctx, req.(*ListCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ExportCustomers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCustomersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CustomerServiceServer).ExportCustomers(m, &grpc.GenericServerStream[ListCustomersRequest, CustomerSummary]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_ExportCustomersServer = grpc.ServerStreamingServer[CustomerSummary]
+
+func _CustomerService_ImportCustomers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CustomerServiceServer).ImportCustomers(&grpc.GenericServerStream[ImportCustomersRequest, ImportCustomersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_ImportCustomersServer = grpc.ClientStreamingServer[ImportCustomersRequest, ImportCustomersResponse]
+
+func _CustomerService_EraseCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).EraseCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_EraseCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).EraseCustomer(ctx, req.(*EraseCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_FindDuplicateCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicateCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).FindDuplicateCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_FindDuplicateCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).FindDuplicateCandidates(ctx, req.(*FindDuplicateCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_MergeCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).MergeCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_MergeCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).MergeCustomers(ctx, req.(*MergeCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_CreateHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHouseholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).CreateHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_CreateHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).CreateHousehold(ctx, req.(*CreateHouseholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_AddHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddHouseholdMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).AddHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_AddHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).AddHouseholdMember(ctx, req.(*AddHouseholdMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_RemoveHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveHouseholdMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).RemoveHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_RemoveHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).RemoveHouseholdMember(ctx, req.(*RemoveHouseholdMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_TransferHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferHouseholdMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).TransferHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_TransferHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).TransferHouseholdMember(ctx, req.(*TransferHouseholdMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetCustomerHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerHouseholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetCustomerHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetCustomerHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetCustomerHousehold(ctx, req.(*GetCustomerHouseholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_UpdateConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UpdateConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UpdateConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UpdateConsent(ctx, req.(*UpdateConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListContactableCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactableCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListContactableCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListContactableCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListContactableCustomers(ctx, req.(*ListContactableCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListReferrals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReferralsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListReferrals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListReferrals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListReferrals(ctx, req.(*ListReferralsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_AddTimelineEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTimelineEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).AddTimelineEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_AddTimelineEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).AddTimelineEntry(ctx, req.(*AddTimelineEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_EditTimelineEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditTimelineEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).EditTimelineEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_EditTimelineEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).EditTimelineEntry(ctx, req.(*EditTimelineEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_DeleteTimelineEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTimelineEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).DeleteTimelineEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_DeleteTimelineEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).DeleteTimelineEntry(ctx, req.(*DeleteTimelineEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetCustomerTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetCustomerTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetCustomerTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetCustomerTimeline(ctx, req.(*GetCustomerTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_PublishHealthQuestionnaire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishHealthQuestionnaireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).PublishHealthQuestionnaire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_PublishHealthQuestionnaire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).PublishHealthQuestionnaire(ctx, req.(*PublishHealthQuestionnaireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_SubmitHealthQuestionnaire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitHealthQuestionnaireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).SubmitHealthQuestionnaire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_SubmitHealthQuestionnaire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).SubmitHealthQuestionnaire(ctx, req.(*SubmitHealthQuestionnaireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetHealthStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetHealthStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetHealthStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetHealthStatus(ctx, req.(*GetHealthStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetHealthSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetHealthSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetHealthSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetHealthSubmission(ctx, req.(*GetHealthStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CustomerServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, Attachment]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]
+
+func _CustomerService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetAttachmentURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetAttachmentURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetAttachmentURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetAttachmentURL(ctx, req.(*AttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).DeleteAttachment(ctx, req.(*AttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_QueryAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CustomerService_ServiceDesc is the grpc.ServiceDesc for CustomerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CustomerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "holo.customer.v1.CustomerService",
+	HandlerType: (*CustomerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterCustomer",
+			Handler:    _CustomerService_RegisterCustomer_Handler,
+		},
+		{
+			MethodName: "GetCustomer",
+			Handler:    _CustomerService_GetCustomer_Handler,
+		},
+		{
+			MethodName: "UpdateCustomer",
+			Handler:    _CustomerService_UpdateCustomer_Handler,
+		},
+		{
+			MethodName: "GetCustomerAsOf",
+			Handler:    _CustomerService_GetCustomerAsOf_Handler,
+		},
+		{
+			MethodName: "GetCustomerHistory",
+			Handler:    _CustomerService_GetCustomerHistory_Handler,
+		},
+		{
+			MethodName: "ListCustomers",
+			Handler:    _CustomerService_ListCustomers_Handler,
+		},
+		{
+			MethodName: "EraseCustomer",
+			Handler:    _CustomerService_EraseCustomer_Handler,
+		},
+		{
+			MethodName: "FindDuplicateCandidates",
+			Handler:    _CustomerService_FindDuplicateCandidates_Handler,
+		},
+		{
+			MethodName: "MergeCustomers",
+			Handler:    _CustomerService_MergeCustomers_Handler,
+		},
+		{
+			MethodName: "CreateHousehold",
+			Handler:    _CustomerService_CreateHousehold_Handler,
+		},
+		{
+			MethodName: "AddHouseholdMember",
+			Handler:    _CustomerService_AddHouseholdMember_Handler,
+		},
+		{
+			MethodName: "RemoveHouseholdMember",
+			Handler:    _CustomerService_RemoveHouseholdMember_Handler,
+		},
+		{
+			MethodName: "TransferHouseholdMember",
+			Handler:    _CustomerService_TransferHouseholdMember_Handler,
+		},
+		{
+			MethodName: "GetCustomerHousehold",
+			Handler:    _CustomerService_GetCustomerHousehold_Handler,
+		},
+		{
+			MethodName: "UpdateConsent",
+			Handler:    _CustomerService_UpdateConsent_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _CustomerService_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "ListContactableCustomers",
+			Handler:    _CustomerService_ListContactableCustomers_Handler,
+		},
+		{
+			MethodName: "ListReferrals",
+			Handler:    _CustomerService_ListReferrals_Handler,
+		},
+		{
+			MethodName: "AddTimelineEntry",
+			Handler:    _CustomerService_AddTimelineEntry_Handler,
+		},
+		{
+			MethodName: "EditTimelineEntry",
+			Handler:    _CustomerService_EditTimelineEntry_Handler,
+		},
+		{
+			MethodName: "DeleteTimelineEntry",
+			Handler:    _CustomerService_DeleteTimelineEntry_Handler,
+		},
+		{
+			MethodName: "GetCustomerTimeline",
+			Handler:    _CustomerService_GetCustomerTimeline_Handler,
+		},
+		{
+			MethodName: "PublishHealthQuestionnaire",
+			Handler:    _CustomerService_PublishHealthQuestionnaire_Handler,
+		},
+		{
+			MethodName: "SubmitHealthQuestionnaire",
+			Handler:    _CustomerService_SubmitHealthQuestionnaire_Handler,
+		},
+		{
+			MethodName: "GetHealthStatus",
+			Handler:    _CustomerService_GetHealthStatus_Handler,
+		},
+		{
+			MethodName: "GetHealthSubmission",
+			Handler:    _CustomerService_GetHealthSubmission_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _CustomerService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "GetAttachmentURL",
+			Handler:    _CustomerService_GetAttachmentURL_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _CustomerService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _CustomerService_QueryAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportCustomers",
+			Handler:       _CustomerService_ExportCustomers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportCustomers",
+			Handler:       _CustomerService_ImportCustomers_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _CustomerService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "customer/v1/customer.proto",
+}
@@ -0,0 +1,14 @@
+// Package api содержит gRPC-контракт customer-service, сгенерированный по нему код
+// и OpenAPI-описание REST/JSON-шлюза.
+package api
+
+import _ "embed"
+
+//go:generate protoc -I proto --go_out=gen --go_opt=paths=source_relative --go-grpc_out=gen --go-grpc_opt=paths=source_relative customer/v1/customer.proto
+//go:generate protoc -I proto --grpc-gateway_out=gen --grpc-gateway_opt=paths=source_relative,grpc_api_configuration=proto/customer/v1/customer_gateway.yaml customer/v1/customer.proto
+//go:generate protoc -I proto --openapiv2_out=openapi --openapiv2_opt=grpc_api_configuration=proto/customer/v1/customer_gateway.yaml,openapi_configuration=proto/customer/v1/customer_openapi.yaml,output_format=json,disable_default_errors=true,allow_merge=true,merge_file_name=customer customer/v1/customer.proto
+
+// OpenAPI — документ OpenAPI (Swagger 2.0) для HTTP-маршрутов шлюза.
+//
+//go:embed openapi/customer.swagger.json
+var OpenAPI []byte
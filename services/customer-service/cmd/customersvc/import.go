@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/application/commands"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/infrastructure/spreadsheet"
+)
+
+// runImport выполняет `customersvc import`: загружает клиентов из CSV/XLSX
+// и печатает построчный отчёт в CSV. Код выхода 2 означает ошибки в строках.
+func runImport(ctx context.Context, args []string) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	file := flags.String("file", "", "path to .csv or .xlsx file with customers")
+	dryRun := flags.Bool("dry-run", false, "validate rows without writing anything")
+	reportPath := flags.String("report", "", "path for the per-row CSV report (stdout by default)")
+	actor := flags.String("actor", "cli:import", "actor id recorded in the audit log")
+	tenantRaw := flags.String("tenant", "", "tenant (brand) the customers belong to")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+	if *file == "" || *tenantRaw == "" {
+		fmt.Fprintln(os.Stderr, "import: -file and -tenant are required")
+		flags.Usage()
+		return 1
+	}
+
+	tenantID, err := tenant.Parse(*tenantRaw)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 1
+	}
+
+	input, err := os.Open(*file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 1
+	}
+	defer input.Close()
+
+	source, err := spreadsheet.Open(*file, input)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 1
+	}
+	defer source.Close()
+
+	output := io.Writer(os.Stdout)
+	if *reportPath != "" {
+		reportFile, err := os.Create(*reportPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "import: %v\n", err)
+			return 1
+		}
+		defer reportFile.Close()
+		output = reportFile
+	}
+
+	application, err := newApp(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: init app: %v\n", err)
+		return 1
+	}
+	defer shutdown(application)
+
+	ctx = tenant.WithTenant(audit.WithActor(ctx, audit.Actor{ID: *actor, Role: "system"}), tenantID)
+	report, err := application.Importer().Handle(ctx, commands.ImportCustomers{Source: source, DryRun: *dryRun})
+	if writeErr := writeImportReport(output, report); writeErr != nil {
+		fmt.Fprintf(os.Stderr, "import: write report: %v\n", writeErr)
+	}
+	fmt.Fprintf(os.Stderr, "import: total=%d imported=%d failed=%d dry_run=%t\n", report.Total, report.Imported, report.Failed, report.DryRun)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 1
+	}
+	if report.Failed > 0 {
+		return 2
+	}
+	return 0
+}
+
+func writeImportReport(w io.Writer, report commands.ImportReport) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"line", "email", "status", "customer_id", "error"}); err != nil {
+		return err
+	}
+
+	for _, row := range report.Rows {
+		status := "imported"
+		switch {
+		case row.Error != "":
+			status = "failed"
+		case report.DryRun:
+			status = "valid"
+		}
+		if err := writer.Write([]string{strconv.Itoa(row.Line), row.Email, status, row.CustomerID, row.Error}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
@@ -18,10 +19,28 @@ func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(runImport(ctx, os.Args[2:]))
+	}
+
+	application, err := newApp(ctx)
+	if err != nil {
+		log.Fatalf("failed to init app: %v", err)
+	}
+
+	if err := application.Run(ctx); err != nil && err != context.Canceled {
+		application.Logger().Error("runtime error", zap.Error(err))
+		os.Exit(1)
+	}
+
+	shutdown(application)
+}
+
+func newApp(ctx context.Context) (*app.App, error) {
 	var cfg app.Config
 	loader := config.New(config.WithConfigPaths("./configs"), config.WithPrefix("CUSTOMER"))
 	if err := loader.Load(&cfg); err != nil {
-		log.Fatalf("failed to load config: %v", err)
+		return nil, fmt.Errorf("load config: %w", err)
 	}
 
 	if env := os.Getenv("APP_ENV"); env != "" {
@@ -31,20 +50,14 @@ func main() {
 		cfg.Observability.Sentry.Release = release
 	}
 
-	application, err := app.New(ctx, cfg, logger.Config{
+	return app.New(ctx, cfg, logger.Config{
 		Level:       os.Getenv("LOG_LEVEL"),
 		Environment: os.Getenv("APP_ENV"),
 		Encoding:    "json",
 	})
-	if err != nil {
-		log.Fatalf("failed to init app: %v", err)
-	}
-
-	if err := application.Run(ctx); err != nil && err != context.Canceled {
-		application.Logger().Error("runtime error", zap.Error(err))
-		os.Exit(1)
-	}
+}
 
+func shutdown(application *app.App) {
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer shutdownCancel()
 
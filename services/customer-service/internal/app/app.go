@@ -6,28 +6,47 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strconv"
 	"time"
 
+	customerpb "github.com/evgeniySeleznev/nwHS/services/customer-service/api/gen/customer/v1"
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/application/commands"
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/application/queries"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/infrastructure/blob"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/infrastructure/crypto"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/infrastructure/healthcheck"
 	kafkaInfra "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/infrastructure/kafka"
 	mongodlq "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/infrastructure/mongo"
 	repository "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/infrastructure/repository"
 	search "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/infrastructure/search"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/interfaces/gateway"
 	grpciface "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/interfaces/grpc"
+	kafkaiface "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/interfaces/kafka"
 
+	"github.com/evgeniySeleznev/nwHS/pkg/concurrency"
 	grpcmiddleware "github.com/evgeniySeleznev/nwHS/pkg/grpc/middleware"
+	"github.com/evgeniySeleznev/nwHS/pkg/grpc/mtls"
+	"github.com/evgeniySeleznev/nwHS/pkg/health"
 	"github.com/evgeniySeleznev/nwHS/pkg/logger"
 	"github.com/evgeniySeleznev/nwHS/pkg/metrics"
 	sentryobs "github.com/evgeniySeleznev/nwHS/pkg/observability/sentry"
+	"github.com/evgeniySeleznev/nwHS/pkg/ratelimit"
 	"github.com/evgeniySeleznev/nwHS/pkg/tracing"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/redis/go-redis/v9"
 	"github.com/segmentio/kafka-go"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/keepalive"
 )
 
 // App описывает корневой сервисный контейнер.
@@ -41,9 +60,16 @@ type App struct {
 	writer     *kafka.Writer
 	indexer    *search.Indexer
 	server     *grpciface.Transport
+	importer   *commands.ImportCustomersHandler
+	timeline   *kafkaiface.TimelineConsumer
 	metricsSrv *http.Server
+	gatewaySrv *http.Server
 	listener   net.Listener
 	mongo      *mongo.Client
+	probes     *health.Registry
+	healthSrv  *grpchealth.Server
+	probeEvery time.Duration
+	tls        *mtls.Reloader
 	shutdown   []func(context.Context) error
 }
 
@@ -90,12 +116,34 @@ func New(ctx context.Context, cfg Config, logCfg logger.Config) (*App, error) {
 		Balancer: &kafka.LeastBytes{},
 	})
 
-	osClient, err := opensearch.NewClient(opensearch.Config{Addresses: []string{cfg.Search.Endpoint}})
+	osClient, err := opensearch.NewClient(opensearch.Config{
+		Addresses: []string{cfg.Search.Endpoint},
+		Transport: search.DeadlineTransport(nil),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("opensearch client: %w", err)
 	}
 
-	repo := repository.NewPostgresRepository(pool)
+	eventStore := repository.NewEventStore(pool, cfg.Postgres.SnapshotEvery)
+	repo := repository.NewPostgresRepository(pool, eventStore)
+	txManager := repository.NewTxManager(pool)
+	auditRepo := repository.NewAuditLogRepository(pool)
+	householdRepo := repository.NewHouseholdRepository(pool)
+	timelineRepo := repository.NewTimelineRepository(pool)
+
+	healthCipher, err := healthKeyring(cfg)
+	if err != nil {
+		return nil, err
+	}
+	attachmentRepo := repository.NewAttachmentRepository(pool)
+	blobs, blobHandler, err := newBlobStore(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	urlTTL, err := time.ParseDuration(cfg.Attachments.URLTTL)
+	if err != nil {
+		return nil, fmt.Errorf("app: attachments url ttl: %w", err)
+	}
 	indexer := search.NewIndexer(osClient, cfg.Search.Index)
 
 	var (
@@ -113,25 +161,219 @@ func New(ctx context.Context, cfg Config, logCfg logger.Config) (*App, error) {
 
 	publisher := kafkaInfra.NewPublisher(writer, cfg.Kafka.CustomerTopic, dlqRepo)
 
-	registerHandler := commands.NewRegisterCustomerHandler(repo, indexer, publisher, zapLogger)
+	registerHandler := commands.NewRegisterCustomerHandler(repo, txManager, auditRepo, indexer, publisher, zapLogger)
+	updateHandler := commands.NewUpdateCustomerHandler(repo, txManager, auditRepo, indexer, zapLogger)
+	mergeHandler := commands.NewMergeCustomersHandler(eventStore, repo, txManager, auditRepo, indexer, publisher, zapLogger)
+	householdHandler := commands.NewHouseholdHandler(repo, householdRepo, txManager, auditRepo, publisher, models.GuardianPolicy{AdultAge: cfg.Household.GuardianRequiredUnderAge}, zapLogger)
+	importHandler := commands.NewImportCustomersHandler(repo, txManager, auditRepo, indexer, publisher, cfg.Import.BatchSize, zapLogger)
+	contactHandler := commands.NewContactSettingsHandler(repo, txManager, auditRepo, publisher, zapLogger)
+	policy, err := timelinePolicy(cfg)
+	if err != nil {
+		return nil, err
+	}
+	timelineHandler := commands.NewTimelineHandler(repo, timelineRepo, txManager, auditRepo, policy, zapLogger)
+	// Без ключей шифрования анкеты здоровья отключены, остальной сервис работает.
+	var (
+		healthHandler    *commands.HealthHandler
+		getHealthHandler *queries.GetHealthHandler
+		healthRepo       = repository.NewHealthRepository(pool, healthCipher)
+	)
+	if healthCipher != nil {
+		healthPolicy := models.HealthAccessPolicy{MedicalRoles: cfg.Health.MedicalRoles}
+		healthHandler = commands.NewHealthHandler(repo, healthRepo, txManager, auditRepo, publisher, healthPolicy, zapLogger)
+		getHealthHandler = queries.NewGetHealthHandler(repo, healthRepo, auditRepo, healthPolicy)
+	} else {
+		zapLogger.Warn("health encryption keys are not configured, health record RPCs are disabled")
+	}
+	// Без хранилища вложений RPC вложений отключены, но удаление данных клиента
+	// по-прежнему проверяет и чистит метаданные вложений.
+	var (
+		attachmentPolicy      = models.AttachmentPolicy{MaxSize: cfg.Attachments.MaxSizeBytes}
+		attachmentHandler     = commands.NewAttachmentHandler(repo, attachmentRepo, blobs, txManager, auditRepo, attachmentPolicy, zapLogger)
+		attachmentRPCs        *commands.AttachmentHandler
+		getAttachmentsHandler *queries.GetAttachmentsHandler
+	)
+	if blobs != nil {
+		attachmentRPCs = attachmentHandler
+		getAttachmentsHandler = queries.NewGetAttachmentsHandler(repo, attachmentRepo, blobs, urlTTL)
+	} else {
+		zapLogger.Warn("attachment storage is not configured, attachment RPCs are disabled")
+	}
+	// Удаление данных клиента проходит по всем хранилищам с персональными данными.
+	eraseHandler := commands.NewEraseCustomerHandler(repo, repo, txManager, auditRepo, zapLogger,
+		attachmentHandler, timelineRepo, healthRepo, indexer)
 	getHandler := queries.NewGetCustomerHandler(repo)
+	getHouseholdHandler := queries.NewGetCustomerHouseholdHandler(householdRepo, repo)
+	dedupHandler := queries.NewFindDuplicatesHandler(indexer, cfg.Dedup.MinScore, cfg.Dedup.MaxCandidates)
+	getAsOfHandler := queries.NewGetCustomerAsOfHandler(eventStore)
+	historyHandler := queries.NewGetCustomerHistoryHandler(eventStore)
+	auditHandler := queries.NewQueryAuditLogHandler(auditRepo)
+	contactableHandler := queries.NewListContactableHandler(repo)
+	referralsHandler := queries.NewListReferralsHandler(repo)
+	listHandler := queries.NewListCustomersHandler(repo)
+	getTimelineHandler := queries.NewGetCustomerTimelineHandler(repo, timelineRepo, policy)
+
+	var timelineConsumer *kafkaiface.TimelineConsumer
+	if len(cfg.Kafka.Brokers) > 0 {
+		reader := kafka.NewReader(kafka.ReaderConfig{
+			Brokers: cfg.Kafka.Brokers,
+			GroupID: cfg.Kafka.ConsumerGroup,
+			Topic:   cfg.Kafka.CustomerTopic,
+		})
+		var deadLetters kafkaiface.DeadLetterSink
+		if dlqRepo != nil {
+			deadLetters = dlqRepo
+		}
+		timelineConsumer = kafkaiface.NewTimelineConsumer(reader, timelineHandler, deadLetters, zapLogger)
+	}
+
+	var defaultTenant tenant.ID
+	if cfg.Tenancy.DefaultTenant != "" {
+		if defaultTenant, err = tenant.Parse(cfg.Tenancy.DefaultTenant); err != nil {
+			return nil, fmt.Errorf("app: tenancy: %w", err)
+		}
+	}
+	tenantResolver := grpciface.NewTenantResolver(auditRepo, defaultTenant, zapLogger)
 
 	telemetryInterceptor := grpcmiddleware.UnaryTelemetryInterceptor(cfg.ServiceName, collector, sentryClient, zapLogger)
+	limiter, redisClient, err := newRateLimiter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	unaryRateLimit := grpcmiddleware.UnaryRateLimitInterceptor(cfg.ServiceName, limiter, cfg.RateLimit.Config, collector, zapLogger)
+	streamRateLimit := grpcmiddleware.StreamRateLimitInterceptor(cfg.ServiceName, limiter, cfg.RateLimit.Config, collector, zapLogger)
+
+	serverCreds := insecure.NewCredentials()
+	gatewayCreds := insecure.NewCredentials()
+	var tlsReloader *mtls.Reloader
+	if cfg.GRPC.TLS.Enabled {
+		tlsReloader, err = mtls.NewReloader(cfg.GRPC.TLS, zapLogger)
+		if err != nil {
+			return nil, fmt.Errorf("app: grpc tls: %w", err)
+		}
+		serverCreds = tlsReloader.ServerCredentials()
+		// Шлюз обращается к своему же порту и предъявляет сертификат сервиса;
+		// личность сервиса с его вызовов снимается первым перехватчиком.
+		gatewayCreds = credentials.NewTLS(tlsReloader.ClientTLSConfig(""))
+	} else {
+		zapLogger.Warn("grpc tls is disabled, traffic is plaintext")
+	}
+
+	timeouts, err := timeoutConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	callLogging := grpcmiddleware.LoggingConfig{
+		Payloads: cfg.Observability.Logging.Payloads,
+		Redactor: grpcmiddleware.NewRedactor(cfg.Observability.Logging.SensitiveFields, cfg.Observability.Logging.SensitiveMetadata),
+	}
+	var (
+		unaryInterceptors  []grpc.UnaryServerInterceptor
+		streamInterceptors []grpc.StreamServerInterceptor
+	)
+	if tlsReloader != nil {
+		unaryInterceptors = append(unaryInterceptors, tlsReloader.UnaryStripSelfInterceptor())
+		streamInterceptors = append(streamInterceptors, tlsReloader.StreamStripSelfInterceptor())
+	}
+	unaryInterceptors = append(unaryInterceptors,
+		telemetryInterceptor,
+		grpcmiddleware.UnaryLoggingInterceptor(callLogging, zapLogger),
+		grpcmiddleware.UnaryRecoveryInterceptor(cfg.ServiceName, collector, sentryClient, zapLogger),
+	)
+	streamInterceptors = append(streamInterceptors,
+		grpcmiddleware.StreamTelemetryInterceptor(cfg.ServiceName, collector, sentryClient, zapLogger),
+		grpcmiddleware.StreamLoggingInterceptor(callLogging, zapLogger),
+		grpcmiddleware.StreamRecoveryInterceptor(cfg.ServiceName, collector, sentryClient, zapLogger),
+	)
+	if !cfg.Concurrency.Disabled {
+		// Лимит стоит до таймаута: отклонённый вызов не должен ждать и расходовать бюджет.
+		limiter := concurrency.NewLimiter(cfg.Concurrency.Config)
+		unaryInterceptors = append(unaryInterceptors, grpcmiddleware.UnaryConcurrencyInterceptor(cfg.ServiceName, limiter, collector, zapLogger))
+		streamInterceptors = append(streamInterceptors, grpcmiddleware.StreamConcurrencyInterceptor(cfg.ServiceName, limiter, collector, zapLogger))
+	}
+	unaryInterceptors = append(unaryInterceptors, grpcmiddleware.UnaryTimeoutInterceptor(cfg.ServiceName, timeouts, collector))
+	streamInterceptors = append(streamInterceptors, grpcmiddleware.StreamTimeoutInterceptor(cfg.ServiceName, timeouts, collector))
+	if cfg.Auth.Disabled {
+		zapLogger.Warn("authentication is disabled, every peer is trusted")
+		unaryInterceptors = append(unaryInterceptors, unaryRateLimit)
+		streamInterceptors = append(streamInterceptors, streamRateLimit)
+	} else {
+		authCfg, err := authConfig(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		policies, err := loadPolicies(cfg.Auth.PolicyFile)
+		if err != nil {
+			return nil, fmt.Errorf("app: %w", err)
+		}
+		unaryInterceptors = append(unaryInterceptors,
+			grpcmiddleware.UnaryAuthInterceptor(authCfg, zapLogger),
+			// Лимит проверяется до политик, чтобы и отклонённые вызовы расходовали квоту.
+			unaryRateLimit,
+			grpcmiddleware.UnaryAuthzInterceptor(cfg.ServiceName, policies, collector, zapLogger),
+		)
+		streamInterceptors = append(streamInterceptors,
+			grpcmiddleware.StreamAuthInterceptor(authCfg, zapLogger),
+			streamRateLimit,
+			grpcmiddleware.StreamAuthzInterceptor(cfg.ServiceName, policies, collector, zapLogger),
+		)
+	}
+	unaryInterceptors = append(unaryInterceptors, grpciface.RequestContextInterceptor(), tenantResolver.UnaryInterceptor(), grpciface.ErrorInterceptor())
+	streamInterceptors = append(streamInterceptors, grpciface.RequestContextStreamInterceptor(), tenantResolver.StreamInterceptor(), grpciface.ErrorStreamInterceptor())
+
 	transport := grpciface.NewTransport(
-		registerHandler,
-		getHandler,
+		grpciface.Handlers{
+			Register:       registerHandler,
+			Update:         updateHandler,
+			Merge:          mergeHandler,
+			Household:      householdHandler,
+			Contact:        contactHandler,
+			Import:         importHandler,
+			Timeline:       timelineHandler,
+			Health:         healthHandler,
+			Attachments:    attachmentRPCs,
+			Erase:          eraseHandler,
+			Get:            getHandler,
+			GetAsOf:        getAsOfHandler,
+			History:        historyHandler,
+			AuditLog:       auditHandler,
+			Dedup:          dedupHandler,
+			GetHousehold:   getHouseholdHandler,
+			Contactable:    contactableHandler,
+			Referrals:      referralsHandler,
+			GetTimeline:    getTimelineHandler,
+			GetHealth:      getHealthHandler,
+			GetAttachments: getAttachmentsHandler,
+			List:           listHandler,
+		},
 		zapLogger,
-		grpc.UnaryInterceptor(telemetryInterceptor),
+		grpc.Creds(serverCreds),
+		// Клиенты pkg/grpc/client пингуют соединение раз в минуту; по умолчанию сервер
+		// разрывает соединения с пингами чаще пяти минут.
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{MinTime: 30 * time.Second, PermitWithoutStream: true}),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
 
-	address := fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port)
-	listener, err := net.Listen("tcp", address)
+	gatewayConn, gatewaySrv, err := newGatewayServer(ctx, cfg, gatewayCreds, zapLogger)
 	if err != nil {
-		return nil, fmt.Errorf("listen %s: %w", address, err)
+		return nil, err
 	}
 
+	probes, probeInterval, err := newProbes(cfg, pool, osClient, mongoClient)
+	if err != nil {
+		return nil, err
+	}
+	healthSrv := grpchealth.NewServer()
+	transport.RegisterHealth(healthSrv)
+
 	metricsMux := http.NewServeMux()
 	metricsMux.Handle("/metrics", collector.Handler())
+	metricsMux.Handle("/healthz", health.LivenessHandler())
+	metricsMux.Handle("/readyz", probes.ReadinessHandler())
+	if blobHandler != nil {
+		metricsMux.Handle("/attachments/", http.StripPrefix("/attachments/", blobHandler))
+	}
 
 	metricsSrv := &http.Server{
 		Addr:              cfg.Observability.Metrics.Addr,
@@ -149,18 +391,31 @@ func New(ctx context.Context, cfg Config, logCfg logger.Config) (*App, error) {
 		writer:     writer,
 		indexer:    indexer,
 		server:     transport,
+		importer:   importHandler,
+		timeline:   timelineConsumer,
 		metricsSrv: metricsSrv,
-		listener:   listener,
+		gatewaySrv: gatewaySrv,
 		mongo:      mongoClient,
+		probes:     probes,
+		healthSrv:  healthSrv,
+		probeEvery: probeInterval,
+		tls:        tlsReloader,
 	}
 
 	app.shutdown = []func(context.Context) error{
 		func(ctx context.Context) error {
-			transport.Stop()
+			// NOT_SERVING до остановки серверов, чтобы балансировщики успели снять трафик.
+			healthSrv.Shutdown()
 			return nil
 		},
 		func(ctx context.Context) error {
-			listener.Close()
+			return gatewaySrv.Shutdown(ctx)
+		},
+		func(ctx context.Context) error {
+			return gatewayConn.Close()
+		},
+		func(ctx context.Context) error {
+			transport.Stop()
 			return nil
 		},
 		func(ctx context.Context) error {
@@ -170,6 +425,12 @@ func New(ctx context.Context, cfg Config, logCfg logger.Config) (*App, error) {
 		func(ctx context.Context) error {
 			return writer.Close()
 		},
+		func(ctx context.Context) error {
+			if timelineConsumer != nil {
+				return timelineConsumer.Close()
+			}
+			return nil
+		},
 		func(ctx context.Context) error {
 			if tracer != nil {
 				return tracer.Shutdown(ctx)
@@ -188,6 +449,12 @@ func New(ctx context.Context, cfg Config, logCfg logger.Config) (*App, error) {
 			}
 			return nil
 		},
+		func(ctx context.Context) error {
+			if redisClient != nil {
+				return redisClient.Close()
+			}
+			return nil
+		},
 		func(ctx context.Context) error {
 			if mongoClient != nil {
 				return mongoClient.Disconnect(ctx)
@@ -204,8 +471,15 @@ func New(ctx context.Context, cfg Config, logCfg logger.Config) (*App, error) {
 	return app, nil
 }
 
-// Run запускает инфраструктурные слои.
+// Run открывает gRPC-порт и запускает инфраструктурные слои.
 func (a *App) Run(ctx context.Context) error {
+	address := fmt.Sprintf("%s:%d", a.cfg.GRPC.Host, a.cfg.GRPC.Port)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("listen %s: %w", address, err)
+	}
+	a.listener = listener
+
 	a.log.Info("customer service started", zap.String("addr", a.listener.Addr().String()))
 
 	if a.metricsSrv != nil {
@@ -217,13 +491,33 @@ func (a *App) Run(ctx context.Context) error {
 		}()
 	}
 
-	errCh := make(chan error, 1)
+	go a.probes.Sync(ctx, a.healthSrv, a.probeEvery, customerpb.CustomerService_ServiceDesc.ServiceName)
+	if a.tls != nil {
+		go a.tls.Run(ctx)
+	}
+
+	errCh := make(chan error, 3)
+	a.log.Info("gateway server listening", zap.String("addr", a.gatewaySrv.Addr))
+	go func() {
+		if err := a.gatewaySrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- fmt.Errorf("gateway server: %w", err)
+		}
+	}()
+
 	go func() {
 		if err := a.server.Serve(a.listener); err != nil {
 			errCh <- err
 		}
 	}()
 
+	if a.timeline != nil {
+		go func() {
+			if err := a.timeline.Run(ctx); err != nil {
+				errCh <- fmt.Errorf("timeline consumer: %w", err)
+			}
+		}()
+	}
+
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -247,6 +541,11 @@ func (a *App) Logger() *zap.Logger {
 	return a.log
 }
 
+// Importer возвращает обработчик массового импорта клиентов для CLI.
+func (a *App) Importer() *commands.ImportCustomersHandler {
+	return a.importer
+}
+
 // Metrics возвращает коллекцию метрик.
 func (a *App) Metrics() *metrics.Collector {
 	return a.metrics
@@ -258,6 +557,8 @@ func newPostgresPool(ctx context.Context, cfg Config) (*pgxpool.Pool, error) {
 		return nil, fmt.Errorf("pgx parse dsn: %w", err)
 	}
 	poolCfg.MaxConns = cfg.Postgres.MaxConns
+	repository.EnableTenantSession(poolCfg)
+	repository.EnableDeadlineTracking(poolCfg)
 
 	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
 	if err != nil {
@@ -267,8 +568,251 @@ func newPostgresPool(ctx context.Context, cfg Config) (*pgxpool.Pool, error) {
 	return pool, nil
 }
 
+// blobStore объединяет запись содержимого вложений и выдачу ссылок на скачивание.
+type blobStore interface {
+	commands.BlobStore
+	queries.URLSigner
+}
+
+// newBlobStore создаёт хранилище вложений. Для локального хранилища возвращается
+// также обработчик скачивания по подписанным ссылкам. Без хранилища или ключа
+// подписи ссылок возвращается nil, и RPC вложений отвечают FailedPrecondition.
+func newBlobStore(ctx context.Context, cfg Config) (blobStore, http.Handler, error) {
+	switch cfg.Attachments.Backend {
+	case "":
+		return nil, nil, nil
+	case "local":
+		if cfg.Attachments.Local.SigningKey == "" {
+			return nil, nil, nil
+		}
+		store, err := blob.NewLocalStore(cfg.Attachments.Local.Root, cfg.Attachments.Local.BaseURL, []byte(cfg.Attachments.Local.SigningKey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("app: attachments: %w", err)
+		}
+		return store, store, nil
+	case "s3":
+		store, err := blob.NewS3Store(blob.S3Config{
+			Endpoint:  cfg.Attachments.S3.Endpoint,
+			Region:    cfg.Attachments.S3.Region,
+			Bucket:    cfg.Attachments.S3.Bucket,
+			AccessKey: cfg.Attachments.S3.AccessKey,
+			SecretKey: cfg.Attachments.S3.SecretKey,
+			UseSSL:    cfg.Attachments.S3.UseSSL,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("app: attachments: %w", err)
+		}
+		if err := store.EnsureBucket(ctx, cfg.Attachments.S3.Region); err != nil {
+			return nil, nil, fmt.Errorf("app: attachments: %w", err)
+		}
+		return store, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("app: attachments: unknown backend %q", cfg.Attachments.Backend)
+	}
+}
+
+// healthKeyring собирает ключи шифрования анкет здоровья. Пустой набор ключей
+// не ошибка: возвращается nil, и RPC анкет отвечают FailedPrecondition.
+func healthKeyring(cfg Config) (*crypto.Keyring, error) {
+	if len(cfg.Health.EncryptionKeys) == 0 {
+		return nil, nil
+	}
+
+	keys, err := crypto.ParseKeys(cfg.Health.EncryptionKeys)
+	if err != nil {
+		return nil, fmt.Errorf("app: health encryption: %w", err)
+	}
+	keyring, err := crypto.NewKeyring(cfg.Health.ActiveKeyID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("app: health encryption: %w", err)
+	}
+	return keyring, nil
+}
+
+// timeoutConfig переводит строковые длительности конфигурации в TimeoutConfig.
+func timeoutConfig(cfg Config) (grpcmiddleware.TimeoutConfig, error) {
+	parse := func(name, value string) (time.Duration, error) {
+		if value == "" {
+			return 0, nil
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("app: timeouts: %s: %w", name, err)
+		}
+		return d, nil
+	}
+
+	var (
+		out grpcmiddleware.TimeoutConfig
+		err error
+	)
+	if out.Default, err = parse("default", cfg.Timeouts.Default); err != nil {
+		return out, err
+	}
+	if out.Max, err = parse("max", cfg.Timeouts.Max); err != nil {
+		return out, err
+	}
+	for _, m := range cfg.Timeouts.Methods {
+		method := grpcmiddleware.MethodTimeout{Method: m.Method, Unbounded: m.Unbounded}
+		if method.Default, err = parse(m.Method, m.Default); err != nil {
+			return out, err
+		}
+		if method.Max, err = parse(m.Method, m.Max); err != nil {
+			return out, err
+		}
+		out.Methods = append(out.Methods, method)
+	}
+	return out, nil
+}
+
+// newRateLimiter выбирает хранилище корзин. Клиент Redis возвращается для закрытия при остановке.
+func newRateLimiter(cfg Config) (ratelimit.Limiter, *redis.Client, error) {
+	switch cfg.RateLimit.Backend {
+	case "memory":
+		return ratelimit.NewMemory(), nil, nil
+	case "redis":
+		if cfg.Redis.Addr == "" {
+			return nil, nil, errors.New("app: rate limit: redis.addr is required for redis backend")
+		}
+		client := redis.NewClient(&redis.Options{
+			Addr:     cfg.Redis.Addr,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+		})
+		return ratelimit.NewRedis(client, cfg.RateLimit.Prefix), client, nil
+	default:
+		return nil, nil, fmt.Errorf("app: rate limit: unknown backend %q", cfg.RateLimit.Backend)
+	}
+}
+
+// authConfig собирает настройки проверки JWT и загружает JWKS, чтобы ошибка
+// конфигурации ключей обнаруживалась при старте, а не на первом запросе.
+// Без issuer проверка iss отключилась бы, и сервис принимал бы токены любого
+// провайдера с тем же набором ключей, поэтому issuer обязателен.
+func authConfig(ctx context.Context, cfg Config) (grpcmiddleware.AuthConfig, error) {
+	if cfg.Auth.Issuer == "" {
+		return grpcmiddleware.AuthConfig{}, errors.New("app: auth: issuer is required unless auth is disabled")
+	}
+	refresh, err := time.ParseDuration(cfg.Auth.RefreshInterval)
+	if err != nil {
+		return grpcmiddleware.AuthConfig{}, fmt.Errorf("app: auth refresh interval: %w", err)
+	}
+	leeway, err := time.ParseDuration(cfg.Auth.Leeway)
+	if err != nil {
+		return grpcmiddleware.AuthConfig{}, fmt.Errorf("app: auth leeway: %w", err)
+	}
+
+	var keys *grpcmiddleware.JWKS
+	switch {
+	case cfg.Auth.JWKSURL != "":
+		keys = grpcmiddleware.NewRemoteJWKS(cfg.Auth.JWKSURL, nil, refresh)
+	case cfg.Auth.JWKSFile != "":
+		keys = grpcmiddleware.NewFileJWKS(cfg.Auth.JWKSFile, refresh)
+	default:
+		return grpcmiddleware.AuthConfig{}, errors.New("app: auth: jwks_url or jwks_file is required unless auth is disabled")
+	}
+	if err := keys.Refresh(ctx); err != nil {
+		return grpcmiddleware.AuthConfig{}, fmt.Errorf("app: auth: %w", err)
+	}
+
+	return grpcmiddleware.AuthConfig{
+		Keys:          keys,
+		Issuer:        cfg.Auth.Issuer,
+		Audience:      cfg.Auth.Audience,
+		Leeway:        leeway,
+		PublicMethods: append(append([]string(nil), grpcmiddleware.DefaultPublicMethods...), cfg.Auth.PublicMethods...),
+		RolesClaim:    cfg.Auth.RolesClaim,
+		TenantClaim:   cfg.Auth.TenantClaim,
+	}, nil
+}
+
+// newProbes регистрирует проверки зависимостей для /readyz и grpc.health.v1.
+func newProbes(cfg Config, pool *pgxpool.Pool, osClient *opensearch.Client, mongoClient *mongo.Client) (*health.Registry, time.Duration, error) {
+	timeout, err := time.ParseDuration(cfg.Probes.Timeout)
+	if err != nil {
+		return nil, 0, fmt.Errorf("app: probes timeout: %w", err)
+	}
+	cacheTTL, err := time.ParseDuration(cfg.Probes.CacheTTL)
+	if err != nil {
+		return nil, 0, fmt.Errorf("app: probes cache ttl: %w", err)
+	}
+	interval, err := time.ParseDuration(cfg.Probes.Interval)
+	if err != nil {
+		return nil, 0, fmt.Errorf("app: probes interval: %w", err)
+	}
+
+	nonCritical := make(map[string]struct{}, len(cfg.Probes.NonCritical))
+	for _, name := range cfg.Probes.NonCritical {
+		nonCritical[name] = struct{}{}
+	}
+	registry := health.NewRegistry(timeout, cacheTTL)
+	register := func(name string, check health.CheckFunc) {
+		if _, ok := nonCritical[name]; ok {
+			registry.Register(name, check, health.NonCritical())
+			return
+		}
+		registry.Register(name, check)
+	}
+
+	register("postgres", healthcheck.Postgres(pool))
+	register("kafka", healthcheck.Kafka(cfg.Kafka.Brokers))
+	register("opensearch", healthcheck.OpenSearch(osClient))
+	if mongoClient != nil {
+		register("mongo", healthcheck.Mongo(mongoClient))
+	}
+	return registry, interval, nil
+}
+
+// newGatewayServer поднимает REST/JSON-шлюз, который ходит в собственный gRPC-порт сервиса.
+func newGatewayServer(ctx context.Context, cfg Config, creds credentials.TransportCredentials, log *zap.Logger) (*grpc.ClientConn, *http.Server, error) {
+	maxAge, err := time.ParseDuration(cfg.Gateway.CORS.MaxAge)
+	if err != nil {
+		return nil, nil, fmt.Errorf("app: gateway cors max age: %w", err)
+	}
+
+	host := cfg.GRPC.Host
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "localhost"
+	}
+	conn, err := grpc.NewClient(net.JoinHostPort(host, strconv.Itoa(cfg.GRPC.Port)), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, nil, fmt.Errorf("app: gateway dial: %w", err)
+	}
+
+	handler, err := gateway.NewHandler(ctx, conn, gateway.CORSConfig{
+		AllowedOrigins:   cfg.Gateway.CORS.AllowedOrigins,
+		AllowedHeaders:   cfg.Gateway.CORS.AllowedHeaders,
+		ExposedHeaders:   cfg.Gateway.CORS.ExposedHeaders,
+		AllowCredentials: cfg.Gateway.CORS.AllowCredentials,
+		MaxAge:           maxAge,
+	}, log)
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+
+	return conn, &http.Server{
+		Addr:              cfg.Gateway.Addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 5 * time.Second,
+	}, nil
+}
+
+func timelinePolicy(cfg Config) (models.TimelinePolicy, error) {
+	restricted := make(map[valueobjects.EntryType][]string, len(cfg.Timeline.RestrictedTypes))
+	for raw, roles := range cfg.Timeline.RestrictedTypes {
+		entryType, err := valueobjects.ParseEntryType(raw)
+		if err != nil {
+			return models.TimelinePolicy{}, fmt.Errorf("app: timeline restricted type %q: %w", raw, err)
+		}
+		restricted[entryType] = roles
+	}
+
+	return models.TimelinePolicy{RestrictedTypes: restricted, ManagerRoles: cfg.Timeline.ManagerRoles}, nil
+}
+
 func newMongoClient(ctx context.Context, uri string) (*mongo.Client, error) {
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri).SetMonitor(mongodlq.DeadlineMonitor()))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAuthConfigRequiresIssuer(t *testing.T) {
+	var cfg Config
+	cfg.Auth.JWKSFile = filepath.Join(t.TempDir(), "jwks.json")
+	cfg.Auth.RefreshInterval = "15m"
+	cfg.Auth.Leeway = "30s"
+
+	if _, err := authConfig(context.Background(), cfg); err == nil || !strings.Contains(err.Error(), "issuer is required") {
+		t.Fatalf("expected missing issuer to fail startup, got %v", err)
+	}
+}
+
+func TestNewBlobStoreDisabledWithoutSigningKey(t *testing.T) {
+	var cfg Config
+	cfg.Defaults()
+
+	store, handler, err := newBlobStore(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("expected missing attachment storage not to fail startup, got %v", err)
+	}
+	if store != nil || handler != nil {
+		t.Fatalf("expected attachments to be disabled without a signing key")
+	}
+}
@@ -1,18 +1,64 @@
 package app
 
+import (
+	"github.com/evgeniySeleznev/nwHS/pkg/concurrency"
+	"github.com/evgeniySeleznev/nwHS/pkg/grpc/mtls"
+	"github.com/evgeniySeleznev/nwHS/pkg/ratelimit"
+)
+
 // Config определяет конфигурацию customer-service.
 type Config struct {
 	ServiceName string `mapstructure:"service_name"`
 
 	GRPC struct {
-		Host string `mapstructure:"host"`
-		Port int    `mapstructure:"port"`
+		Host string      `mapstructure:"host"`
+		Port int         `mapstructure:"port"`
+		TLS  mtls.Config `mapstructure:"tls"`
 	} `mapstructure:"grpc"`
 
+	Auth struct {
+		// Disabled отключает проверку токенов (только для локальной разработки).
+		Disabled bool `mapstructure:"disabled"`
+		// Issuer обязателен, если проверка включена.
+		Issuer   string `mapstructure:"issuer"`
+		Audience string `mapstructure:"audience"`
+		// JWKSURL или JWKSFile — источник ключей проверки подписи.
+		JWKSURL         string   `mapstructure:"jwks_url"`
+		JWKSFile        string   `mapstructure:"jwks_file"`
+		RefreshInterval string   `mapstructure:"refresh_interval"`
+		Leeway          string   `mapstructure:"leeway"`
+		PublicMethods   []string `mapstructure:"public_methods"`
+		RolesClaim      string   `mapstructure:"roles_claim"`
+		TenantClaim     string   `mapstructure:"tenant_claim"`
+		// PolicyFile — YAML с политиками доступа к методам; пустое значение — встроенные политики.
+		PolicyFile string `mapstructure:"policy_file"`
+	} `mapstructure:"auth"`
+
+	// Timeouts — бюджет вызова: default для запросов без дедлайна, max ограничивает
+	// клиентский дедлайн; methods переопределяет их для отдельных методов.
+	Timeouts struct {
+		Default string          `mapstructure:"default"`
+		Max     string          `mapstructure:"max"`
+		Methods []MethodTimeout `mapstructure:"methods"`
+	} `mapstructure:"timeouts"`
+
+	Gateway struct {
+		// Addr — адрес HTTP-сервера REST/JSON-шлюза, отдельного от сервера метрик.
+		Addr string `mapstructure:"addr"`
+		CORS struct {
+			AllowedOrigins   []string `mapstructure:"allowed_origins"`
+			AllowedHeaders   []string `mapstructure:"allowed_headers"`
+			ExposedHeaders   []string `mapstructure:"exposed_headers"`
+			AllowCredentials bool     `mapstructure:"allow_credentials"`
+			MaxAge           string   `mapstructure:"max_age"`
+		} `mapstructure:"cors"`
+	} `mapstructure:"gateway"`
+
 	Postgres struct {
 		DSN            string `mapstructure:"dsn"`
 		MaxConns       int32  `mapstructure:"max_conns"`
 		MigrationsPath string `mapstructure:"migrations_path"`
+		SnapshotEvery  int    `mapstructure:"snapshot_every"`
 	} `mapstructure:"postgres"`
 
 	Kafka struct {
@@ -34,11 +80,86 @@ type Config struct {
 		TTL      string `mapstructure:"ttl"`
 	} `mapstructure:"redis"`
 
+	RateLimit struct {
+		// Backend — "memory" (лимит на реплику) или "redis" (общий лимит, использует секцию redis).
+		Backend          string `mapstructure:"backend"`
+		Prefix           string `mapstructure:"prefix"`
+		ratelimit.Config `mapstructure:",squash"`
+	} `mapstructure:"rate_limit"`
+
+	// Concurrency — адаптивный лимит одновременных вызовов; при перегрузке первыми
+	// отклоняются методы класса sheddable.
+	Concurrency struct {
+		Disabled           bool `mapstructure:"disabled"`
+		concurrency.Config `mapstructure:",squash"`
+	} `mapstructure:"concurrency"`
+
 	Search struct {
 		Endpoint string `mapstructure:"endpoint"`
 		Index    string `mapstructure:"index"`
 	} `mapstructure:"search"`
 
+	Dedup struct {
+		MinScore      float64 `mapstructure:"min_score"`
+		MaxCandidates int     `mapstructure:"max_candidates"`
+	} `mapstructure:"dedup"`
+
+	Tenancy struct {
+		DefaultTenant string `mapstructure:"default_tenant"`
+	} `mapstructure:"tenancy"`
+
+	Import struct {
+		BatchSize int `mapstructure:"batch_size"`
+	} `mapstructure:"import"`
+
+	Timeline struct {
+		// RestrictedTypes сопоставляет чувствительные типы записей ролям, которым они доступны.
+		RestrictedTypes map[string][]string `mapstructure:"restricted_types"`
+		ManagerRoles    []string            `mapstructure:"manager_roles"`
+	} `mapstructure:"timeline"`
+
+	Health struct {
+		MedicalRoles []string `mapstructure:"medical_roles"`
+		// EncryptionKeys — ключи AES-256 в base64 по идентификаторам; новые анкеты
+		// шифруются ключом ActiveKeyID, старые читаются любым из перечисленных.
+		EncryptionKeys map[string]string `mapstructure:"encryption_keys"`
+		ActiveKeyID    string            `mapstructure:"active_key_id"`
+	} `mapstructure:"health"`
+
+	Attachments struct {
+		// Backend выбирает хранилище содержимого: "local" или "s3".
+		Backend      string `mapstructure:"backend"`
+		MaxSizeBytes int64  `mapstructure:"max_size_bytes"`
+		URLTTL       string `mapstructure:"url_ttl"`
+		Local        struct {
+			Root string `mapstructure:"root"`
+			// BaseURL — внешний адрес обработчика скачивания на HTTP-сервере метрик.
+			BaseURL    string `mapstructure:"base_url"`
+			SigningKey string `mapstructure:"signing_key"`
+		} `mapstructure:"local"`
+		S3 struct {
+			Endpoint  string `mapstructure:"endpoint"`
+			Region    string `mapstructure:"region"`
+			Bucket    string `mapstructure:"bucket"`
+			AccessKey string `mapstructure:"access_key"`
+			SecretKey string `mapstructure:"secret_key"`
+			UseSSL    bool   `mapstructure:"use_ssl"`
+		} `mapstructure:"s3"`
+	} `mapstructure:"attachments"`
+
+	Household struct {
+		GuardianRequiredUnderAge int `mapstructure:"guardian_required_under_age"`
+	} `mapstructure:"household"`
+
+	Probes struct {
+		Timeout  string `mapstructure:"timeout"`
+		CacheTTL string `mapstructure:"cache_ttl"`
+		// Interval — период обновления статуса grpc.health.v1.
+		Interval string `mapstructure:"interval"`
+		// NonCritical — зависимости, сбой которых переводит сервис в degraded, а не в down.
+		NonCritical []string `mapstructure:"non_critical"`
+	} `mapstructure:"probes"`
+
 	Observability struct {
 		Metrics struct {
 			Addr string `mapstructure:"addr"`
@@ -56,9 +177,25 @@ type Config struct {
 			Endpoint string `mapstructure:"endpoint"`
 			Insecure bool   `mapstructure:"insecure"`
 		} `mapstructure:"tracing"`
+
+		// Logging управляет журналом вызовов. Тела и метаданные пишутся только при payloads,
+		// поля с debug_redact и перечисленные в sensitive_fields маскируются.
+		Logging struct {
+			Payloads          bool     `mapstructure:"payloads"`
+			SensitiveFields   []string `mapstructure:"sensitive_fields"`
+			SensitiveMetadata []string `mapstructure:"sensitive_metadata"`
+		} `mapstructure:"logging"`
 	} `mapstructure:"observability"`
 }
 
+// MethodTimeout переопределяет таймауты одного метода, например длинного импорта.
+type MethodTimeout struct {
+	Method    string `mapstructure:"method"`
+	Default   string `mapstructure:"default"`
+	Max       string `mapstructure:"max"`
+	Unbounded bool   `mapstructure:"unbounded"`
+}
+
 // Defaults заполняет значения по умолчанию.
 func (c *Config) Defaults() {
 	if c.ServiceName == "" {
@@ -67,24 +204,148 @@ func (c *Config) Defaults() {
 	if c.GRPC.Port == 0 {
 		c.GRPC.Port = 50051
 	}
+	if c.Auth.RefreshInterval == "" {
+		c.Auth.RefreshInterval = "15m"
+	}
+	if c.Auth.Leeway == "" {
+		c.Auth.Leeway = "30s"
+	}
+	if c.Auth.Audience == "" {
+		c.Auth.Audience = c.ServiceName
+	}
+	if c.Timeouts.Default == "" {
+		c.Timeouts.Default = "10s"
+	}
+	if c.Timeouts.Max == "" {
+		c.Timeouts.Max = "30s"
+	}
+	if len(c.Timeouts.Methods) == 0 {
+		c.Timeouts.Methods = []MethodTimeout{
+			{Method: "/holo.customer.v1.CustomerService/ImportCustomers", Default: "10m", Max: "30m"},
+			{Method: "/holo.customer.v1.CustomerService/UploadAttachment", Default: "5m", Max: "15m"},
+			{Method: "/holo.customer.v1.CustomerService/ExportCustomers", Default: "30m", Max: "2h"},
+			{Method: "/grpc.health.v1.Health/Watch", Unbounded: true},
+		}
+	}
+	if c.Gateway.Addr == "" {
+		c.Gateway.Addr = ":8080"
+	}
+	if c.Gateway.CORS.MaxAge == "" {
+		c.Gateway.CORS.MaxAge = "10m"
+	}
 	if c.Redis.TTL == "" {
 		c.Redis.TTL = "10m"
 	}
+	if c.RateLimit.Backend == "" {
+		c.RateLimit.Backend = "memory"
+	}
+	if c.RateLimit.Prefix == "" {
+		c.RateLimit.Prefix = c.ServiceName + ":ratelimit:"
+	}
+	if c.RateLimit.Default.Unlimited() && len(c.RateLimit.Rules) == 0 {
+		c.RateLimit.Default = ratelimit.Limit{Rate: 50, Burst: 100}
+		c.RateLimit.Rules = []ratelimit.Rule{
+			{Method: "/holo.customer.v1.CustomerService/RegisterCustomer", Limit: ratelimit.Limit{Rate: 5, Burst: 10}},
+			{Method: "/holo.customer.v1.CustomerService/ImportCustomers", Limit: ratelimit.Limit{Rate: 0.1, Burst: 1}},
+		}
+	}
+	if c.Concurrency.InitialLimit == 0 {
+		c.Concurrency.InitialLimit = 20
+	}
+	if c.Concurrency.MinLimit == 0 {
+		c.Concurrency.MinLimit = 4
+	}
+	if c.Concurrency.MaxLimit == 0 {
+		c.Concurrency.MaxLimit = 200
+	}
+	if len(c.Concurrency.Classes) == 0 {
+		const svc = "/holo.customer.v1.CustomerService/"
+		c.Concurrency.Classes = []concurrency.Class{
+			{Method: "/grpc.health.v1.Health/", Priority: concurrency.Critical},
+			{Method: svc + "GetCustomer", Priority: concurrency.Critical},
+			{Method: svc + "RegisterCustomer", Priority: concurrency.Critical},
+			{Method: svc + "ListCustomers", Priority: concurrency.Sheddable},
+			{Method: svc + "ExportCustomers", Priority: concurrency.Sheddable},
+			{Method: svc + "ImportCustomers", Priority: concurrency.Sheddable},
+			{Method: svc + "GetCustomerHistory", Priority: concurrency.Sheddable},
+			{Method: svc + "QueryAuditLog", Priority: concurrency.Sheddable},
+		}
+	}
 	if c.Kafka.OutboxPollInterval == "" {
 		c.Kafka.OutboxPollInterval = "500ms"
 	}
 	if c.Search.Index == "" {
 		c.Search.Index = "customers"
 	}
+	if c.Dedup.MinScore == 0 {
+		c.Dedup.MinScore = 0.6
+	}
+	if c.Dedup.MaxCandidates == 0 {
+		c.Dedup.MaxCandidates = 5
+	}
+	if c.Household.GuardianRequiredUnderAge == 0 {
+		c.Household.GuardianRequiredUnderAge = 18
+	}
+	if c.Import.BatchSize == 0 {
+		c.Import.BatchSize = 500
+	}
+	if c.Timeline.RestrictedTypes == nil {
+		c.Timeline.RestrictedTypes = map[string][]string{
+			"injury":    {"trainer", "manager"},
+			"complaint": {"front_desk", "manager"},
+		}
+	}
+	if len(c.Timeline.ManagerRoles) == 0 {
+		c.Timeline.ManagerRoles = []string{"manager", "admin"}
+	}
+	if len(c.Health.MedicalRoles) == 0 {
+		c.Health.MedicalRoles = []string{"medic", "doctor"}
+	}
+	if c.Attachments.Backend == "" {
+		c.Attachments.Backend = "local"
+	}
+	if c.Attachments.MaxSizeBytes == 0 {
+		c.Attachments.MaxSizeBytes = 20 << 20
+	}
+	if c.Attachments.URLTTL == "" {
+		c.Attachments.URLTTL = "15m"
+	}
+	if c.Attachments.Local.Root == "" {
+		c.Attachments.Local.Root = "/var/lib/customer-service/attachments"
+	}
+	if c.Attachments.Local.BaseURL == "" {
+		c.Attachments.Local.BaseURL = "http://localhost:9100/attachments/"
+	}
+	if c.Attachments.S3.Bucket == "" {
+		c.Attachments.S3.Bucket = "customer-attachments"
+	}
+	if c.Kafka.ConsumerGroup == "" {
+		c.Kafka.ConsumerGroup = "customer-service-timeline"
+	}
 	if c.Postgres.MaxConns == 0 {
 		c.Postgres.MaxConns = 16
 	}
+	if c.Postgres.SnapshotEvery == 0 {
+		c.Postgres.SnapshotEvery = 20
+	}
 	if c.Kafka.DLQ.Database == "" {
 		c.Kafka.DLQ.Database = "holo_dlq"
 	}
 	if c.Kafka.DLQ.Collection == "" {
 		c.Kafka.DLQ.Collection = "customer_events"
 	}
+	if c.Probes.Timeout == "" {
+		c.Probes.Timeout = "2s"
+	}
+	if c.Probes.CacheTTL == "" {
+		c.Probes.CacheTTL = "5s"
+	}
+	if c.Probes.Interval == "" {
+		c.Probes.Interval = "10s"
+	}
+	if c.Probes.NonCritical == nil {
+		c.Probes.NonCritical = []string{"opensearch", "mongo"}
+	}
 	if c.Observability.Metrics.Addr == "" {
 		c.Observability.Metrics.Addr = ":9100"
 	}
@@ -0,0 +1,18 @@
+package app
+
+import (
+	_ "embed"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/authz"
+)
+
+//go:embed policies.yaml
+var defaultPolicies []byte
+
+// loadPolicies читает политики доступа из файла или берёт встроенные по умолчанию.
+func loadPolicies(path string) (*authz.Engine, error) {
+	if path == "" {
+		return authz.Parse(defaultPolicies)
+	}
+	return authz.Load(path)
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/authz"
+	customerpb "github.com/evgeniySeleznev/nwHS/services/customer-service/api/gen/customer/v1"
+)
+
+func TestDefaultPoliciesCoverEveryMethod(t *testing.T) {
+	engine, err := loadPolicies("")
+	if err != nil {
+		t.Fatalf("load default policies: %v", err)
+	}
+
+	admin := authz.Subject{ID: "admin-1", Roles: []string{"admin"}}
+	service := customerpb.CustomerService_ServiceDesc
+	var methods []string
+	for _, method := range service.Methods {
+		methods = append(methods, method.MethodName)
+	}
+	for _, stream := range service.Streams {
+		methods = append(methods, stream.StreamName)
+	}
+	for _, method := range methods {
+		full := "/" + service.ServiceName + "/" + method
+		if decision := engine.Authorize(full, admin, nil); decision.Reason == authz.ReasonNoPolicy {
+			t.Fatalf("no policy for %s", full)
+		}
+	}
+}
+
+func TestDefaultPoliciesRestrictCustomers(t *testing.T) {
+	engine, err := loadPolicies("")
+	if err != nil {
+		t.Fatalf("load default policies: %v", err)
+	}
+	customer := authz.Subject{ID: "c-1", Roles: []string{"customer"}}
+	get := "/holo.customer.v1.CustomerService/GetCustomer"
+
+	if !engine.Authorize(get, customer, &customerpb.GetCustomerRequest{Id: "c-1"}).Allowed {
+		t.Fatalf("customer must read own profile")
+	}
+	if engine.Authorize(get, customer, &customerpb.GetCustomerRequest{Id: "c-2"}).Allowed {
+		t.Fatalf("customer must not read foreign profile")
+	}
+	if engine.Authorize("/holo.customer.v1.CustomerService/EraseCustomer", authz.Subject{ID: "s-1", Roles: []string{"front_desk"}}, nil).Allowed {
+		t.Fatalf("front desk must not erase customers")
+	}
+}
@@ -0,0 +1,281 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// sniffLen — объём начала файла, по которому определяется тип содержимого.
+const sniffLen = 512
+
+// UploadAttachment описывает загрузку файла клиента. Checksum — необязательный
+// SHA-256 содержимого в hex, рассчитанный клиентом.
+type UploadAttachment struct {
+	CustomerID string
+	Kind       string
+	FileName   string
+	Checksum   string
+	Content    io.Reader
+}
+
+// DeleteAttachment описывает удаление вложения.
+type DeleteAttachment struct {
+	CustomerID   string
+	AttachmentID string
+}
+
+// BlobStore хранит содержимое вложений.
+type BlobStore interface {
+	Put(ctx context.Context, key string, body io.Reader, contentType string) error
+	Delete(ctx context.Context, key string) error
+}
+
+// AttachmentRepository определяет хранилище метаданных вложений.
+type AttachmentRepository interface {
+	Save(ctx context.Context, attachment *models.Attachment) error
+	GetAttachment(ctx context.Context, id string) (*models.Attachment, error)
+	ListAttachments(ctx context.Context, customerID uuid.UUID) ([]*models.Attachment, error)
+	DeleteAttachments(ctx context.Context, ids []uuid.UUID) error
+}
+
+// AttachmentHandler реализует загрузку и удаление вложений клиентов.
+type AttachmentHandler struct {
+	customers   CustomerReader
+	attachments AttachmentRepository
+	blobs       BlobStore
+	tx          Transactor
+	auditLog    AuditLog
+	policy      models.AttachmentPolicy
+	logger      *zap.Logger
+	clockNow    func() time.Time
+}
+
+// NewAttachmentHandler создаёт обработчик с зависимостями.
+func NewAttachmentHandler(customers CustomerReader, attachments AttachmentRepository, blobs BlobStore, tx Transactor, auditLog AuditLog, policy models.AttachmentPolicy, logger *zap.Logger) *AttachmentHandler {
+	return &AttachmentHandler{
+		customers:   customers,
+		attachments: attachments,
+		blobs:       blobs,
+		tx:          tx,
+		auditLog:    auditLog,
+		policy:      policy,
+		logger:      logger,
+		clockNow:    time.Now,
+	}
+}
+
+// Upload сохраняет содержимое в blob-хранилище потоком, проверяя тип по первым
+// байтам и размер по мере чтения, затем записывает метаданные. Если файл
+// отклонён после начала записи, загруженный объект удаляется.
+func (h *AttachmentHandler) Upload(ctx context.Context, cmd UploadAttachment) (*models.Attachment, error) {
+	kind, err := valueobjects.ParseAttachmentKind(cmd.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := h.customers.GetByID(ctx, cmd.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("get customer by id: %w", err)
+	}
+
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limited := &limitedReader{r: cmd.Content, limit: h.policy.MaxSize}
+	content := bufio.NewReaderSize(limited, sniffLen)
+	head, err := content.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		if limited.exceeded {
+			return nil, valueobjects.ErrAttachmentTooLarge
+		}
+		return nil, fmt.Errorf("read attachment: %w", err)
+	}
+	if len(head) == 0 {
+		return nil, valueobjects.ErrEmptyAttachment
+	}
+
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil || !h.policy.Allows(kind, contentType) {
+		return nil, valueobjects.ErrUnsupportedContentType
+	}
+
+	id := uuid.New()
+	key := fmt.Sprintf("%s/%s/%s", tenantID, customer.ID(), id)
+	hasher := sha256.New()
+	counter := &countingReader{r: io.TeeReader(content, hasher)}
+
+	if err := h.blobs.Put(ctx, key, counter, contentType); err != nil {
+		h.discard(ctx, key)
+		if limited.exceeded {
+			return nil, valueobjects.ErrAttachmentTooLarge
+		}
+		return nil, fmt.Errorf("store attachment: %w", err)
+	}
+
+	checksum := hex.EncodeToString(hasher.Sum(nil))
+	if cmd.Checksum != "" && !strings.EqualFold(cmd.Checksum, checksum) {
+		h.discard(ctx, key)
+		return nil, valueobjects.ErrChecksumMismatch
+	}
+
+	now := h.clockNow().UTC()
+	actor := audit.ActorFromContext(ctx)
+	attachment, err := models.NewAttachment(id, customer.ID(), kind, cmd.FileName, contentType, counter.n, checksum, key, actor.ID, h.policy, now)
+	if err != nil {
+		h.discard(ctx, key)
+		return nil, err
+	}
+
+	err = h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.attachments.Save(ctx, attachment); err != nil {
+			return fmt.Errorf("save attachment: %w", err)
+		}
+
+		record := newAuditRecord(ctx, audit.ActionAttachmentUploaded, audit.AggregateAttachment, attachment.ID().String(), nil, audit.AttachmentState(attachment), now)
+		if err := h.auditLog.Append(ctx, record); err != nil {
+			return fmt.Errorf("append audit record: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		h.discard(ctx, key)
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// Delete удаляет вложение клиента.
+func (h *AttachmentHandler) Delete(ctx context.Context, cmd DeleteAttachment) error {
+	customer, err := h.customers.GetByID(ctx, cmd.CustomerID)
+	if err != nil {
+		return fmt.Errorf("get customer by id: %w", err)
+	}
+
+	attachment, err := h.attachments.GetAttachment(ctx, cmd.AttachmentID)
+	if err != nil {
+		return fmt.Errorf("get attachment: %w", err)
+	}
+
+	// Вложение слитого дубликата принадлежит основному клиенту.
+	owner, err := h.customers.GetByID(ctx, attachment.CustomerID().String())
+	if err != nil {
+		return fmt.Errorf("get attachment owner: %w", err)
+	}
+	if owner.ID() != customer.ID() {
+		return valueobjects.ErrAttachmentNotFound
+	}
+
+	return h.remove(ctx, []*models.Attachment{attachment})
+}
+
+// EraseCustomer удаляет все вложения клиента, включая вложения слитых дубликатов.
+func (h *AttachmentHandler) EraseCustomer(ctx context.Context, customerID uuid.UUID) error {
+	attachments, err := h.attachments.ListAttachments(ctx, customerID)
+	if err != nil {
+		return fmt.Errorf("list attachments: %w", err)
+	}
+	if len(attachments) == 0 {
+		return nil
+	}
+	return h.remove(ctx, attachments)
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (h *AttachmentHandler) WithClock(clock func() time.Time) {
+	if clock != nil {
+		h.clockNow = clock
+	}
+}
+
+// remove сначала удаляет объекты из хранилища, затем метаданные. Удаление
+// объектов идемпотентно, поэтому прерванную операцию можно безопасно повторить.
+// Без хранилища вложения не удаляются: метаданные без объектов не остаются.
+func (h *AttachmentHandler) remove(ctx context.Context, attachments []*models.Attachment) error {
+	if h.blobs == nil {
+		return valueobjects.ErrAttachmentsDisabled
+	}
+
+	ids := make([]uuid.UUID, 0, len(attachments))
+	for _, attachment := range attachments {
+		if err := h.blobs.Delete(ctx, attachment.StorageKey()); err != nil {
+			return fmt.Errorf("delete attachment content: %w", err)
+		}
+		ids = append(ids, attachment.ID())
+	}
+
+	now := h.clockNow().UTC()
+	return h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.attachments.DeleteAttachments(ctx, ids); err != nil {
+			return fmt.Errorf("delete attachments: %w", err)
+		}
+
+		for _, attachment := range attachments {
+			record := newAuditRecord(ctx, audit.ActionAttachmentDeleted, audit.AggregateAttachment, attachment.ID().String(), audit.AttachmentState(attachment), nil, now)
+			if err := h.auditLog.Append(ctx, record); err != nil {
+				return fmt.Errorf("append audit record: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// discard удаляет объект отклонённой загрузки.
+func (h *AttachmentHandler) discard(ctx context.Context, key string) {
+	if err := h.blobs.Delete(context.WithoutCancel(ctx), key); err != nil {
+		h.logger.Warn("failed to discard rejected attachment", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// limitedReader возвращает ErrAttachmentTooLarge, как только прочитано больше
+// limit байт. Нулевой лимит означает отсутствие ограничения.
+type limitedReader struct {
+	r        io.Reader
+	limit    int64
+	read     int64
+	exceeded bool
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.exceeded {
+		return 0, valueobjects.ErrAttachmentTooLarge
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.limit > 0 && l.read > l.limit {
+		l.exceeded = true
+		return 0, valueobjects.ErrAttachmentTooLarge
+	}
+	return n, err
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type fakeBlobStore struct{ objects map[string][]byte }
+
+func (f *fakeBlobStore) Put(ctx context.Context, key string, body io.Reader, contentType string) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	f.objects[key] = data
+	return nil
+}
+
+func (f *fakeBlobStore) Delete(ctx context.Context, key string) error {
+	delete(f.objects, key)
+	return nil
+}
+
+type fakeAttachmentRepo struct {
+	attachments map[uuid.UUID]*models.Attachment
+}
+
+func (f *fakeAttachmentRepo) Save(ctx context.Context, attachment *models.Attachment) error {
+	f.attachments[attachment.ID()] = attachment
+	return nil
+}
+
+func (f *fakeAttachmentRepo) GetAttachment(ctx context.Context, id string) (*models.Attachment, error) {
+	attachment, ok := f.attachments[uuid.MustParse(id)]
+	if !ok {
+		return nil, valueobjects.ErrAttachmentNotFound
+	}
+	return attachment, nil
+}
+
+func (f *fakeAttachmentRepo) ListAttachments(ctx context.Context, customerID uuid.UUID) ([]*models.Attachment, error) {
+	var result []*models.Attachment
+	for _, attachment := range f.attachments {
+		if attachment.CustomerID() == customerID {
+			result = append(result, attachment)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeAttachmentRepo) DeleteAttachments(ctx context.Context, ids []uuid.UUID) error {
+	for _, id := range ids {
+		delete(f.attachments, id)
+	}
+	return nil
+}
+
+// pngHeader — сигнатура PNG, достаточная для определения типа содержимого.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestAttachmentHandler_Upload(t *testing.T) {
+	customer, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	blobs := &fakeBlobStore{objects: map[string][]byte{}}
+	repo := &fakeAttachmentRepo{attachments: map[uuid.UUID]*models.Attachment{}}
+	auditLog := &fakeAuditLog{}
+	handler := NewAttachmentHandler(&fakeRepo{saved: customer}, repo, blobs, &fakeTx{}, auditLog, models.AttachmentPolicy{MaxSize: 64}, zap.NewNop())
+
+	ctx := tenant.WithTenant(context.Background(), "brand")
+	ctx = audit.WithActor(ctx, audit.Actor{ID: "desk-1", Role: "front_desk"})
+
+	content := append(append([]byte{}, pngHeader...), bytes.Repeat([]byte{1}, 16)...)
+	sum := sha256.Sum256(content)
+	attachment, err := handler.Upload(ctx, UploadAttachment{
+		CustomerID: customer.ID().String(),
+		Kind:       "photo",
+		FileName:   "../../etc/avatar.png",
+		Checksum:   hex.EncodeToString(sum[:]),
+		Content:    bytes.NewReader(content),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attachment.ContentType() != "image/png" || attachment.Size() != int64(len(content)) || attachment.FileName() != "avatar.png" {
+		t.Fatalf("unexpected attachment: %s %d %s", attachment.ContentType(), attachment.Size(), attachment.FileName())
+	}
+	if !bytes.Equal(blobs.objects[attachment.StorageKey()], content) {
+		t.Fatalf("expected content to be stored under %s", attachment.StorageKey())
+	}
+
+	cases := []struct {
+		name    string
+		kind    string
+		content []byte
+		sum     string
+		want    error
+	}{
+		{name: "too large", kind: "photo", content: append(append([]byte{}, pngHeader...), bytes.Repeat([]byte{1}, 64)...), want: valueobjects.ErrAttachmentTooLarge},
+		{name: "sniffed type", kind: "photo", content: []byte("%PDF-1.7 waiver"), want: valueobjects.ErrUnsupportedContentType},
+		{name: "checksum", kind: "waiver", content: []byte("%PDF-1.7 waiver"), sum: "deadbeef", want: valueobjects.ErrChecksumMismatch},
+		{name: "empty", kind: "waiver", content: nil, want: valueobjects.ErrEmptyAttachment},
+	}
+	for _, tc := range cases {
+		_, err := handler.Upload(ctx, UploadAttachment{CustomerID: customer.ID().String(), Kind: tc.kind, Checksum: tc.sum, Content: bytes.NewReader(tc.content)})
+		if !errors.Is(err, tc.want) {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+	if len(blobs.objects) != 1 || len(repo.attachments) != 1 {
+		t.Fatalf("expected rejected uploads to be discarded, got %d objects", len(blobs.objects))
+	}
+
+	erase := NewEraseCustomerHandler(&fakeRepo{saved: customer}, &fakeEraser{}, &fakeTx{}, auditLog, zap.NewNop(), handler)
+	if err := erase.Handle(ctx, EraseCustomer{CustomerID: customer.ID().String(), Reason: "gdpr request"}); err != nil {
+		t.Fatalf("unexpected erase error: %v", err)
+	}
+	if len(blobs.objects) != 0 || len(repo.attachments) != 0 {
+		t.Fatalf("expected erasure to remove attachments")
+	}
+	if last := auditLog.records[len(auditLog.records)-1]; last.Action != audit.ActionCustomerErased {
+		t.Fatalf("expected erasure to be audited, got %s", last.Action)
+	}
+}
+
+func TestAttachmentHandler_EraseWithoutStorage(t *testing.T) {
+	customer, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	customer.MarkEventsCommitted()
+
+	repo := &fakeAttachmentRepo{attachments: make(map[uuid.UUID]*models.Attachment)}
+	handler := NewAttachmentHandler(&fakeRepo{saved: customer}, repo, nil, &fakeTx{}, &fakeAuditLog{}, models.AttachmentPolicy{MaxSize: 64}, zap.NewNop())
+	ctx := context.Background()
+
+	if err := handler.EraseCustomer(ctx, customer.ID()); err != nil {
+		t.Fatalf("expected erasure without attachments to succeed, got %v", err)
+	}
+
+	attachment := models.RehydrateAttachment(uuid.New(), customer.ID(), "waiver", "waiver.pdf", "application/pdf", 15, "", "brand/customer/1", "staff-1", time.Now())
+	repo.attachments[attachment.ID()] = attachment
+	if err := handler.EraseCustomer(ctx, customer.ID()); !errors.Is(err, valueobjects.ErrAttachmentsDisabled) {
+		t.Fatalf("expected %v, got %v", valueobjects.ErrAttachmentsDisabled, err)
+	}
+	if len(repo.attachments) != 1 {
+		t.Fatalf("expected attachment metadata to be kept while its content cannot be deleted")
+	}
+}
@@ -0,0 +1,23 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+)
+
+// Transactor выполняет функцию в рамках одной транзакции хранилища.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// AuditLog добавляет записи в журнал аудита.
+type AuditLog interface {
+	Append(ctx context.Context, record audit.Record) error
+}
+
+// newAuditRecord собирает запись аудита из контекста запроса и снимков агрегата.
+func newAuditRecord(ctx context.Context, action audit.Action, aggregateType, aggregateID string, before, after map[string]interface{}, occurredAt time.Time) audit.Record {
+	return audit.NewRecord(ctx, action, aggregateType, aggregateID, before, after, occurredAt)
+}
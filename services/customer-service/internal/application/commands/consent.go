@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"go.uber.org/zap"
+)
+
+// UpdateConsent описывает согласие или отказ клиента от коммуникаций по каналу.
+type UpdateConsent struct {
+	CustomerID       string
+	Channel          string
+	Granted          bool
+	Source           string
+	LegalTextVersion string
+}
+
+// UpdatePreferences описывает изменение предпочтений коммуникации.
+type UpdatePreferences struct {
+	CustomerID       string
+	Language         string
+	Timezone         string
+	PreferredChannel string
+}
+
+// CustomerStore загружает и сохраняет изменённого клиента.
+type CustomerStore interface {
+	GetByID(ctx context.Context, id string) (*models.Customer, error)
+	Update(ctx context.Context, customer *models.Customer) error
+}
+
+// ContactSettingsHandler реализует изменение согласий и предпочтений клиента.
+type ContactSettingsHandler struct {
+	repo     CustomerStore
+	tx       Transactor
+	auditLog AuditLog
+	events   EventPublisher
+	logger   *zap.Logger
+	clockNow func() time.Time
+}
+
+// NewContactSettingsHandler создаёт обработчик с зависимостями.
+func NewContactSettingsHandler(repo CustomerStore, tx Transactor, auditLog AuditLog, events EventPublisher, logger *zap.Logger) *ContactSettingsHandler {
+	return &ContactSettingsHandler{
+		repo:     repo,
+		tx:       tx,
+		auditLog: auditLog,
+		events:   events,
+		logger:   logger,
+		clockNow: time.Now,
+	}
+}
+
+// UpdateConsent изменяет согласие и публикует ConsentChanged.
+func (h *ContactSettingsHandler) UpdateConsent(ctx context.Context, cmd UpdateConsent) error {
+	channel, err := valueobjects.ParseChannel(cmd.Channel)
+	if err != nil {
+		return err
+	}
+
+	return h.mutate(ctx, cmd.CustomerID, audit.ActionConsentChanged, func(customer *models.Customer) error {
+		return customer.ChangeConsent(channel, cmd.Granted, cmd.Source, cmd.LegalTextVersion)
+	})
+}
+
+// UpdatePreferences изменяет язык, часовой пояс и предпочтительный канал.
+func (h *ContactSettingsHandler) UpdatePreferences(ctx context.Context, cmd UpdatePreferences) error {
+	preferences, err := models.ParsePreferences(cmd.Language, cmd.Timezone, cmd.PreferredChannel)
+	if err != nil {
+		return err
+	}
+
+	return h.mutate(ctx, cmd.CustomerID, audit.ActionPreferencesUpdated, func(customer *models.Customer) error {
+		customer.UpdatePreferences(preferences)
+		return nil
+	})
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (h *ContactSettingsHandler) WithClock(clock func() time.Time) {
+	if clock != nil {
+		h.clockNow = clock
+	}
+}
+
+func (h *ContactSettingsHandler) mutate(ctx context.Context, customerID string, action audit.Action, change func(*models.Customer) error) error {
+	var customer *models.Customer
+
+	err := h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		customer, err = h.repo.GetByID(ctx, customerID)
+		if err != nil {
+			return err
+		}
+
+		before := audit.CustomerState(customer)
+		if err := change(customer); err != nil {
+			return err
+		}
+		if len(customer.PendingEvents()) == 0 {
+			return nil
+		}
+
+		if err := h.repo.Update(ctx, customer); err != nil {
+			return fmt.Errorf("update customer: %w", err)
+		}
+
+		record := newAuditRecord(ctx, action, audit.AggregateCustomer, customer.ID().String(), before, audit.CustomerState(customer), h.clockNow().UTC())
+		if err := h.auditLog.Append(ctx, record); err != nil {
+			return fmt.Errorf("append audit record: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	pending := customer.PendingEvents()
+	if len(pending) == 0 {
+		return nil
+	}
+	customer.MarkEventsCommitted()
+
+	if err := h.events.PublishEvents(ctx, pending); err != nil {
+		return fmt.Errorf("publish event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// EraseCustomer описывает удаление персональных данных клиента по запросу.
+type EraseCustomer struct {
+	CustomerID string
+	Reason     string
+}
+
+// ErasureStep удаляет данные клиента из одного хранилища. Шаг должен быть
+// идемпотентным: прерванное удаление повторяется целиком.
+type ErasureStep interface {
+	EraseCustomer(ctx context.Context, customerID uuid.UUID) error
+}
+
+// CustomerEraser обезличивает агрегат клиента: строку, снимки и поток событий.
+type CustomerEraser interface {
+	Erase(ctx context.Context, customer *models.Customer) error
+}
+
+// EraseCustomerHandler выполняет шаги удаления данных клиента и фиксирует итог в аудите.
+type EraseCustomerHandler struct {
+	customers CustomerReader
+	eraser    CustomerEraser
+	tx        Transactor
+	steps     []ErasureStep
+	auditLog  AuditLog
+	logger    *zap.Logger
+	clockNow  func() time.Time
+}
+
+// NewEraseCustomerHandler создаёт обработчик. Шаги выполняются в порядке передачи
+// до обезличивания агрегата.
+func NewEraseCustomerHandler(customers CustomerReader, eraser CustomerEraser, tx Transactor, auditLog AuditLog, logger *zap.Logger, steps ...ErasureStep) *EraseCustomerHandler {
+	return &EraseCustomerHandler{
+		customers: customers,
+		eraser:    eraser,
+		tx:        tx,
+		steps:     steps,
+		auditLog:  auditLog,
+		logger:    logger,
+		clockNow:  time.Now,
+	}
+}
+
+// Handle удаляет данные клиента. Шаги и обезличивание агрегата выполняются в
+// одной транзакции, запись аудита добавляется в неё же: при ошибке любого шага
+// клиент остаётся неудалённым и запрос можно повторить.
+func (h *EraseCustomerHandler) Handle(ctx context.Context, cmd EraseCustomer) error {
+	var customer *models.Customer
+
+	err := h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		customer, err = h.customers.GetByID(ctx, cmd.CustomerID)
+		if err != nil {
+			return fmt.Errorf("get customer by id: %w", err)
+		}
+
+		for _, step := range h.steps {
+			if err := step.EraseCustomer(ctx, customer.ID()); err != nil {
+				return fmt.Errorf("erase customer: %w", err)
+			}
+		}
+
+		customer.Erase()
+		if err := h.eraser.Erase(ctx, customer); err != nil {
+			return fmt.Errorf("erase customer aggregate: %w", err)
+		}
+
+		record := newAuditRecord(ctx, audit.ActionCustomerErased, audit.AggregateCustomer, customer.ID().String(), nil, map[string]interface{}{"reason": cmd.Reason}, h.clockNow().UTC())
+		if err := h.auditLog.Append(ctx, record); err != nil {
+			return fmt.Errorf("append audit record: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	customer.MarkEventsCommitted()
+	h.logger.Info("customer data erased", zap.String("customer_id", customer.ID().String()))
+	return nil
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (h *EraseCustomerHandler) WithClock(clock func() time.Time) {
+	if clock != nil {
+		h.clockNow = clock
+	}
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type fakeEraser struct {
+	erased  []*models.Customer
+	pending []events.Envelope
+	err     error
+}
+
+func (f *fakeEraser) Erase(ctx context.Context, customer *models.Customer) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.erased = append(f.erased, customer)
+	f.pending = append(f.pending, customer.PendingEvents()...)
+	return nil
+}
+
+type fakeErasureStep struct {
+	name  string
+	calls *[]string
+	err   error
+}
+
+func (f fakeErasureStep) EraseCustomer(ctx context.Context, customerID uuid.UUID) error {
+	*f.calls = append(*f.calls, f.name+":"+customerID.String())
+	return f.err
+}
+
+func TestEraseCustomerHandler(t *testing.T) {
+	customer, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	customer.MarkEventsCommitted()
+
+	var calls []string
+	eraser := &fakeEraser{}
+	auditLog := &fakeAuditLog{}
+	tx := &fakeTx{}
+	handler := NewEraseCustomerHandler(&fakeRepo{saved: customer}, eraser, tx, auditLog, zap.NewNop(),
+		fakeErasureStep{name: "timeline", calls: &calls},
+		fakeErasureStep{name: "search", calls: &calls},
+	)
+
+	if err := handler.Handle(context.Background(), EraseCustomer{CustomerID: customer.ID().String(), Reason: "gdpr request"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	id := customer.ID().String()
+	if len(calls) != 2 || calls[0] != "timeline:"+id || calls[1] != "search:"+id {
+		t.Fatalf("expected steps to run in order, got %v", calls)
+	}
+	if tx.calls != 1 {
+		t.Fatalf("expected erasure to run in one transaction, got %d", tx.calls)
+	}
+	if len(eraser.erased) != 1 || !eraser.erased[0].IsErased() {
+		t.Fatalf("expected aggregate to be erased before it is stored")
+	}
+	if len(eraser.pending) != 1 || eraser.pending[0].Type != events.TypeCustomerErased || eraser.pending[0].Version != 2 {
+		t.Fatalf("expected a single tombstone event, got %+v", eraser.pending)
+	}
+	if customer.Email().String() != "" || customer.FullName() != "" || len(customer.PendingEvents()) != 0 {
+		t.Fatalf("expected personal data to be cleared and events committed, got %+v", customer.Snapshot())
+	}
+	if len(auditLog.records) != 1 || auditLog.records[0].Action != audit.ActionCustomerErased {
+		t.Fatalf("expected erasure to be audited, got %+v", auditLog.records)
+	}
+}
+
+func TestEraseCustomerHandler_StepFailure(t *testing.T) {
+	customer, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	customer.MarkEventsCommitted()
+
+	var calls []string
+	eraser := &fakeEraser{}
+	auditLog := &fakeAuditLog{}
+	boom := errors.New("index unavailable")
+	handler := NewEraseCustomerHandler(&fakeRepo{saved: customer}, eraser, &fakeTx{}, auditLog, zap.NewNop(),
+		fakeErasureStep{name: "search", calls: &calls, err: boom},
+	)
+
+	if err := handler.Handle(context.Background(), EraseCustomer{CustomerID: customer.ID().String()}); !errors.Is(err, boom) {
+		t.Fatalf("expected step error, got %v", err)
+	}
+	if len(eraser.erased) != 0 || len(auditLog.records) != 0 {
+		t.Fatalf("expected failed erasure to leave the aggregate and audit log untouched")
+	}
+	if customer.IsErased() {
+		t.Fatalf("expected customer to stay unerased")
+	}
+}
@@ -0,0 +1,194 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"go.uber.org/zap"
+)
+
+// PublishQuestionnaire описывает публикацию новой версии анкеты здоровья.
+// RequireReconsent делает анкеты предыдущих версий недействительными.
+type PublishQuestionnaire struct {
+	Code             string
+	Title            string
+	Questions        []models.Question
+	ValidFor         time.Duration
+	RequireReconsent bool
+}
+
+// SubmitHealthQuestionnaire описывает подписанную клиентом анкету.
+type SubmitHealthQuestionnaire struct {
+	CustomerID    string
+	Code          string
+	Answers       map[string]string
+	SignerName    string
+	SignatureData string
+	SignedAt      time.Time
+}
+
+// HealthRepository определяет хранилище анкет здоровья.
+type HealthRepository interface {
+	SaveDefinition(ctx context.Context, definition *models.QuestionnaireDefinition) error
+	CurrentDefinition(ctx context.Context, code string) (*models.QuestionnaireDefinition, error)
+	SaveSubmission(ctx context.Context, submission *models.HealthSubmission) error
+}
+
+// HealthHandler реализует команды анкет здоровья.
+type HealthHandler struct {
+	customers CustomerReader
+	health    HealthRepository
+	tx        Transactor
+	auditLog  AuditLog
+	events    EventPublisher
+	policy    models.HealthAccessPolicy
+	logger    *zap.Logger
+	clockNow  func() time.Time
+}
+
+// NewHealthHandler создаёт обработчик с зависимостями.
+func NewHealthHandler(customers CustomerReader, health HealthRepository, tx Transactor, auditLog AuditLog, events EventPublisher, policy models.HealthAccessPolicy, logger *zap.Logger) *HealthHandler {
+	return &HealthHandler{
+		customers: customers,
+		health:    health,
+		tx:        tx,
+		auditLog:  auditLog,
+		events:    events,
+		policy:    policy,
+		logger:    logger,
+		clockNow:  time.Now,
+	}
+}
+
+// PublishQuestionnaire публикует следующую версию анкеты и возвращает её номер.
+// Публиковать анкеты могут только медицинские роли.
+func (h *HealthHandler) PublishQuestionnaire(ctx context.Context, cmd PublishQuestionnaire) (int, error) {
+	if !h.policy.CanReadRecords(audit.ActorFromContext(ctx).Role) {
+		return 0, valueobjects.ErrHealthAccessDenied
+	}
+
+	var definition *models.QuestionnaireDefinition
+	err := h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		previous, err := h.health.CurrentDefinition(ctx, cmd.Code)
+		if err != nil && !errors.Is(err, valueobjects.ErrQuestionnaireNotFound) {
+			return fmt.Errorf("load questionnaire: %w", err)
+		}
+
+		now := h.clockNow().UTC()
+		definition, err = models.NewQuestionnaireDefinition(cmd.Code, cmd.Title, cmd.Questions, cmd.ValidFor, cmd.RequireReconsent, previous, now)
+		if err != nil {
+			return err
+		}
+
+		if err := h.health.SaveDefinition(ctx, definition); err != nil {
+			return fmt.Errorf("save questionnaire: %w", err)
+		}
+
+		record := newAuditRecord(ctx, audit.ActionQuestionnairePublished, audit.AggregateQuestionnaire, definition.ID.String(), nil, audit.QuestionnaireState(definition), now)
+		if err := h.auditLog.Append(ctx, record); err != nil {
+			return fmt.Errorf("append audit record: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	envelope := events.Envelope{
+		AggregateID: definition.ID.String(),
+		Version:     definition.Version,
+		Type:        events.TypeQuestionnairePublished,
+		Payload: events.QuestionnairePublished{
+			QuestionnaireID:    definition.ID.String(),
+			Code:               definition.Code,
+			Version:            definition.Version,
+			MinAcceptedVersion: definition.MinAcceptedVersion,
+			OccurredAt:         definition.PublishedAt,
+		},
+		OccurredAt: definition.PublishedAt,
+	}
+	if err := h.events.PublishEvents(ctx, []events.Envelope{envelope}); err != nil {
+		return 0, fmt.Errorf("publish event: %w", err)
+	}
+
+	return definition.Version, nil
+}
+
+// Submit сохраняет подписанную анкету по текущей версии определения.
+// Подать анкету может медицинский сотрудник или сам клиент.
+func (h *HealthHandler) Submit(ctx context.Context, cmd SubmitHealthQuestionnaire) (string, error) {
+	customer, err := h.customers.GetByID(ctx, cmd.CustomerID)
+	if err != nil {
+		return "", fmt.Errorf("get customer by id: %w", err)
+	}
+
+	actor := audit.ActorFromContext(ctx)
+	if !h.policy.CanSubmit(actor.ID, actor.Role, customer.ID()) {
+		return "", valueobjects.ErrHealthAccessDenied
+	}
+
+	definition, err := h.health.CurrentDefinition(ctx, cmd.Code)
+	if err != nil {
+		return "", fmt.Errorf("load questionnaire: %w", err)
+	}
+
+	now := h.clockNow().UTC()
+	submission, err := models.NewHealthSubmission(customer.ID(), definition, cmd.Answers, models.Signature{
+		SignerName: cmd.SignerName,
+		Data:       cmd.SignatureData,
+		SignedAt:   cmd.SignedAt.UTC(),
+	}, now)
+	if err != nil {
+		return "", err
+	}
+
+	err = h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.health.SaveSubmission(ctx, submission); err != nil {
+			return fmt.Errorf("save health submission: %w", err)
+		}
+
+		record := newAuditRecord(ctx, audit.ActionHealthSubmitted, audit.AggregateHealth, submission.ID().String(), nil, audit.HealthSubmissionState(submission), now)
+		if err := h.auditLog.Append(ctx, record); err != nil {
+			return fmt.Errorf("append audit record: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	envelope := events.Envelope{
+		AggregateID: customer.ID().String(),
+		Type:        events.TypeHealthQuestionnaireSubmitted,
+		Payload: events.HealthQuestionnaireSubmitted{
+			CustomerID:   customer.ID().String(),
+			SubmissionID: submission.ID().String(),
+			Code:         submission.QuestionnaireCode(),
+			Version:      submission.Version(),
+			Flags:        submission.Flags(),
+			ExpiresAt:    submission.ExpiresAt(),
+			OccurredAt:   now,
+		},
+		OccurredAt: now,
+	}
+	if err := h.events.PublishEvents(ctx, []events.Envelope{envelope}); err != nil {
+		return "", fmt.Errorf("publish event: %w", err)
+	}
+
+	return submission.ID().String(), nil
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (h *HealthHandler) WithClock(clock func() time.Time) {
+	if clock != nil {
+		h.clockNow = clock
+	}
+}
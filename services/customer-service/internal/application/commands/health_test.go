@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"go.uber.org/zap"
+)
+
+type fakeHealthRepo struct {
+	definition  *models.QuestionnaireDefinition
+	submissions []*models.HealthSubmission
+}
+
+func (f *fakeHealthRepo) SaveDefinition(ctx context.Context, definition *models.QuestionnaireDefinition) error {
+	f.definition = definition
+	return nil
+}
+
+func (f *fakeHealthRepo) CurrentDefinition(ctx context.Context, code string) (*models.QuestionnaireDefinition, error) {
+	if f.definition == nil || f.definition.Code != code {
+		return nil, valueobjects.ErrQuestionnaireNotFound
+	}
+	return f.definition, nil
+}
+
+func (f *fakeHealthRepo) SaveSubmission(ctx context.Context, submission *models.HealthSubmission) error {
+	f.submissions = append(f.submissions, submission)
+	return nil
+}
+
+func TestHealthHandler_PublishAndSubmit(t *testing.T) {
+	customer, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	health := &fakeHealthRepo{}
+	auditLog := &fakeAuditLog{}
+	publisher := &fakePublisher{}
+	handler := NewHealthHandler(&fakeRepo{saved: customer}, health, &fakeTx{}, auditLog, publisher, models.HealthAccessPolicy{MedicalRoles: []string{"medic"}}, zap.NewNop())
+
+	cmd := PublishQuestionnaire{
+		Code:      "par-q",
+		Questions: []models.Question{{ID: "heart", Text: "Heart condition?", Kind: models.QuestionYesNo, Required: true, FlagsOnYes: []string{models.FlagRequiresDoctorClearance}}},
+		ValidFor:  365 * 24 * time.Hour,
+	}
+
+	desk := audit.WithActor(context.Background(), audit.Actor{ID: "desk-1", Role: "front_desk"})
+	if _, err := handler.PublishQuestionnaire(desk, cmd); !errors.Is(err, valueobjects.ErrHealthAccessDenied) {
+		t.Fatalf("expected front desk to be denied, got %v", err)
+	}
+
+	medic := audit.WithActor(context.Background(), audit.Actor{ID: "medic-1", Role: "medic"})
+	version, err := handler.PublishQuestionnaire(medic, cmd)
+	if err != nil || version != 1 {
+		t.Fatalf("expected version 1, got %d, %v", version, err)
+	}
+
+	submit := SubmitHealthQuestionnaire{
+		CustomerID:    customer.ID().String(),
+		Code:          "par-q",
+		Answers:       map[string]string{"heart": "yes"},
+		SignerName:    "John Doe",
+		SignatureData: "typed:John Doe",
+	}
+	if _, err := handler.Submit(desk, submit); !errors.Is(err, valueobjects.ErrHealthAccessDenied) {
+		t.Fatalf("expected front desk submission to be denied, got %v", err)
+	}
+
+	self := audit.WithActor(context.Background(), audit.Actor{ID: customer.ID().String(), Role: models.CustomerRole})
+	if _, err := handler.Submit(self, submit); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(health.submissions) != 1 || health.submissions[0].Flags()[0] != models.FlagRequiresDoctorClearance {
+		t.Fatalf("expected flagged submission to be saved")
+	}
+
+	record := auditLog.records[len(auditLog.records)-1]
+	if record.Action != audit.ActionHealthSubmitted {
+		t.Fatalf("unexpected audit action: %s", record.Action)
+	}
+	for field := range record.Changes {
+		if field == "answers" || field == "signature" {
+			t.Fatalf("expected medical data to stay out of audit log, got %s", field)
+		}
+	}
+
+	if len(publisher.envelopes) != 2 {
+		t.Fatalf("expected publish and submit events, got %d", len(publisher.envelopes))
+	}
+}
@@ -0,0 +1,259 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CreateHousehold описывает создание домохозяйства.
+type CreateHousehold struct {
+	Name           string
+	PrimaryPayerID string
+}
+
+// AddHouseholdMember описывает добавление клиента в домохозяйство.
+// GuardianID обязателен для несовершеннолетних.
+type AddHouseholdMember struct {
+	HouseholdID string
+	CustomerID  string
+	GuardianID  string
+}
+
+// RemoveHouseholdMember описывает исключение клиента из домохозяйства.
+type RemoveHouseholdMember struct {
+	HouseholdID string
+	CustomerID  string
+}
+
+// TransferHouseholdMember описывает перевод клиента в другое домохозяйство.
+type TransferHouseholdMember struct {
+	CustomerID        string
+	TargetHouseholdID string
+	GuardianID        string
+}
+
+// CustomerReader загружает клиента по идентификатору.
+type CustomerReader interface {
+	GetByID(ctx context.Context, id string) (*models.Customer, error)
+}
+
+// HouseholdRepository определяет операции хранилища домохозяйств.
+type HouseholdRepository interface {
+	Save(ctx context.Context, household *models.Household) error
+	GetByID(ctx context.Context, id string) (*models.Household, error)
+	GetByCustomerID(ctx context.Context, customerID string) (*models.Household, error)
+}
+
+// EventPublisher публикует события агрегатов в шину.
+type EventPublisher interface {
+	PublishEvents(ctx context.Context, envelopes []events.Envelope) error
+}
+
+// HouseholdHandler реализует команды управления домохозяйствами.
+type HouseholdHandler struct {
+	customers  CustomerReader
+	households HouseholdRepository
+	tx         Transactor
+	auditLog   AuditLog
+	events     EventPublisher
+	policy     models.GuardianPolicy
+	logger     *zap.Logger
+	clockNow   func() time.Time
+}
+
+// NewHouseholdHandler создаёт обработчик с зависимостями.
+func NewHouseholdHandler(customers CustomerReader, households HouseholdRepository, tx Transactor, auditLog AuditLog, events EventPublisher, policy models.GuardianPolicy, logger *zap.Logger) *HouseholdHandler {
+	return &HouseholdHandler{
+		customers:  customers,
+		households: households,
+		tx:         tx,
+		auditLog:   auditLog,
+		events:     events,
+		policy:     policy,
+		logger:     logger,
+		clockNow:   time.Now,
+	}
+}
+
+// Create создаёт домохозяйство и возвращает его идентификатор.
+func (h *HouseholdHandler) Create(ctx context.Context, cmd CreateHousehold) (string, error) {
+	var household *models.Household
+
+	err := h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		payer, err := h.customers.GetByID(ctx, cmd.PrimaryPayerID)
+		if err != nil {
+			return fmt.Errorf("load primary payer: %w", err)
+		}
+
+		household, err = models.NewHousehold(cmd.Name, payer, h.policy, h.clockNow().UTC())
+		if err != nil {
+			return err
+		}
+
+		return h.save(ctx, audit.ActionHouseholdCreated, nil, household)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return household.ID().String(), h.publish(ctx, household)
+}
+
+// AddMember добавляет клиента в домохозяйство.
+func (h *HouseholdHandler) AddMember(ctx context.Context, cmd AddHouseholdMember) error {
+	guardianID, err := parseOptionalID(cmd.GuardianID)
+	if err != nil {
+		return err
+	}
+
+	var household *models.Household
+
+	err = h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		customer, err := h.customers.GetByID(ctx, cmd.CustomerID)
+		if err != nil {
+			return fmt.Errorf("load customer: %w", err)
+		}
+
+		household, err = h.households.GetByID(ctx, cmd.HouseholdID)
+		if err != nil {
+			return fmt.Errorf("load household: %w", err)
+		}
+
+		before := audit.HouseholdState(household)
+		if err := household.AddMember(customer, guardianID, h.policy, h.clockNow().UTC()); err != nil {
+			return err
+		}
+
+		return h.save(ctx, audit.ActionHouseholdMemberAdded, before, household)
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.publish(ctx, household)
+}
+
+// RemoveMember исключает клиента из домохозяйства.
+func (h *HouseholdHandler) RemoveMember(ctx context.Context, cmd RemoveHouseholdMember) error {
+	customerID, err := uuid.Parse(cmd.CustomerID)
+	if err != nil {
+		return fmt.Errorf("invalid customer id: %w", err)
+	}
+
+	var household *models.Household
+
+	err = h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		household, err = h.households.GetByID(ctx, cmd.HouseholdID)
+		if err != nil {
+			return fmt.Errorf("load household: %w", err)
+		}
+
+		before := audit.HouseholdState(household)
+		if err := household.RemoveMember(customerID, h.policy, h.clockNow().UTC()); err != nil {
+			return err
+		}
+
+		return h.save(ctx, audit.ActionHouseholdMemberRemoved, before, household)
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.publish(ctx, household)
+}
+
+// TransferMember переводит клиента из текущего домохозяйства в целевое.
+func (h *HouseholdHandler) TransferMember(ctx context.Context, cmd TransferHouseholdMember) error {
+	guardianID, err := parseOptionalID(cmd.GuardianID)
+	if err != nil {
+		return err
+	}
+
+	var source, target *models.Household
+
+	err = h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		customer, err := h.customers.GetByID(ctx, cmd.CustomerID)
+		if err != nil {
+			return fmt.Errorf("load customer: %w", err)
+		}
+
+		source, err = h.households.GetByCustomerID(ctx, cmd.CustomerID)
+		if err != nil {
+			return fmt.Errorf("load source household: %w", err)
+		}
+
+		target, err = h.households.GetByID(ctx, cmd.TargetHouseholdID)
+		if err != nil {
+			return fmt.Errorf("load target household: %w", err)
+		}
+
+		sourceBefore := audit.HouseholdState(source)
+		targetBefore := audit.HouseholdState(target)
+
+		if err := source.TransferMember(customer, target, guardianID, h.policy, h.clockNow().UTC()); err != nil {
+			return err
+		}
+
+		// Источник сохраняется первым, чтобы освободить уникальную привязку клиента.
+		if err := h.save(ctx, audit.ActionHouseholdMemberTransferred, sourceBefore, source); err != nil {
+			return err
+		}
+		return h.save(ctx, audit.ActionHouseholdMemberTransferred, targetBefore, target)
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.publish(ctx, source, target)
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (h *HouseholdHandler) WithClock(clock func() time.Time) {
+	if clock != nil {
+		h.clockNow = clock
+	}
+}
+
+func (h *HouseholdHandler) save(ctx context.Context, action audit.Action, before map[string]interface{}, household *models.Household) error {
+	if err := h.households.Save(ctx, household); err != nil {
+		return fmt.Errorf("save household: %w", err)
+	}
+
+	record := newAuditRecord(ctx, action, audit.AggregateHousehold, household.ID().String(), before, audit.HouseholdState(household), h.clockNow().UTC())
+	if err := h.auditLog.Append(ctx, record); err != nil {
+		return fmt.Errorf("append audit record: %w", err)
+	}
+
+	return nil
+}
+
+func (h *HouseholdHandler) publish(ctx context.Context, households ...*models.Household) error {
+	var pending []events.Envelope
+	for _, household := range households {
+		pending = append(pending, household.PendingEvents()...)
+		household.MarkEventsCommitted()
+	}
+
+	if err := h.events.PublishEvents(ctx, pending); err != nil {
+		return fmt.Errorf("publish event: %w", err)
+	}
+	return nil
+}
+
+func parseOptionalID(raw string) (uuid.UUID, error) {
+	if raw == "" {
+		return uuid.Nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid id %q: %w", raw, err)
+	}
+	return id, nil
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"go.uber.org/zap"
+)
+
+type fakeCustomerReader map[string]*models.Customer
+
+func (f fakeCustomerReader) GetByID(ctx context.Context, id string) (*models.Customer, error) {
+	customer, ok := f[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return customer, nil
+}
+
+type fakeHouseholdRepo struct {
+	households map[string]*models.Household
+	saved      []string
+}
+
+func (f *fakeHouseholdRepo) Save(ctx context.Context, household *models.Household) error {
+	f.saved = append(f.saved, household.ID().String())
+	return nil
+}
+
+func (f *fakeHouseholdRepo) GetByID(ctx context.Context, id string) (*models.Household, error) {
+	household, ok := f.households[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return household, nil
+}
+
+func (f *fakeHouseholdRepo) GetByCustomerID(ctx context.Context, customerID string) (*models.Household, error) {
+	for _, household := range f.households {
+		for _, member := range household.Members() {
+			if member.CustomerID.String() == customerID {
+				return household, nil
+			}
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func TestHouseholdHandler_TransferMember(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	policy := models.GuardianPolicy{AdultAge: 18}
+	payer, _ := models.NewCustomer("Payer", mustEmail("payer@example.com"), mustPhone("+1234567890"), time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC))
+	member, _ := models.NewCustomer("Member", mustEmail("member@example.com"), mustPhone("+1234567891"), time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC))
+	otherPayer, _ := models.NewCustomer("Other", mustEmail("other@example.com"), mustPhone("+1234567892"), time.Date(1981, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	source, _ := models.NewHousehold("Source", payer, policy, now)
+	target, _ := models.NewHousehold("Target", otherPayer, policy, now)
+	if err := source.AddMember(member, payer.ID(), policy, now); err != nil {
+		t.Fatalf("add member: %v", err)
+	}
+	source.MarkEventsCommitted()
+	target.MarkEventsCommitted()
+
+	repo := &fakeHouseholdRepo{households: map[string]*models.Household{
+		source.ID().String(): source,
+		target.ID().String(): target,
+	}}
+	publisher := &fakePublisher{}
+	handler := NewHouseholdHandler(fakeCustomerReader{member.ID().String(): member}, repo, &fakeTx{}, &fakeAuditLog{}, publisher, policy, zap.NewNop())
+	handler.WithClock(func() time.Time { return now })
+
+	err := handler.TransferMember(context.Background(), TransferHouseholdMember{
+		CustomerID:        member.ID().String(),
+		TargetHouseholdID: target.ID().String(),
+	})
+	if err != nil {
+		t.Fatalf("transfer: %v", err)
+	}
+
+	if len(publisher.envelopes) != 2 {
+		t.Fatalf("expected events for both households, got %+v", publisher.envelopes)
+	}
+	if got := publisher.envelopes[1]; got.Type != events.TypeHouseholdMemberAdded || got.AggregateID != target.ID().String() {
+		t.Fatalf("expected member added event on target, got %+v", got)
+	}
+	if len(source.PendingEvents()) != 0 || len(target.PendingEvents()) != 0 {
+		t.Fatalf("expected events committed on both households")
+	}
+	if target.PersistedVersion() != target.Version() {
+		t.Fatalf("expected target persisted version %d, got %d", target.Version(), target.PersistedVersion())
+	}
+}
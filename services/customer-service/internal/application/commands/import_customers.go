@@ -0,0 +1,279 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"go.uber.org/zap"
+)
+
+// DefaultImportBatchSize задаёт размер пачки записи при импорте.
+const DefaultImportBatchSize = 500
+
+// birthDateLayouts перечисляет допустимые форматы даты рождения в файлах импорта.
+var birthDateLayouts = []string{"2006-01-02", "02.01.2006", "01/02/2006"}
+
+// ImportRow описывает одну строку файла импорта в исходном виде.
+type ImportRow struct {
+	Line        int
+	FullName    string
+	Email       string
+	PhoneNumber string
+	BirthDate   string
+}
+
+// ImportSource поставляет строки импорта; конец данных обозначается io.EOF.
+type ImportSource interface {
+	Next(ctx context.Context) (ImportRow, error)
+}
+
+// ImportCustomers описывает команду массового импорта клиентов.
+type ImportCustomers struct {
+	Source ImportSource
+	DryRun bool
+}
+
+// ImportRowResult описывает результат обработки одной строки.
+type ImportRowResult struct {
+	Line       int
+	Email      string
+	CustomerID string
+	Error      string
+}
+
+// ImportReport содержит итоги импорта и построчный отчёт.
+type ImportReport struct {
+	DryRun   bool
+	Total    int
+	Imported int
+	Failed   int
+	Rows     []ImportRowResult
+}
+
+// CustomerBulkRepository определяет пакетную запись клиентов.
+type CustomerBulkRepository interface {
+	ExistingEmails(ctx context.Context, emails []string) (map[string]bool, error)
+	CopyCustomers(ctx context.Context, customers []*models.Customer) error
+}
+
+// BatchAuditLog добавляет записи аудита пачкой.
+type BatchAuditLog interface {
+	AppendBatch(ctx context.Context, records []audit.Record) error
+}
+
+// CustomerBulkIndexer индексирует клиентов пачкой.
+type CustomerBulkIndexer interface {
+	IndexBatch(ctx context.Context, customers []*models.Customer) error
+}
+
+// ImportCustomersHandler реализует массовый импорт клиентов из файла или потока.
+type ImportCustomersHandler struct {
+	repo      CustomerBulkRepository
+	tx        Transactor
+	auditLog  BatchAuditLog
+	indexer   CustomerBulkIndexer
+	events    EventPublisher
+	batchSize int
+	logger    *zap.Logger
+	clockNow  func() time.Time
+}
+
+// NewImportCustomersHandler создаёт обработчик с зависимостями.
+func NewImportCustomersHandler(repo CustomerBulkRepository, tx Transactor, auditLog BatchAuditLog, indexer CustomerBulkIndexer, events EventPublisher, batchSize int, logger *zap.Logger) *ImportCustomersHandler {
+	if batchSize <= 0 {
+		batchSize = DefaultImportBatchSize
+	}
+	return &ImportCustomersHandler{
+		repo:      repo,
+		tx:        tx,
+		auditLog:  auditLog,
+		indexer:   indexer,
+		events:    events,
+		batchSize: batchSize,
+		logger:    logger,
+		clockNow:  time.Now,
+	}
+}
+
+// pendingRow связывает провалидированного клиента с его строкой в отчёте.
+type pendingRow struct {
+	result   int
+	customer *models.Customer
+}
+
+// Handle читает строки источника, валидирует их и записывает пачками.
+// Ошибки отдельных строк попадают в отчёт и не прерывают импорт.
+func (h *ImportCustomersHandler) Handle(ctx context.Context, cmd ImportCustomers) (ImportReport, error) {
+	report := ImportReport{DryRun: cmd.DryRun}
+	seen := make(map[string]int)
+	batch := make([]pendingRow, 0, h.batchSize)
+
+	for {
+		row, err := cmd.Source.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return report, fmt.Errorf("read import row: %w", err)
+		}
+
+		report.Total++
+		if row.Line == 0 {
+			row.Line = report.Total
+		}
+		report.Rows = append(report.Rows, ImportRowResult{Line: row.Line, Email: strings.TrimSpace(row.Email)})
+		index := len(report.Rows) - 1
+
+		customer, err := h.parseRow(row)
+		if err != nil {
+			report.fail(index, err)
+			continue
+		}
+
+		email := customer.Email().String()
+		if line, ok := seen[email]; ok {
+			report.fail(index, fmt.Errorf("duplicate email in file, first seen on line %d", line))
+			continue
+		}
+		seen[email] = row.Line
+
+		batch = append(batch, pendingRow{result: index, customer: customer})
+		if len(batch) == h.batchSize {
+			if err := h.flush(ctx, cmd.DryRun, batch, &report); err != nil {
+				return report, err
+			}
+			batch = batch[:0]
+		}
+	}
+
+	if err := h.flush(ctx, cmd.DryRun, batch, &report); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (h *ImportCustomersHandler) WithClock(clock func() time.Time) {
+	if clock != nil {
+		h.clockNow = clock
+	}
+}
+
+func (h *ImportCustomersHandler) parseRow(row ImportRow) (*models.Customer, error) {
+	email, err := valueobjects.NewEmail(strings.TrimSpace(row.Email))
+	if err != nil {
+		return nil, err
+	}
+
+	phone, err := valueobjects.NewPhoneNumber(strings.TrimSpace(row.PhoneNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	birthDate, err := parseBirthDate(row.BirthDate)
+	if err != nil {
+		return nil, err
+	}
+	if birthDate.After(h.clockNow()) {
+		return nil, valueobjects.ErrInvalidBirthDay
+	}
+
+	return models.NewCustomer(strings.TrimSpace(row.FullName), email, phone, birthDate)
+}
+
+func (h *ImportCustomersHandler) flush(ctx context.Context, dryRun bool, batch []pendingRow, report *ImportReport) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	emails := make([]string, 0, len(batch))
+	for _, row := range batch {
+		emails = append(emails, row.customer.Email().String())
+	}
+
+	existing, err := h.repo.ExistingEmails(ctx, emails)
+	if err != nil {
+		return fmt.Errorf("check existing emails: %w", err)
+	}
+
+	accepted := batch[:0:0]
+	for _, row := range batch {
+		if existing[row.customer.Email().String()] {
+			report.fail(row.result, fmt.Errorf("customer with email %s already exists", row.customer.Email().String()))
+			continue
+		}
+		accepted = append(accepted, row)
+	}
+
+	if dryRun || len(accepted) == 0 {
+		return nil
+	}
+
+	customers := make([]*models.Customer, 0, len(accepted))
+	records := make([]audit.Record, 0, len(accepted))
+	now := h.clockNow().UTC()
+	for _, row := range accepted {
+		customers = append(customers, row.customer)
+		records = append(records, newAuditRecord(ctx, audit.ActionCustomerRegistered, audit.AggregateCustomer, row.customer.ID().String(), nil, audit.CustomerState(row.customer), now))
+	}
+
+	err = h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.CopyCustomers(ctx, customers); err != nil {
+			return fmt.Errorf("copy customers: %w", err)
+		}
+		if err := h.auditLog.AppendBatch(ctx, records); err != nil {
+			return fmt.Errorf("append audit records: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// Пачка откатывается целиком: строки помечаются ошибкой, импорт продолжается.
+		h.logger.Warn("failed to import batch", zap.Error(err), zap.Int("rows", len(accepted)))
+		for _, row := range accepted {
+			report.fail(row.result, err)
+		}
+		return nil
+	}
+
+	var pending []events.Envelope
+	for _, row := range accepted {
+		pending = append(pending, row.customer.PendingEvents()...)
+		row.customer.MarkEventsCommitted()
+		report.Rows[row.result].CustomerID = row.customer.ID().String()
+		report.Imported++
+	}
+
+	if err := h.indexer.IndexBatch(ctx, customers); err != nil {
+		h.logger.Warn("failed to index imported customers", zap.Error(err), zap.Int("rows", len(customers)))
+	}
+
+	if err := h.events.PublishEvents(ctx, pending); err != nil {
+		return fmt.Errorf("publish event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ImportReport) fail(index int, err error) {
+	r.Rows[index].Error = err.Error()
+	r.Failed++
+}
+
+func parseBirthDate(raw string) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	for _, layout := range birthDateLayouts {
+		if date, err := time.Parse(layout, raw); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: cannot parse %q", valueobjects.ErrInvalidBirthDay, raw)
+}
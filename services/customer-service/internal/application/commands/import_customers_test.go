@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"go.uber.org/zap"
+)
+
+type sliceSource struct{ rows []ImportRow }
+
+func (s *sliceSource) Next(ctx context.Context) (ImportRow, error) {
+	if len(s.rows) == 0 {
+		return ImportRow{}, io.EOF
+	}
+	row := s.rows[0]
+	s.rows = s.rows[1:]
+	return row, nil
+}
+
+type fakeBulkRepo struct {
+	existing map[string]bool
+	batches  [][]*models.Customer
+}
+
+func (f *fakeBulkRepo) ExistingEmails(ctx context.Context, emails []string) (map[string]bool, error) {
+	return f.existing, nil
+}
+
+func (f *fakeBulkRepo) CopyCustomers(ctx context.Context, customers []*models.Customer) error {
+	f.batches = append(f.batches, customers)
+	return nil
+}
+
+type fakeBatchAuditLog struct{ records []audit.Record }
+
+func (f *fakeBatchAuditLog) AppendBatch(ctx context.Context, records []audit.Record) error {
+	f.records = append(f.records, records...)
+	return nil
+}
+
+type fakeBulkIndexer struct{ indexed int }
+
+func (f *fakeBulkIndexer) IndexBatch(ctx context.Context, customers []*models.Customer) error {
+	f.indexed += len(customers)
+	return nil
+}
+
+func importRows() []ImportRow {
+	return []ImportRow{
+		{Line: 2, FullName: "John Doe", Email: "john@example.com", PhoneNumber: "+1234567890", BirthDate: "1990-05-10"},
+		{Line: 3, FullName: "Jane Doe", Email: "jane@example.com", PhoneNumber: "+1234567891", BirthDate: "11.06.1991"},
+		{Line: 4, FullName: "Bad Email", Email: "not-an-email", PhoneNumber: "+1234567892", BirthDate: "1990-05-10"},
+		{Line: 5, FullName: "John Again", Email: "john@example.com", PhoneNumber: "+1234567893", BirthDate: "1990-05-10"},
+		{Line: 6, FullName: "Taken", Email: "taken@example.com", PhoneNumber: "+1234567894", BirthDate: "1990-05-10"},
+		{Line: 7, FullName: "Bad Date", Email: "date@example.com", PhoneNumber: "+1234567895", BirthDate: "yesterday"},
+	}
+}
+
+func TestImportCustomersHandler_Handle(t *testing.T) {
+	repo := &fakeBulkRepo{existing: map[string]bool{"taken@example.com": true}}
+	auditLog := &fakeBatchAuditLog{}
+	indexer := &fakeBulkIndexer{}
+	publisher := &fakePublisher{}
+	handler := NewImportCustomersHandler(repo, &fakeTx{}, auditLog, indexer, publisher, 2, zap.NewNop())
+	handler.WithClock(func() time.Time { return time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC) })
+
+	report, err := handler.Handle(context.Background(), ImportCustomers{Source: &sliceSource{rows: importRows()}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Total != 6 || report.Imported != 2 || report.Failed != 4 {
+		t.Fatalf("unexpected totals: %+v", report)
+	}
+	if len(repo.batches) != 1 || len(repo.batches[0]) != 2 {
+		t.Fatalf("expected one batch of two customers, got %d batches", len(repo.batches))
+	}
+	if len(auditLog.records) != 2 || indexer.indexed != 2 || len(publisher.envelopes) != 2 {
+		t.Fatalf("expected audit, index and one event per imported customer")
+	}
+
+	failures := map[int]string{}
+	for _, row := range report.Rows {
+		if row.Error != "" {
+			failures[row.Line] = row.Error
+		} else if row.CustomerID == "" {
+			t.Fatalf("expected customer id for line %d", row.Line)
+		}
+	}
+	if !strings.Contains(failures[5], "line 2") || !strings.Contains(failures[6], "already exists") {
+		t.Fatalf("unexpected failures: %+v", failures)
+	}
+	if _, ok := failures[4]; !ok {
+		t.Fatalf("expected invalid email to be reported")
+	}
+	if _, ok := failures[7]; !ok {
+		t.Fatalf("expected invalid birth date to be reported")
+	}
+}
+
+func TestImportCustomersHandler_DryRun(t *testing.T) {
+	repo := &fakeBulkRepo{}
+	publisher := &fakePublisher{}
+	handler := NewImportCustomersHandler(repo, &fakeTx{}, &fakeBatchAuditLog{}, &fakeBulkIndexer{}, publisher, 10, zap.NewNop())
+
+	report, err := handler.Handle(context.Background(), ImportCustomers{Source: &sliceSource{rows: importRows()}, DryRun: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !report.DryRun || report.Imported != 0 || report.Failed != 3 {
+		t.Fatalf("unexpected dry-run report: %+v", report)
+	}
+	if len(repo.batches) != 0 || len(publisher.envelopes) != 0 {
+		t.Fatalf("expected dry run to write nothing")
+	}
+}
@@ -0,0 +1,125 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"go.uber.org/zap"
+)
+
+// MergeCustomers описывает слияние дубликата с основным клиентом.
+type MergeCustomers struct {
+	SurvivorID string
+	MergedID   string
+}
+
+// CustomerLoader восстанавливает агрегат без перенаправления слитых идентификаторов.
+type CustomerLoader interface {
+	Load(ctx context.Context, id string) (*models.Customer, error)
+}
+
+// CustomerMergeRepository сохраняет результат слияния.
+type CustomerMergeRepository interface {
+	Update(ctx context.Context, customer *models.Customer) error
+	RedirectMerged(ctx context.Context, fromID, toID string) error
+}
+
+// CustomerSearchRemover удаляет клиента из поискового индекса.
+type CustomerSearchRemover interface {
+	Delete(ctx context.Context, customerID string) error
+}
+
+// MergeEventPublisher публикует событие слияния клиентов.
+type MergeEventPublisher interface {
+	PublishCustomersMerged(ctx context.Context, event events.CustomersMerged) error
+}
+
+// MergeCustomersHandler реализует слияние дубликатов.
+type MergeCustomersHandler struct {
+	loader   CustomerLoader
+	repo     CustomerMergeRepository
+	tx       Transactor
+	auditLog AuditLog
+	search   CustomerSearchRemover
+	events   MergeEventPublisher
+	logger   *zap.Logger
+	clockNow func() time.Time
+}
+
+// NewMergeCustomersHandler создаёт обработчик с зависимостями.
+func NewMergeCustomersHandler(loader CustomerLoader, repo CustomerMergeRepository, tx Transactor, auditLog AuditLog, search CustomerSearchRemover, events MergeEventPublisher, logger *zap.Logger) *MergeCustomersHandler {
+	return &MergeCustomersHandler{
+		loader:   loader,
+		repo:     repo,
+		tx:       tx,
+		auditLog: auditLog,
+		search:   search,
+		events:   events,
+		logger:   logger,
+		clockNow: time.Now,
+	}
+}
+
+// Handle сливает MergedID в SurvivorID и публикует CustomersMerged.
+func (h *MergeCustomersHandler) Handle(ctx context.Context, cmd MergeCustomers) error {
+	err := h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		survivor, err := h.loader.Load(ctx, cmd.SurvivorID)
+		if err != nil {
+			return fmt.Errorf("load survivor: %w", err)
+		}
+
+		merged, err := h.loader.Load(ctx, cmd.MergedID)
+		if err != nil {
+			return fmt.Errorf("load merged customer: %w", err)
+		}
+
+		before := audit.CustomerState(merged)
+		if err := merged.MergeInto(survivor); err != nil {
+			return err
+		}
+
+		if err := h.repo.Update(ctx, merged); err != nil {
+			return fmt.Errorf("update merged customer: %w", err)
+		}
+		if err := h.repo.RedirectMerged(ctx, merged.ID().String(), survivor.ID().String()); err != nil {
+			return err
+		}
+
+		record := newAuditRecord(ctx, audit.ActionCustomersMerged, audit.AggregateCustomer, merged.ID().String(), before, audit.CustomerState(merged), h.clockNow().UTC())
+		if err := h.auditLog.Append(ctx, record); err != nil {
+			return fmt.Errorf("append audit record: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := h.search.Delete(ctx, cmd.MergedID); err != nil {
+		h.logger.Warn("failed to remove merged customer from index", zap.Error(err), zap.String("customer_id", cmd.MergedID))
+	}
+
+	domainEvent := events.CustomersMerged{
+		SurvivorID: cmd.SurvivorID,
+		MergedID:   cmd.MergedID,
+		OccurredAt: h.clockNow().UTC(),
+	}
+
+	if err := h.events.PublishCustomersMerged(ctx, domainEvent); err != nil {
+		return fmt.Errorf("publish event: %w", err)
+	}
+
+	return nil
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (h *MergeCustomersHandler) WithClock(clock func() time.Time) {
+	if clock != nil {
+		h.clockNow = clock
+	}
+}
@@ -0,0 +1,193 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"go.uber.org/zap"
+)
+
+func newMergePair(t *testing.T) (*models.Customer, *models.Customer, *fakeLoader) {
+	t.Helper()
+	survivor, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	duplicate, _ := models.NewCustomer("Jonh Doe", mustEmail("jd@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	survivor.MarkEventsCommitted()
+	duplicate.MarkEventsCommitted()
+
+	loader := &fakeLoader{customers: map[string]*models.Customer{
+		survivor.ID().String():  survivor,
+		duplicate.ID().String(): duplicate,
+	}}
+	return survivor, duplicate, loader
+}
+
+func TestMergeCustomersHandler_Handle(t *testing.T) {
+	survivor, duplicate, loader := newMergePair(t)
+	repo := &fakeMergeRepo{}
+	remover := &fakeRemover{}
+	publisher := &fakePublisher{}
+	auditLog := &fakeAuditLog{}
+	tx := &fakeTx{}
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	handler := NewMergeCustomersHandler(loader, repo, tx, auditLog, remover, publisher, zap.NewNop())
+	handler.WithClock(func() time.Time { return now })
+
+	ctx := audit.WithActor(context.Background(), audit.Actor{ID: "staff-1", Role: "manager"})
+	err := handler.Handle(ctx, MergeCustomers{SurvivorID: survivor.ID().String(), MergedID: duplicate.ID().String()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mergedInto, ok := duplicate.MergedInto(); !ok || mergedInto != survivor.ID() {
+		t.Fatalf("expected duplicate to point to survivor")
+	}
+	if tx.calls != 1 {
+		t.Fatalf("expected merge and audit to run in one transaction, got %d", tx.calls)
+	}
+	if repo.updated != duplicate || repo.redirected != [2]string{duplicate.ID().String(), survivor.ID().String()} {
+		t.Fatalf("expected merged customer to be persisted and redirected")
+	}
+	if remover.deleted != duplicate.ID().String() {
+		t.Fatalf("expected duplicate to be removed from search index")
+	}
+	if publisher.merged.SurvivorID != survivor.ID().String() || publisher.merged.MergedID != duplicate.ID().String() || !publisher.merged.OccurredAt.Equal(now) {
+		t.Fatalf("unexpected merge event: %+v", publisher.merged)
+	}
+
+	if len(auditLog.records) != 1 {
+		t.Fatalf("expected one audit record, got %d", len(auditLog.records))
+	}
+	record := auditLog.records[0]
+	if record.Action != audit.ActionCustomersMerged || record.AggregateID != duplicate.ID().String() || record.Actor.ID != "staff-1" || !record.OccurredAt.Equal(now) {
+		t.Fatalf("unexpected merge audit record: %+v", record)
+	}
+	if change, ok := record.Changes["merged_into"]; !ok || change.Before != nil || change.After != survivor.ID().String() {
+		t.Fatalf("expected merged_into change, got %+v", record.Changes)
+	}
+
+	if err := handler.Handle(ctx, MergeCustomers{SurvivorID: survivor.ID().String(), MergedID: duplicate.ID().String()}); !errors.Is(err, valueobjects.ErrAlreadyMerged) {
+		t.Fatalf("expected already merged error, got %v", err)
+	}
+}
+
+func TestMergeCustomersHandler_Rejected(t *testing.T) {
+	cases := []struct {
+		name    string
+		prepare func(survivor, duplicate *models.Customer) MergeCustomers
+		want    error
+	}{
+		{
+			name: "self merge",
+			prepare: func(survivor, _ *models.Customer) MergeCustomers {
+				return MergeCustomers{SurvivorID: survivor.ID().String(), MergedID: survivor.ID().String()}
+			},
+			want: valueobjects.ErrSelfMerge,
+		},
+		{
+			name: "erased survivor",
+			prepare: func(survivor, duplicate *models.Customer) MergeCustomers {
+				survivor.Erase()
+				survivor.MarkEventsCommitted()
+				return MergeCustomers{SurvivorID: survivor.ID().String(), MergedID: duplicate.ID().String()}
+			},
+			want: valueobjects.ErrCustomerErased,
+		},
+		{
+			name: "erased duplicate",
+			prepare: func(survivor, duplicate *models.Customer) MergeCustomers {
+				duplicate.Erase()
+				duplicate.MarkEventsCommitted()
+				return MergeCustomers{SurvivorID: survivor.ID().String(), MergedID: duplicate.ID().String()}
+			},
+			want: valueobjects.ErrCustomerErased,
+		},
+	}
+
+	for _, tc := range cases {
+		survivor, duplicate, loader := newMergePair(t)
+		repo := &fakeMergeRepo{}
+		remover := &fakeRemover{}
+		publisher := &fakePublisher{}
+		auditLog := &fakeAuditLog{}
+		handler := NewMergeCustomersHandler(loader, repo, &fakeTx{}, auditLog, remover, publisher, zap.NewNop())
+
+		if err := handler.Handle(context.Background(), tc.prepare(survivor, duplicate)); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+		if repo.updated != nil || len(auditLog.records) != 0 || remover.deleted != "" || publisher.merged.MergedID != "" {
+			t.Fatalf("%s: expected rejected merge to have no side effects", tc.name)
+		}
+	}
+}
+
+func TestMergeCustomersHandler_Failures(t *testing.T) {
+	boom := errors.New("boom")
+
+	t.Run("unknown customer", func(t *testing.T) {
+		survivor, _, loader := newMergePair(t)
+		publisher := &fakePublisher{}
+		handler := NewMergeCustomersHandler(loader, &fakeMergeRepo{}, &fakeTx{}, &fakeAuditLog{}, &fakeRemover{}, publisher, zap.NewNop())
+
+		if err := handler.Handle(context.Background(), MergeCustomers{SurvivorID: survivor.ID().String(), MergedID: "missing"}); err == nil {
+			t.Fatalf("expected load error")
+		}
+		if publisher.merged.MergedID != "" {
+			t.Fatalf("expected no event for a failed merge")
+		}
+	})
+
+	t.Run("redirect fails", func(t *testing.T) {
+		survivor, duplicate, loader := newMergePair(t)
+		auditLog := &fakeAuditLog{}
+		publisher := &fakePublisher{}
+		handler := NewMergeCustomersHandler(loader, &fakeMergeRepo{err: boom}, &fakeTx{}, auditLog, &fakeRemover{}, publisher, zap.NewNop())
+
+		if err := handler.Handle(context.Background(), MergeCustomers{SurvivorID: survivor.ID().String(), MergedID: duplicate.ID().String()}); !errors.Is(err, boom) {
+			t.Fatalf("expected redirect error, got %v", err)
+		}
+		if len(auditLog.records) != 0 || publisher.merged.MergedID != "" {
+			t.Fatalf("expected no audit record or event when redirect fails")
+		}
+	})
+
+	t.Run("audit fails", func(t *testing.T) {
+		survivor, duplicate, loader := newMergePair(t)
+		remover := &fakeRemover{}
+		publisher := &fakePublisher{}
+		handler := NewMergeCustomersHandler(loader, &fakeMergeRepo{}, &fakeTx{}, &fakeAuditLog{err: boom}, remover, publisher, zap.NewNop())
+
+		if err := handler.Handle(context.Background(), MergeCustomers{SurvivorID: survivor.ID().String(), MergedID: duplicate.ID().String()}); !errors.Is(err, boom) {
+			t.Fatalf("expected audit error, got %v", err)
+		}
+		if remover.deleted != "" || publisher.merged.MergedID != "" {
+			t.Fatalf("expected no index removal or event when audit fails")
+		}
+	})
+
+	t.Run("index removal fails", func(t *testing.T) {
+		survivor, duplicate, loader := newMergePair(t)
+		publisher := &fakePublisher{}
+		handler := NewMergeCustomersHandler(loader, &fakeMergeRepo{}, &fakeTx{}, &fakeAuditLog{}, &fakeRemover{err: boom}, publisher, zap.NewNop())
+
+		if err := handler.Handle(context.Background(), MergeCustomers{SurvivorID: survivor.ID().String(), MergedID: duplicate.ID().String()}); err != nil {
+			t.Fatalf("expected index failure to be logged only, got %v", err)
+		}
+		if publisher.merged.MergedID != duplicate.ID().String() {
+			t.Fatalf("expected event to be published despite index failure")
+		}
+	})
+
+	t.Run("publish fails", func(t *testing.T) {
+		survivor, duplicate, loader := newMergePair(t)
+		handler := NewMergeCustomersHandler(loader, &fakeMergeRepo{}, &fakeTx{}, &fakeAuditLog{}, &fakeRemover{}, &fakePublisher{err: boom}, zap.NewNop())
+
+		if err := handler.Handle(context.Background(), MergeCustomers{SurvivorID: survivor.ID().String(), MergedID: duplicate.ID().String()}); !errors.Is(err, boom) {
+			t.Fatalf("expected publish error, got %v", err)
+		}
+	})
+}
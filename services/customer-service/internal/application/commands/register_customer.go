@@ -3,8 +3,10 @@ package commands
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
@@ -17,12 +19,15 @@ type RegisterCustomer struct {
 	Email       string
 	PhoneNumber string
 	BirthDate   time.Time
+	// ReferralCode — необязательный код пригласившего клиента.
+	ReferralCode string
 }
 
 // CustomerRepository определяет контракты с инфраструктурой хранения.
 type CustomerRepository interface {
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 	Save(ctx context.Context, customer *models.Customer) error
+	GetByReferralCode(ctx context.Context, code valueobjects.ReferralCode) (*models.Customer, error)
 }
 
 // CustomerSearchIndexer индексирует сущность в поисковом движке.
@@ -33,11 +38,14 @@ type CustomerSearchIndexer interface {
 // DomainEventPublisher публикует доменные события в шину Kafka.
 type DomainEventPublisher interface {
 	PublishCustomerRegistered(ctx context.Context, event events.CustomerRegistered) error
+	PublishCustomerReferred(ctx context.Context, event events.CustomerReferred) error
 }
 
 // RegisterCustomerHandler реализует бизнес-логику регистрации клиента.
 type RegisterCustomerHandler struct {
 	repo     CustomerRepository
+	tx       Transactor
+	auditLog AuditLog
 	indexer  CustomerSearchIndexer
 	events   DomainEventPublisher
 	logger   *zap.Logger
@@ -45,9 +53,11 @@ type RegisterCustomerHandler struct {
 }
 
 // NewRegisterCustomerHandler создаёт обработчик с зависимостями.
-func NewRegisterCustomerHandler(repo CustomerRepository, indexer CustomerSearchIndexer, events DomainEventPublisher, logger *zap.Logger) *RegisterCustomerHandler {
+func NewRegisterCustomerHandler(repo CustomerRepository, tx Transactor, auditLog AuditLog, indexer CustomerSearchIndexer, events DomainEventPublisher, logger *zap.Logger) *RegisterCustomerHandler {
 	return &RegisterCustomerHandler{
 		repo:     repo,
+		tx:       tx,
+		auditLog: auditLog,
 		indexer:  indexer,
 		events:   events,
 		logger:   logger,
@@ -79,30 +89,77 @@ func (h *RegisterCustomerHandler) Handle(ctx context.Context, cmd RegisterCustom
 		return "", fmt.Errorf("customer with email %s already exists", email.String())
 	}
 
+	var referralCode valueobjects.ReferralCode
+	if strings.TrimSpace(cmd.ReferralCode) != "" {
+		referralCode, err = valueobjects.ParseReferralCode(cmd.ReferralCode)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	customer, err := models.NewCustomer(cmd.FullName, email, phone, cmd.BirthDate)
 	if err != nil {
 		return "", err
 	}
 
-	if err := h.repo.Save(ctx, customer); err != nil {
-		return "", fmt.Errorf("save customer: %w", err)
+	var referred *events.CustomerReferred
+	err = h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if !referralCode.IsZero() {
+			referrer, err := h.repo.GetByReferralCode(ctx, referralCode)
+			if err != nil {
+				return fmt.Errorf("resolve referral code: %w", err)
+			}
+			if err := customer.AttributeReferral(referrer); err != nil {
+				return err
+			}
+			referred = &events.CustomerReferred{
+				ReferrerID:   referrer.ID().String(),
+				ReferredID:   customer.ID().String(),
+				ReferralCode: referrer.ReferralCode().String(),
+				OccurredAt:   h.clockNow().UTC(),
+			}
+		}
+
+		if err := h.repo.Save(ctx, customer); err != nil {
+			return fmt.Errorf("save customer: %w", err)
+		}
+
+		record := newAuditRecord(ctx, audit.ActionCustomerRegistered, audit.AggregateCustomer, customer.ID().String(), nil, audit.CustomerState(customer), h.clockNow().UTC())
+		if err := h.auditLog.Append(ctx, record); err != nil {
+			return fmt.Errorf("append audit record: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
 	}
+	customer.MarkEventsCommitted()
 
 	if err := h.indexer.Index(ctx, customer); err != nil {
 		h.logger.Warn("failed to index customer", zap.Error(err), zap.String("customer_id", customer.ID().String()))
 	}
 
 	domainEvent := events.CustomerRegistered{
-		CustomerID: customer.ID().String(),
-		Email:      customer.Email().String(),
-		FullName:   customer.FullName(),
-		OccurredAt: h.clockNow().UTC(),
+		CustomerID:   customer.ID().String(),
+		Email:        customer.Email().String(),
+		FullName:     customer.FullName(),
+		PhoneNumber:  customer.PhoneNumber().String(),
+		BirthDate:    customer.BirthDate(),
+		ReferralCode: customer.ReferralCode().String(),
+		OccurredAt:   h.clockNow().UTC(),
 	}
 
 	if err := h.events.PublishCustomerRegistered(ctx, domainEvent); err != nil {
 		return "", fmt.Errorf("publish event: %w", err)
 	}
 
+	if referred != nil {
+		if err := h.events.PublishCustomerReferred(ctx, *referred); err != nil {
+			return "", fmt.Errorf("publish event: %w", err)
+		}
+	}
+
 	return customer.ID().String(), nil
 }
 
@@ -3,10 +3,12 @@ package commands
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	appqueries "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/application/queries"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
@@ -15,9 +17,10 @@ import (
 )
 
 type fakeRepo struct {
-	exists bool
-	saved  *models.Customer
-	err    error
+	exists   bool
+	saved    *models.Customer
+	referrer *models.Customer
+	err      error
 }
 
 func (f *fakeRepo) ExistsByEmail(ctx context.Context, email string) (bool, error) {
@@ -29,10 +32,39 @@ func (f *fakeRepo) Save(ctx context.Context, customer *models.Customer) error {
 	return f.err
 }
 
+func (f *fakeRepo) GetByReferralCode(ctx context.Context, code valueobjects.ReferralCode) (*models.Customer, error) {
+	if f.referrer == nil || f.referrer.ReferralCode() != code {
+		return nil, valueobjects.ErrUnknownReferralCode
+	}
+	return f.referrer, nil
+}
+
 func (f *fakeRepo) GetByID(ctx context.Context, id string) (*models.Customer, error) {
 	return f.saved, f.err
 }
 
+func (f *fakeRepo) Update(ctx context.Context, customer *models.Customer) error {
+	f.saved = customer
+	return f.err
+}
+
+type fakeTx struct{ calls int }
+
+func (f *fakeTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	f.calls++
+	return fn(ctx)
+}
+
+type fakeAuditLog struct {
+	records []audit.Record
+	err     error
+}
+
+func (f *fakeAuditLog) Append(ctx context.Context, record audit.Record) error {
+	f.records = append(f.records, record)
+	return f.err
+}
+
 type fakeIndexer struct{ err error }
 
 func (f *fakeIndexer) Index(ctx context.Context, customer *models.Customer) error {
@@ -40,8 +72,11 @@ func (f *fakeIndexer) Index(ctx context.Context, customer *models.Customer) erro
 }
 
 type fakePublisher struct {
-	event events.CustomerRegistered
-	err   error
+	event     events.CustomerRegistered
+	merged    events.CustomersMerged
+	referred  events.CustomerReferred
+	envelopes []events.Envelope
+	err       error
 }
 
 func (f *fakePublisher) PublishCustomerRegistered(ctx context.Context, event events.CustomerRegistered) error {
@@ -49,11 +84,62 @@ func (f *fakePublisher) PublishCustomerRegistered(ctx context.Context, event eve
 	return f.err
 }
 
+func (f *fakePublisher) PublishCustomerReferred(ctx context.Context, event events.CustomerReferred) error {
+	f.referred = event
+	return f.err
+}
+
+func (f *fakePublisher) PublishCustomersMerged(ctx context.Context, event events.CustomersMerged) error {
+	f.merged = event
+	return f.err
+}
+
+func (f *fakePublisher) PublishEvents(ctx context.Context, envelopes []events.Envelope) error {
+	f.envelopes = append(f.envelopes, envelopes...)
+	return f.err
+}
+
+type fakeLoader struct{ customers map[string]*models.Customer }
+
+func (f *fakeLoader) Load(ctx context.Context, id string) (*models.Customer, error) {
+	customer, ok := f.customers[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return customer, nil
+}
+
+type fakeMergeRepo struct {
+	updated    *models.Customer
+	redirected [2]string
+	err        error
+}
+
+func (f *fakeMergeRepo) Update(ctx context.Context, customer *models.Customer) error {
+	f.updated = customer
+	return nil
+}
+
+func (f *fakeMergeRepo) RedirectMerged(ctx context.Context, fromID, toID string) error {
+	f.redirected = [2]string{fromID, toID}
+	return f.err
+}
+
+type fakeRemover struct {
+	deleted string
+	err     error
+}
+
+func (f *fakeRemover) Delete(ctx context.Context, customerID string) error {
+	f.deleted = customerID
+	return f.err
+}
+
 func TestRegisterCustomerHandler_Handle(t *testing.T) {
 	repo := &fakeRepo{}
 	indexer := &fakeIndexer{}
 	publisher := &fakePublisher{}
-	handler := NewRegisterCustomerHandler(repo, indexer, publisher, zap.NewNop())
+	handler := NewRegisterCustomerHandler(repo, &fakeTx{}, &fakeAuditLog{}, indexer, publisher, zap.NewNop())
 
 	fixedTime := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
 	handler.WithClock(func() time.Time { return fixedTime })
@@ -80,9 +166,70 @@ func TestRegisterCustomerHandler_Handle(t *testing.T) {
 	}
 }
 
+func TestRegisterCustomerHandler_Referral(t *testing.T) {
+	referrer, _ := models.NewCustomer("Jane Roe", mustEmail("jane@example.com"), mustPhone("+1987654321"), time.Date(1985, 3, 1, 0, 0, 0, 0, time.UTC))
+	repo := &fakeRepo{referrer: referrer}
+	publisher := &fakePublisher{}
+	handler := NewRegisterCustomerHandler(repo, &fakeTx{}, &fakeAuditLog{}, &fakeIndexer{}, publisher, zap.NewNop())
+
+	code := referrer.ReferralCode().String()
+	id, err := handler.Handle(context.Background(), RegisterCustomer{
+		FullName:     "John Doe",
+		Email:        "john@example.com",
+		PhoneNumber:  "+1234567890",
+		BirthDate:    time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC),
+		ReferralCode: strings.ToLower(code[:4] + "-" + code[4:]),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	referredBy, ok := repo.saved.ReferredBy()
+	if !ok || referredBy != referrer.ID() {
+		t.Fatalf("expected referral to be attributed to %s, got %s", referrer.ID(), referredBy)
+	}
+	if publisher.referred.ReferrerID != referrer.ID().String() || publisher.referred.ReferredID != id {
+		t.Fatalf("unexpected referral event: %+v", publisher.referred)
+	}
+	if publisher.event.ReferralCode != repo.saved.ReferralCode().String() {
+		t.Fatalf("expected registered event to carry own referral code")
+	}
+}
+
+func TestRegisterCustomerHandler_ReferralRejected(t *testing.T) {
+	referrer, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1987654321"), time.Date(1985, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	cases := map[string]struct {
+		code string
+		want error
+	}{
+		"unknown": {code: "ZZZZZZZZ", want: valueobjects.ErrUnknownReferralCode},
+		"invalid": {code: "not-a-code!", want: valueobjects.ErrInvalidReferralCode},
+		"self":    {code: referrer.ReferralCode().String(), want: valueobjects.ErrSelfReferral},
+	}
+
+	for name, tc := range cases {
+		publisher := &fakePublisher{}
+		handler := NewRegisterCustomerHandler(&fakeRepo{referrer: referrer}, &fakeTx{}, &fakeAuditLog{}, &fakeIndexer{}, publisher, zap.NewNop())
+		_, err := handler.Handle(context.Background(), RegisterCustomer{
+			FullName:     "John Doe",
+			Email:        "john@example.com",
+			PhoneNumber:  "+1234567890",
+			BirthDate:    time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC),
+			ReferralCode: tc.code,
+		})
+		if !errors.Is(err, tc.want) {
+			t.Fatalf("%s: expected %v, got %v", name, tc.want, err)
+		}
+		if publisher.event.CustomerID != "" {
+			t.Fatalf("%s: expected no event to be published", name)
+		}
+	}
+}
+
 func TestRegisterCustomerHandler_Duplicate(t *testing.T) {
 	repo := &fakeRepo{exists: true}
-	handler := NewRegisterCustomerHandler(repo, &fakeIndexer{}, &fakePublisher{}, zap.NewNop())
+	handler := NewRegisterCustomerHandler(repo, &fakeTx{}, &fakeAuditLog{}, &fakeIndexer{}, &fakePublisher{}, zap.NewNop())
 	_, err := handler.Handle(context.Background(), RegisterCustomer{
 		FullName:    "John Doe",
 		Email:       "john@example.com",
@@ -95,7 +242,7 @@ func TestRegisterCustomerHandler_Duplicate(t *testing.T) {
 }
 
 func TestRegisterCustomerHandler_InvalidEmail(t *testing.T) {
-	handler := NewRegisterCustomerHandler(&fakeRepo{}, &fakeIndexer{}, &fakePublisher{}, zap.NewNop())
+	handler := NewRegisterCustomerHandler(&fakeRepo{}, &fakeTx{}, &fakeAuditLog{}, &fakeIndexer{}, &fakePublisher{}, zap.NewNop())
 	_, err := handler.Handle(context.Background(), RegisterCustomer{Email: "broken"})
 	if err == nil {
 		t.Fatalf("expected validation error")
@@ -104,7 +251,7 @@ func TestRegisterCustomerHandler_InvalidEmail(t *testing.T) {
 
 func TestRegisterCustomerHandler_RepositoryError(t *testing.T) {
 	repo := &fakeRepo{err: errors.New("db down")}
-	handler := NewRegisterCustomerHandler(repo, &fakeIndexer{}, &fakePublisher{}, zap.NewNop())
+	handler := NewRegisterCustomerHandler(repo, &fakeTx{}, &fakeAuditLog{}, &fakeIndexer{}, &fakePublisher{}, zap.NewNop())
 	_, err := handler.Handle(context.Background(), RegisterCustomer{
 		FullName:    "John Doe",
 		Email:       "john@example.com",
@@ -116,6 +263,169 @@ func TestRegisterCustomerHandler_RepositoryError(t *testing.T) {
 	}
 }
 
+func TestRegisterCustomerHandler_AuditRecord(t *testing.T) {
+	tx := &fakeTx{}
+	auditLog := &fakeAuditLog{}
+	handler := NewRegisterCustomerHandler(&fakeRepo{}, tx, auditLog, &fakeIndexer{}, &fakePublisher{}, zap.NewNop())
+
+	ctx := audit.WithActor(context.Background(), audit.Actor{ID: "staff-1", Role: "front_desk"})
+	ctx = audit.WithRequestID(ctx, "req-1")
+
+	id, err := handler.Handle(ctx, RegisterCustomer{
+		FullName:    "John Doe",
+		Email:       "john@example.com",
+		PhoneNumber: "+1234567890",
+		BirthDate:   time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tx.calls != 1 {
+		t.Fatalf("expected save and audit to run in one transaction, got %d", tx.calls)
+	}
+	if len(auditLog.records) != 1 {
+		t.Fatalf("expected one audit record, got %d", len(auditLog.records))
+	}
+
+	record := auditLog.records[0]
+	if record.Actor.ID != "staff-1" || record.RequestID != "req-1" {
+		t.Fatalf("unexpected actor or request id: %+v", record)
+	}
+	if record.Action != audit.ActionCustomerRegistered || record.AggregateID != id {
+		t.Fatalf("unexpected action or aggregate: %+v", record)
+	}
+	if change, ok := record.Changes["email"]; !ok || change.Before != nil || change.After != audit.Redacted {
+		t.Fatalf("expected redacted email change to be recorded, got %+v", record.Changes)
+	}
+	for field, change := range record.Changes {
+		for _, value := range []interface{}{change.Before, change.After} {
+			if value == "john@example.com" || value == "John Doe" || value == "+1234567890" || value == "1990-05-10" {
+				t.Fatalf("expected no personal data in the audit log, got %s=%v", field, value)
+			}
+		}
+	}
+}
+
+func TestRegisterCustomerHandler_AuditFailure(t *testing.T) {
+	publisher := &fakePublisher{}
+	handler := NewRegisterCustomerHandler(&fakeRepo{}, &fakeTx{}, &fakeAuditLog{err: errors.New("audit down")}, &fakeIndexer{}, publisher, zap.NewNop())
+
+	_, err := handler.Handle(context.Background(), RegisterCustomer{
+		FullName:    "John Doe",
+		Email:       "john@example.com",
+		PhoneNumber: "+1234567890",
+		BirthDate:   time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC),
+	})
+	if err == nil {
+		t.Fatalf("expected audit error")
+	}
+	if publisher.event.CustomerID != "" {
+		t.Fatalf("expected no event to be published when audit fails")
+	}
+}
+
+func TestUpdateCustomerHandler_Handle(t *testing.T) {
+	customer, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	customer.MarkEventsCommitted()
+
+	repo := &fakeRepo{saved: customer}
+	auditLog := &fakeAuditLog{}
+	handler := NewUpdateCustomerHandler(repo, &fakeTx{}, auditLog, &fakeIndexer{}, zap.NewNop())
+
+	phone := "+1987654321"
+	version, err := handler.Handle(context.Background(), UpdateCustomer{CustomerID: customer.ID().String(), PhoneNumber: &phone})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != 2 {
+		t.Fatalf("expected version 2, got %d", version)
+	}
+	if len(auditLog.records) != 1 {
+		t.Fatalf("expected one audit record, got %d", len(auditLog.records))
+	}
+
+	changes := auditLog.records[0].Changes
+	if len(changes) != 2 || changes["phone_number"].Before != audit.Redacted || changes["phone_number"].After != audit.Redacted {
+		t.Fatalf("expected phone and version changes, got %+v", changes)
+	}
+}
+
+func TestUpdateCustomerHandler_NoChanges(t *testing.T) {
+	customer, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	customer.MarkEventsCommitted()
+
+	auditLog := &fakeAuditLog{}
+	handler := NewUpdateCustomerHandler(&fakeRepo{saved: customer}, &fakeTx{}, auditLog, &fakeIndexer{}, zap.NewNop())
+
+	name := "John Doe"
+	if _, err := handler.Handle(context.Background(), UpdateCustomer{CustomerID: customer.ID().String(), FullName: &name}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(auditLog.records) != 0 {
+		t.Fatalf("expected no audit record for no-op update")
+	}
+}
+
+func TestContactSettingsHandler_UpdateConsent(t *testing.T) {
+	customer, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	customer.MarkEventsCommitted()
+
+	auditLog := &fakeAuditLog{}
+	publisher := &fakePublisher{}
+	handler := NewContactSettingsHandler(&fakeRepo{saved: customer}, &fakeTx{}, auditLog, publisher, zap.NewNop())
+
+	err := handler.UpdateConsent(context.Background(), UpdateConsent{
+		CustomerID:       customer.ID().String(),
+		Channel:          "sms",
+		Granted:          true,
+		Source:           "web-form",
+		LegalTextVersion: "2024-01",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !customer.IsContactable(valueobjects.ChannelSMS) || customer.IsContactable(valueobjects.ChannelEmail) {
+		t.Fatalf("expected customer to be contactable only by sms")
+	}
+	if len(publisher.envelopes) != 1 || publisher.envelopes[0].Type != events.TypeConsentChanged {
+		t.Fatalf("expected ConsentChanged to be published, got %+v", publisher.envelopes)
+	}
+	if len(auditLog.records) != 1 || auditLog.records[0].Action != audit.ActionConsentChanged {
+		t.Fatalf("expected consent audit record")
+	}
+
+	err = handler.UpdateConsent(context.Background(), UpdateConsent{CustomerID: customer.ID().String(), Channel: "email", Granted: true, Source: "web-form"})
+	if !errors.Is(err, valueobjects.ErrLegalTextMissing) {
+		t.Fatalf("expected legal text error, got %v", err)
+	}
+}
+
+func TestContactSettingsHandler_UpdatePreferences(t *testing.T) {
+	customer, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	customer.MarkEventsCommitted()
+
+	publisher := &fakePublisher{}
+	handler := NewContactSettingsHandler(&fakeRepo{saved: customer}, &fakeTx{}, &fakeAuditLog{}, publisher, zap.NewNop())
+
+	cmd := UpdatePreferences{CustomerID: customer.ID().String(), Language: "ru", Timezone: "Europe/Moscow", PreferredChannel: "email"}
+	if err := handler.UpdatePreferences(context.Background(), cmd); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := handler.UpdatePreferences(context.Background(), cmd); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(publisher.envelopes) != 1 {
+		t.Fatalf("expected repeated preferences to be a no-op, got %d events", len(publisher.envelopes))
+	}
+
+	cmd.Timezone = "Mars/Olympus"
+	if err := handler.UpdatePreferences(context.Background(), cmd); !errors.Is(err, valueobjects.ErrInvalidTimezone) {
+		t.Fatalf("expected timezone error, got %v", err)
+	}
+}
+
 func TestGetCustomerHandler_Handle(t *testing.T) {
 	repo := &fakeRepo{}
 	handler := appqueries.NewGetCustomerHandler(repo)
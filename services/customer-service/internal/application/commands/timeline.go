@@ -0,0 +1,186 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"go.uber.org/zap"
+)
+
+// AddTimelineEntry описывает добавление записи сотрудника в ленту клиента.
+type AddTimelineEntry struct {
+	CustomerID string
+	Type       string
+	Body       string
+	Visibility string
+	Pinned     bool
+}
+
+// EditTimelineEntry описывает изменение записи; nil-поля не изменяются.
+type EditTimelineEntry struct {
+	EntryID    string
+	Body       *string
+	Visibility *string
+	Pinned     *bool
+}
+
+// DeleteTimelineEntry описывает удаление записи.
+type DeleteTimelineEntry struct {
+	EntryID string
+}
+
+// RecordSystemEvent описывает системную запись о доменном событии клиента.
+type RecordSystemEvent struct {
+	CustomerID string
+	Summary    string
+	SourceID   string
+	OccurredAt time.Time
+}
+
+// TimelineRepository определяет операции хранилища ленты.
+type TimelineRepository interface {
+	Add(ctx context.Context, entry *models.TimelineEntry) error
+	Update(ctx context.Context, entry *models.TimelineEntry) error
+	GetByID(ctx context.Context, id string) (*models.TimelineEntry, error)
+}
+
+// TimelineHandler реализует команды ленты взаимодействий с клиентом.
+type TimelineHandler struct {
+	customers CustomerReader
+	timeline  TimelineRepository
+	tx        Transactor
+	auditLog  AuditLog
+	policy    models.TimelinePolicy
+	logger    *zap.Logger
+	clockNow  func() time.Time
+}
+
+// NewTimelineHandler создаёт обработчик с зависимостями.
+func NewTimelineHandler(customers CustomerReader, timeline TimelineRepository, tx Transactor, auditLog AuditLog, policy models.TimelinePolicy, logger *zap.Logger) *TimelineHandler {
+	return &TimelineHandler{
+		customers: customers,
+		timeline:  timeline,
+		tx:        tx,
+		auditLog:  auditLog,
+		policy:    policy,
+		logger:    logger,
+		clockNow:  time.Now,
+	}
+}
+
+// AddEntry добавляет запись от имени инициатора запроса и возвращает её идентификатор.
+func (h *TimelineHandler) AddEntry(ctx context.Context, cmd AddTimelineEntry) (string, error) {
+	entryType, err := valueobjects.ParseEntryType(cmd.Type)
+	if err != nil {
+		return "", err
+	}
+
+	visibility, err := valueobjects.ParseVisibility(cmd.Visibility)
+	if err != nil {
+		return "", err
+	}
+
+	customer, err := h.customers.GetByID(ctx, cmd.CustomerID)
+	if err != nil {
+		return "", fmt.Errorf("get customer by id: %w", err)
+	}
+
+	now := h.clockNow().UTC()
+	entry, err := models.NewTimelineEntry(customer.ID(), entryType, cmd.Body, visibility, cmd.Pinned, audit.TimelineViewer(ctx), h.policy, now)
+	if err != nil {
+		return "", err
+	}
+
+	err = h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.timeline.Add(ctx, entry); err != nil {
+			return fmt.Errorf("add timeline entry: %w", err)
+		}
+
+		record := newAuditRecord(ctx, audit.ActionTimelineEntryAdded, audit.AggregateTimeline, entry.ID().String(), nil, audit.TimelineEntryState(entry), now)
+		if err := h.auditLog.Append(ctx, record); err != nil {
+			return fmt.Errorf("append audit record: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return entry.ID().String(), nil
+}
+
+// EditEntry изменяет текст, видимость или закрепление записи.
+func (h *TimelineHandler) EditEntry(ctx context.Context, cmd EditTimelineEntry) error {
+	edit := models.TimelineEdit{Body: cmd.Body, Pinned: cmd.Pinned}
+	if cmd.Visibility != nil {
+		visibility, err := valueobjects.ParseVisibility(*cmd.Visibility)
+		if err != nil {
+			return err
+		}
+		edit.Visibility = &visibility
+	}
+
+	return h.mutate(ctx, cmd.EntryID, audit.ActionTimelineEntryEdited, func(entry *models.TimelineEntry, now time.Time) error {
+		return entry.Edit(audit.TimelineViewer(ctx), h.policy, edit, now)
+	})
+}
+
+// DeleteEntry удаляет запись из ленты; в хранилище она остаётся помеченной удалённой.
+func (h *TimelineHandler) DeleteEntry(ctx context.Context, cmd DeleteTimelineEntry) error {
+	return h.mutate(ctx, cmd.EntryID, audit.ActionTimelineEntryDeleted, func(entry *models.TimelineEntry, now time.Time) error {
+		return entry.Delete(audit.TimelineViewer(ctx), h.policy, now)
+	})
+}
+
+// RecordSystemEvent добавляет системную запись; повтор того же события не создаёт дубликат.
+func (h *TimelineHandler) RecordSystemEvent(ctx context.Context, cmd RecordSystemEvent) error {
+	customer, err := h.customers.GetByID(ctx, cmd.CustomerID)
+	if err != nil {
+		return fmt.Errorf("get customer by id: %w", err)
+	}
+
+	entry := models.NewSystemTimelineEntry(customer.ID(), cmd.Summary, cmd.SourceID, cmd.OccurredAt.UTC())
+	if err := h.timeline.Add(ctx, entry); err != nil {
+		return fmt.Errorf("add timeline entry: %w", err)
+	}
+
+	return nil
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (h *TimelineHandler) WithClock(clock func() time.Time) {
+	if clock != nil {
+		h.clockNow = clock
+	}
+}
+
+func (h *TimelineHandler) mutate(ctx context.Context, entryID string, action audit.Action, change func(entry *models.TimelineEntry, now time.Time) error) error {
+	return h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		entry, err := h.timeline.GetByID(ctx, entryID)
+		if err != nil {
+			return err
+		}
+
+		before := audit.TimelineEntryState(entry)
+		now := h.clockNow().UTC()
+		if err := change(entry, now); err != nil {
+			return err
+		}
+
+		if err := h.timeline.Update(ctx, entry); err != nil {
+			return fmt.Errorf("update timeline entry: %w", err)
+		}
+
+		record := newAuditRecord(ctx, action, audit.AggregateTimeline, entry.ID().String(), before, audit.TimelineEntryState(entry), now)
+		if err := h.auditLog.Append(ctx, record); err != nil {
+			return fmt.Errorf("append audit record: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"go.uber.org/zap"
+)
+
+type fakeTimelineRepo struct {
+	entries map[string]*models.TimelineEntry
+}
+
+func (f *fakeTimelineRepo) Add(ctx context.Context, entry *models.TimelineEntry) error {
+	if f.entries == nil {
+		f.entries = make(map[string]*models.TimelineEntry)
+	}
+	f.entries[entry.ID().String()] = entry
+	return nil
+}
+
+func (f *fakeTimelineRepo) Update(ctx context.Context, entry *models.TimelineEntry) error {
+	f.entries[entry.ID().String()] = entry
+	return nil
+}
+
+func (f *fakeTimelineRepo) GetByID(ctx context.Context, id string) (*models.TimelineEntry, error) {
+	entry, ok := f.entries[id]
+	if !ok {
+		return nil, valueobjects.ErrTimelineEntryNotFound
+	}
+	return entry, nil
+}
+
+func TestTimelineHandler_AddAndEdit(t *testing.T) {
+	customer, _ := models.NewCustomer("John Doe", mustEmail("john@example.com"), mustPhone("+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	timeline := &fakeTimelineRepo{}
+	auditLog := &fakeAuditLog{}
+	policy := models.TimelinePolicy{
+		RestrictedTypes: map[valueobjects.EntryType][]string{valueobjects.EntryTypeInjury: {"trainer"}},
+		ManagerRoles:    []string{"manager"},
+	}
+	handler := NewTimelineHandler(&fakeRepo{saved: customer}, timeline, &fakeTx{}, auditLog, policy, zap.NewNop())
+
+	desk := audit.WithActor(context.Background(), audit.Actor{ID: "desk-1", Role: "front_desk"})
+	if _, err := handler.AddEntry(desk, AddTimelineEntry{CustomerID: customer.ID().String(), Type: "injury", Body: "knee"}); !errors.Is(err, valueobjects.ErrTimelineAccessDenied) {
+		t.Fatalf("expected injury note to be denied for front desk, got %v", err)
+	}
+
+	id, err := handler.AddEntry(desk, AddTimelineEntry{CustomerID: customer.ID().String(), Type: "complaint", Body: "cold showers", Visibility: "managers"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	manager := audit.WithActor(context.Background(), audit.Actor{ID: "boss", Role: "manager"})
+	pinned := true
+	if err := handler.EditEntry(manager, EditTimelineEntry{EntryID: id, Pinned: &pinned}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !timeline.entries[id].Pinned() {
+		t.Fatalf("expected entry to be pinned")
+	}
+
+	if len(auditLog.records) != 2 {
+		t.Fatalf("expected 2 audit records, got %d", len(auditLog.records))
+	}
+	edited := auditLog.records[1]
+	if edited.Action != audit.ActionTimelineEntryEdited || edited.Actor.ID != "boss" {
+		t.Fatalf("unexpected audit record: %+v", edited)
+	}
+	if change, ok := edited.Changes["pinned"]; !ok || change.After != true {
+		t.Fatalf("expected pinned change in audit, got %+v", edited.Changes)
+	}
+	added := auditLog.records[0]
+	if _, ok := added.Changes["body"]; ok {
+		t.Fatalf("expected note text to stay out of the audit log, got %+v", added.Changes)
+	}
+	if change, ok := added.Changes["body_length"]; !ok || change.After != len("cold showers") {
+		t.Fatalf("expected body length in audit, got %+v", added.Changes)
+	}
+}
@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"go.uber.org/zap"
+)
+
+// UpdateCustomer описывает команду изменения профиля клиента.
+// Поля со значением nil не изменяются.
+type UpdateCustomer struct {
+	CustomerID  string
+	FullName    *string
+	Email       *string
+	PhoneNumber *string
+}
+
+// CustomerUpdater определяет операции хранилища для изменения клиента.
+type CustomerUpdater interface {
+	GetByID(ctx context.Context, id string) (*models.Customer, error)
+	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	Update(ctx context.Context, customer *models.Customer) error
+}
+
+// UpdateCustomerHandler реализует изменение профиля клиента.
+type UpdateCustomerHandler struct {
+	repo     CustomerUpdater
+	tx       Transactor
+	auditLog AuditLog
+	indexer  CustomerSearchIndexer
+	logger   *zap.Logger
+	clockNow func() time.Time
+}
+
+// NewUpdateCustomerHandler создаёт обработчик с зависимостями.
+func NewUpdateCustomerHandler(repo CustomerUpdater, tx Transactor, auditLog AuditLog, indexer CustomerSearchIndexer, logger *zap.Logger) *UpdateCustomerHandler {
+	return &UpdateCustomerHandler{
+		repo:     repo,
+		tx:       tx,
+		auditLog: auditLog,
+		indexer:  indexer,
+		logger:   logger,
+		clockNow: time.Now,
+	}
+}
+
+// Handle применяет изменения и возвращает новую версию агрегата.
+func (h *UpdateCustomerHandler) Handle(ctx context.Context, cmd UpdateCustomer) (int, error) {
+	var customer *models.Customer
+
+	err := h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		customer, err = h.repo.GetByID(ctx, cmd.CustomerID)
+		if err != nil {
+			return err
+		}
+
+		before := audit.CustomerState(customer)
+
+		if err := h.apply(ctx, customer, cmd); err != nil {
+			return err
+		}
+		if len(customer.PendingEvents()) == 0 {
+			return nil
+		}
+
+		if err := h.repo.Update(ctx, customer); err != nil {
+			return fmt.Errorf("update customer: %w", err)
+		}
+
+		record := newAuditRecord(ctx, audit.ActionCustomerUpdated, audit.AggregateCustomer, customer.ID().String(), before, audit.CustomerState(customer), h.clockNow().UTC())
+		if err := h.auditLog.Append(ctx, record); err != nil {
+			return fmt.Errorf("append audit record: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(customer.PendingEvents()) > 0 {
+		customer.MarkEventsCommitted()
+		if err := h.indexer.Index(ctx, customer); err != nil {
+			h.logger.Warn("failed to index customer", zap.Error(err), zap.String("customer_id", customer.ID().String()))
+		}
+	}
+
+	return customer.Version(), nil
+}
+
+func (h *UpdateCustomerHandler) apply(ctx context.Context, customer *models.Customer, cmd UpdateCustomer) error {
+	if cmd.FullName != nil {
+		if *cmd.FullName == "" {
+			return valueobjects.ErrEmptyFullName
+		}
+		customer.UpdateFullName(*cmd.FullName)
+	}
+
+	if cmd.PhoneNumber != nil {
+		phone, err := valueobjects.NewPhoneNumber(*cmd.PhoneNumber)
+		if err != nil {
+			return err
+		}
+		customer.UpdatePhoneNumber(phone)
+	}
+
+	if cmd.Email != nil {
+		email, err := valueobjects.NewEmail(*cmd.Email)
+		if err != nil {
+			return err
+		}
+		if email != customer.Email() {
+			exists, err := h.repo.ExistsByEmail(ctx, email.String())
+			if err != nil {
+				return fmt.Errorf("check email existence: %w", err)
+			}
+			if exists {
+				return fmt.Errorf("customer with email %s already exists", email.String())
+			}
+		}
+		customer.UpdateEmail(email)
+	}
+
+	return nil
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (h *UpdateCustomerHandler) WithClock(clock func() time.Time) {
+	if clock != nil {
+		h.clockNow = clock
+	}
+}
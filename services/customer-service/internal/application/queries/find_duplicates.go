@@ -0,0 +1,77 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/dedup"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+)
+
+// FindDuplicates описывает профиль, для которого ищутся возможные дубликаты.
+type FindDuplicates struct {
+	ExcludeID   string
+	FullName    string
+	PhoneNumber string
+	BirthDate   time.Time
+}
+
+// DuplicateCandidateDTO описывает возможный дубликат клиента.
+type DuplicateCandidateDTO struct {
+	CustomerID string
+	FullName   string
+	Email      string
+	Score      float64
+	Reasons    []string
+}
+
+// DuplicateCandidateSource выбирает из поискового индекса клиентов-кандидатов.
+type DuplicateCandidateSource interface {
+	FindCandidates(ctx context.Context, probe dedup.Profile, size int) ([]dedup.Profile, error)
+}
+
+// FindDuplicatesHandler ищет и ранжирует возможные дубликаты клиента.
+type FindDuplicatesHandler struct {
+	source   DuplicateCandidateSource
+	minScore float64
+	limit    int
+}
+
+// NewFindDuplicatesHandler создаёт обработчик с порогом балла и лимитом кандидатов.
+func NewFindDuplicatesHandler(source DuplicateCandidateSource, minScore float64, limit int) *FindDuplicatesHandler {
+	return &FindDuplicatesHandler{source: source, minScore: minScore, limit: limit}
+}
+
+// Handle возвращает кандидатов по убыванию балла совпадения.
+func (h *FindDuplicatesHandler) Handle(ctx context.Context, query FindDuplicates) ([]DuplicateCandidateDTO, error) {
+	probe := dedup.Profile{
+		CustomerID: query.ExcludeID,
+		FullName:   query.FullName,
+		BirthDate:  query.BirthDate,
+	}
+	if phone, err := valueobjects.NewPhoneNumber(query.PhoneNumber); err == nil {
+		probe.PhoneNormalized = phone.Normalized()
+	}
+
+	// Берём из индекса с запасом: часть совпадений отсеется по порогу.
+	profiles, err := h.source.FindCandidates(ctx, probe, h.limit*4+1)
+	if err != nil {
+		return nil, fmt.Errorf("find duplicate candidates: %w", err)
+	}
+
+	ranked := dedup.Rank(probe, profiles, h.minScore, h.limit)
+
+	candidates := make([]DuplicateCandidateDTO, 0, len(ranked))
+	for _, candidate := range ranked {
+		candidates = append(candidates, DuplicateCandidateDTO{
+			CustomerID: candidate.CustomerID,
+			FullName:   candidate.FullName,
+			Email:      candidate.Email,
+			Score:      candidate.Score,
+			Reasons:    candidate.Reasons,
+		})
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,132 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+// GetAttachmentURL описывает запрос ссылки на скачивание вложения.
+type GetAttachmentURL struct {
+	CustomerID   string
+	AttachmentID string
+}
+
+// AttachmentDTO описывает метаданные вложения.
+type AttachmentDTO struct {
+	ID          string
+	CustomerID  string
+	Kind        string
+	FileName    string
+	ContentType string
+	Size        int64
+	Checksum    string
+	UploadedBy  string
+	CreatedAt   time.Time
+}
+
+// AttachmentURLDTO содержит временную ссылку на скачивание.
+type AttachmentURLDTO struct {
+	URL       string
+	ExpiresAt time.Time
+}
+
+// AttachmentReadModel описывает чтение метаданных вложений.
+type AttachmentReadModel interface {
+	GetAttachment(ctx context.Context, id string) (*models.Attachment, error)
+	ListAttachments(ctx context.Context, customerID uuid.UUID) ([]*models.Attachment, error)
+}
+
+// URLSigner выдаёт временные ссылки на объекты blob-хранилища.
+type URLSigner interface {
+	PresignGet(ctx context.Context, key, fileName string, ttl time.Duration) (string, error)
+}
+
+// GetAttachmentsHandler возвращает вложения клиента и ссылки на их скачивание.
+type GetAttachmentsHandler struct {
+	customers   CustomerReadModel
+	attachments AttachmentReadModel
+	signer      URLSigner
+	ttl         time.Duration
+	clockNow    func() time.Time
+}
+
+// NewGetAttachmentsHandler создаёт обработчик; ttl — срок действия ссылок.
+func NewGetAttachmentsHandler(customers CustomerReadModel, attachments AttachmentReadModel, signer URLSigner, ttl time.Duration) *GetAttachmentsHandler {
+	return &GetAttachmentsHandler{customers: customers, attachments: attachments, signer: signer, ttl: ttl, clockNow: time.Now}
+}
+
+// List возвращает вложения клиента от новых к старым.
+func (h *GetAttachmentsHandler) List(ctx context.Context, customerID string) ([]AttachmentDTO, error) {
+	customer, err := h.customers.GetByID(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("get customer by id: %w", err)
+	}
+
+	attachments, err := h.attachments.ListAttachments(ctx, customer.ID())
+	if err != nil {
+		return nil, fmt.Errorf("list attachments: %w", err)
+	}
+
+	result := make([]AttachmentDTO, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, toAttachmentDTO(attachment))
+	}
+
+	return result, nil
+}
+
+// DownloadURL возвращает временную ссылку на скачивание вложения клиента.
+func (h *GetAttachmentsHandler) DownloadURL(ctx context.Context, query GetAttachmentURL) (AttachmentURLDTO, error) {
+	customer, err := h.customers.GetByID(ctx, query.CustomerID)
+	if err != nil {
+		return AttachmentURLDTO{}, fmt.Errorf("get customer by id: %w", err)
+	}
+
+	attachment, err := h.attachments.GetAttachment(ctx, query.AttachmentID)
+	if err != nil {
+		return AttachmentURLDTO{}, err
+	}
+
+	// Вложение слитого дубликата принадлежит основному клиенту.
+	owner, err := h.customers.GetByID(ctx, attachment.CustomerID().String())
+	if err != nil {
+		return AttachmentURLDTO{}, fmt.Errorf("get attachment owner: %w", err)
+	}
+	if owner.ID() != customer.ID() {
+		return AttachmentURLDTO{}, valueobjects.ErrAttachmentNotFound
+	}
+
+	expiresAt := h.clockNow().UTC().Add(h.ttl)
+	link, err := h.signer.PresignGet(ctx, attachment.StorageKey(), attachment.FileName(), h.ttl)
+	if err != nil {
+		return AttachmentURLDTO{}, fmt.Errorf("presign attachment: %w", err)
+	}
+
+	return AttachmentURLDTO{URL: link, ExpiresAt: expiresAt}, nil
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (h *GetAttachmentsHandler) WithClock(clock func() time.Time) {
+	if clock != nil {
+		h.clockNow = clock
+	}
+}
+
+func toAttachmentDTO(attachment *models.Attachment) AttachmentDTO {
+	return AttachmentDTO{
+		ID:          attachment.ID().String(),
+		CustomerID:  attachment.CustomerID().String(),
+		Kind:        string(attachment.Kind()),
+		FileName:    attachment.FileName(),
+		ContentType: attachment.ContentType(),
+		Size:        attachment.Size(),
+		Checksum:    attachment.Checksum(),
+		UploadedBy:  attachment.UploadedBy(),
+		CreatedAt:   attachment.CreatedAt(),
+	}
+}
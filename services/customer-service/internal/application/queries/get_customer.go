@@ -3,16 +3,21 @@ package queries
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
 )
 
 // CustomerDTO представляет данные для отдачи наружу.
 type CustomerDTO struct {
-	ID          string
-	FullName    string
-	Email       string
-	PhoneNumber string
+	ID           string
+	FullName     string
+	Email        string
+	PhoneNumber  string
+	BirthDate    time.Time
+	ReferralCode string
+	UpdatedAt    time.Time
+	Version      int
 }
 
 // CustomerReadModel описывает операции чтения агрегата.
@@ -37,10 +42,18 @@ func (h *GetCustomerHandler) Handle(ctx context.Context, id string) (CustomerDTO
 		return CustomerDTO{}, fmt.Errorf("get customer by id: %w", err)
 	}
 
+	return newCustomerDTO(customer), nil
+}
+
+func newCustomerDTO(customer *models.Customer) CustomerDTO {
 	return CustomerDTO{
-		ID:          customer.ID().String(),
-		FullName:    customer.FullName(),
-		Email:       customer.Email().String(),
-		PhoneNumber: customer.PhoneNumber().String(),
-	}, nil
+		ID:           customer.ID().String(),
+		FullName:     customer.FullName(),
+		Email:        customer.Email().String(),
+		PhoneNumber:  customer.PhoneNumber().String(),
+		BirthDate:    customer.BirthDate(),
+		ReferralCode: customer.ReferralCode().String(),
+		UpdatedAt:    customer.UpdatedAt(),
+		Version:      customer.Version(),
+	}
 }
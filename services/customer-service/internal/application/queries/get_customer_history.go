@@ -0,0 +1,119 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+)
+
+// CustomerHistoryReader описывает чтение потока событий клиента.
+type CustomerHistoryReader interface {
+	LoadAsOf(ctx context.Context, id string, at time.Time) (*models.Customer, error)
+	History(ctx context.Context, id string) ([]events.Envelope, error)
+}
+
+// CustomerEventDTO представляет событие из истории клиента.
+type CustomerEventDTO struct {
+	Version    int
+	Type       string
+	Changes    map[string]string
+	OccurredAt time.Time
+}
+
+// GetCustomerAsOfHandler восстанавливает состояние клиента на момент времени.
+type GetCustomerAsOfHandler struct {
+	reader CustomerHistoryReader
+}
+
+// NewGetCustomerAsOfHandler создаёт обработчик.
+func NewGetCustomerAsOfHandler(reader CustomerHistoryReader) *GetCustomerAsOfHandler {
+	return &GetCustomerAsOfHandler{reader: reader}
+}
+
+// Handle возвращает DTO клиента в состоянии на момент at.
+func (h *GetCustomerAsOfHandler) Handle(ctx context.Context, id string, at time.Time) (CustomerDTO, error) {
+	customer, err := h.reader.LoadAsOf(ctx, id, at)
+	if err != nil {
+		return CustomerDTO{}, fmt.Errorf("load customer as of %s: %w", at.Format(time.RFC3339), err)
+	}
+
+	return newCustomerDTO(customer), nil
+}
+
+// GetCustomerHistoryHandler возвращает упорядоченную историю изменений клиента.
+type GetCustomerHistoryHandler struct {
+	reader CustomerHistoryReader
+}
+
+// NewGetCustomerHistoryHandler создаёт обработчик.
+func NewGetCustomerHistoryHandler(reader CustomerHistoryReader) *GetCustomerHistoryHandler {
+	return &GetCustomerHistoryHandler{reader: reader}
+}
+
+// Handle возвращает события клиента по возрастанию версии.
+func (h *GetCustomerHistoryHandler) Handle(ctx context.Context, id string) ([]CustomerEventDTO, error) {
+	stream, err := h.reader.History(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("load customer history: %w", err)
+	}
+
+	history := make([]CustomerEventDTO, 0, len(stream))
+	for _, event := range stream {
+		history = append(history, CustomerEventDTO{
+			Version:    event.Version,
+			Type:       string(event.Type),
+			Changes:    eventChanges(event.Payload),
+			OccurredAt: event.OccurredAt,
+		})
+	}
+
+	return history, nil
+}
+
+func eventChanges(payload interface{}) map[string]string {
+	switch event := payload.(type) {
+	case events.CustomerRegistered:
+		changes := map[string]string{
+			"email":        event.Email,
+			"full_name":    event.FullName,
+			"phone_number": event.PhoneNumber,
+			"birth_date":   event.BirthDate.Format("2006-01-02"),
+		}
+		if event.ReferralCode != "" {
+			changes["referral_code"] = event.ReferralCode
+		}
+		return changes
+	case events.CustomerEmailChanged:
+		return map[string]string{"email": event.Email}
+	case events.CustomerPhoneNumberChanged:
+		return map[string]string{"phone_number": event.PhoneNumber}
+	case events.CustomerFullNameChanged:
+		return map[string]string{"full_name": event.FullName}
+	case events.CustomersMerged:
+		return map[string]string{"merged_into": event.SurvivorID}
+	case events.ConsentChanged:
+		return map[string]string{
+			"channel":            event.Channel,
+			"granted":            strconv.FormatBool(event.Granted),
+			"source":             event.Source,
+			"legal_text_version": event.LegalTextVersion,
+		}
+	case events.CustomerPreferencesUpdated:
+		return map[string]string{
+			"language":          event.Language,
+			"timezone":          event.Timezone,
+			"preferred_channel": event.PreferredChannel,
+		}
+	case events.CustomerReferred:
+		return map[string]string{
+			"referred_by":   event.ReferrerID,
+			"referral_code": event.ReferralCode,
+		}
+	default:
+		return nil
+	}
+}
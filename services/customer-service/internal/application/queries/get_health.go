@@ -0,0 +1,144 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+// GetHealthStatus описывает запрос статуса анкеты здоровья клиента.
+type GetHealthStatus struct {
+	CustomerID string
+	Code       string
+}
+
+// HealthStatusDTO — статус допуска без медицинских подробностей; доступен всем сотрудникам.
+type HealthStatusDTO struct {
+	CustomerID     string
+	Code           string
+	Status         string
+	Version        int
+	CurrentVersion int
+	Flags          []string
+	SubmittedAt    time.Time
+	ExpiresAt      time.Time
+}
+
+// HealthSubmissionDTO — анкета с ответами и подписью; доступна медицинским ролям.
+type HealthSubmissionDTO struct {
+	HealthStatusDTO
+	SubmissionID string
+	Answers      map[string]string
+	SignerName   string
+	Signature    string
+	SignedAt     time.Time
+}
+
+// HealthReadModel описывает чтение анкет здоровья.
+type HealthReadModel interface {
+	CurrentDefinition(ctx context.Context, code string) (*models.QuestionnaireDefinition, error)
+	LatestSubmission(ctx context.Context, customerID uuid.UUID, code string) (*models.HealthSubmission, error)
+}
+
+// AccessLog фиксирует чтение чувствительных данных в журнале аудита.
+type AccessLog interface {
+	Append(ctx context.Context, record audit.Record) error
+}
+
+// GetHealthHandler возвращает статус и содержимое анкет здоровья.
+type GetHealthHandler struct {
+	customers CustomerReadModel
+	health    HealthReadModel
+	accessLog AccessLog
+	policy    models.HealthAccessPolicy
+	clockNow  func() time.Time
+}
+
+// NewGetHealthHandler создаёт обработчик.
+func NewGetHealthHandler(customers CustomerReadModel, health HealthReadModel, accessLog AccessLog, policy models.HealthAccessPolicy) *GetHealthHandler {
+	return &GetHealthHandler{customers: customers, health: health, accessLog: accessLog, policy: policy, clockNow: time.Now}
+}
+
+// Status возвращает действующий статус анкеты и производные флаги.
+func (h *GetHealthHandler) Status(ctx context.Context, query GetHealthStatus) (HealthStatusDTO, error) {
+	status, _, err := h.load(ctx, query)
+	return status, err
+}
+
+// Submission возвращает последнюю анкету с ответами. Каждое чтение пишется в журнал аудита.
+func (h *GetHealthHandler) Submission(ctx context.Context, query GetHealthStatus) (HealthSubmissionDTO, error) {
+	if !h.policy.CanReadRecords(audit.ActorFromContext(ctx).Role) {
+		return HealthSubmissionDTO{}, valueobjects.ErrHealthAccessDenied
+	}
+
+	status, submission, err := h.load(ctx, query)
+	if err != nil {
+		return HealthSubmissionDTO{}, err
+	}
+	if submission == nil {
+		return HealthSubmissionDTO{}, valueobjects.ErrHealthRecordNotFound
+	}
+
+	record := audit.NewRecord(ctx, audit.ActionHealthRecordViewed, audit.AggregateHealth, submission.ID().String(), nil, nil, h.clockNow().UTC())
+	if err := h.accessLog.Append(ctx, record); err != nil {
+		return HealthSubmissionDTO{}, fmt.Errorf("append audit record: %w", err)
+	}
+
+	signature := submission.Signature()
+	return HealthSubmissionDTO{
+		HealthStatusDTO: status,
+		SubmissionID:    submission.ID().String(),
+		Answers:         submission.Answers(),
+		SignerName:      signature.SignerName,
+		Signature:       signature.Data,
+		SignedAt:        signature.SignedAt,
+	}, nil
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (h *GetHealthHandler) WithClock(clock func() time.Time) {
+	if clock != nil {
+		h.clockNow = clock
+	}
+}
+
+func (h *GetHealthHandler) load(ctx context.Context, query GetHealthStatus) (HealthStatusDTO, *models.HealthSubmission, error) {
+	customer, err := h.customers.GetByID(ctx, query.CustomerID)
+	if err != nil {
+		return HealthStatusDTO{}, nil, fmt.Errorf("get customer by id: %w", err)
+	}
+
+	definition, err := h.health.CurrentDefinition(ctx, query.Code)
+	if err != nil {
+		return HealthStatusDTO{}, nil, fmt.Errorf("load questionnaire: %w", err)
+	}
+
+	status := HealthStatusDTO{
+		CustomerID:     customer.ID().String(),
+		Code:           definition.Code,
+		CurrentVersion: definition.Version,
+	}
+
+	submission, err := h.health.LatestSubmission(ctx, customer.ID(), definition.Code)
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrHealthRecordNotFound) {
+			status.Status = string(models.HealthStatusMissing)
+			return status, nil, nil
+		}
+		return HealthStatusDTO{}, nil, fmt.Errorf("load health submission: %w", err)
+	}
+
+	status.Status = string(submission.Status(definition, h.clockNow()))
+	status.Version = submission.Version()
+	status.Flags = submission.Flags()
+	status.SubmittedAt = submission.SubmittedAt()
+	status.ExpiresAt = submission.ExpiresAt()
+
+	return status, submission, nil
+}
@@ -0,0 +1,80 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// HouseholdDTO представляет домохозяйство клиента.
+type HouseholdDTO struct {
+	ID             string
+	Name           string
+	PrimaryPayerID string
+	Members        []HouseholdMemberDTO
+	Version        int
+}
+
+// HouseholdMemberDTO представляет участника домохозяйства.
+type HouseholdMemberDTO struct {
+	CustomerID string
+	FullName   string
+	GuardianID string
+	Minor      bool
+	JoinedAt   time.Time
+}
+
+// HouseholdReadModel описывает чтение домохозяйств.
+type HouseholdReadModel interface {
+	GetByCustomerID(ctx context.Context, customerID string) (*models.Household, error)
+}
+
+// GetCustomerHouseholdHandler возвращает домохозяйство, в котором состоит клиент.
+type GetCustomerHouseholdHandler struct {
+	households HouseholdReadModel
+	customers  CustomerReadModel
+}
+
+// NewGetCustomerHouseholdHandler создаёт обработчик.
+func NewGetCustomerHouseholdHandler(households HouseholdReadModel, customers CustomerReadModel) *GetCustomerHouseholdHandler {
+	return &GetCustomerHouseholdHandler{households: households, customers: customers}
+}
+
+// Handle возвращает DTO домохозяйства с именами участников.
+func (h *GetCustomerHouseholdHandler) Handle(ctx context.Context, customerID string) (HouseholdDTO, error) {
+	household, err := h.households.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		return HouseholdDTO{}, fmt.Errorf("get household by customer: %w", err)
+	}
+
+	dto := HouseholdDTO{
+		ID:             household.ID().String(),
+		Name:           household.Name(),
+		PrimaryPayerID: household.PrimaryPayerID().String(),
+		Version:        household.Version(),
+	}
+
+	for _, member := range household.Members() {
+		customer, err := h.customers.GetByID(ctx, member.CustomerID.String())
+		if err != nil {
+			return HouseholdDTO{}, fmt.Errorf("get household member: %w", err)
+		}
+
+		memberDTO := HouseholdMemberDTO{
+			CustomerID: member.CustomerID.String(),
+			FullName:   customer.FullName(),
+			Minor:      member.Minor,
+			JoinedAt:   member.JoinedAt,
+		}
+		if member.GuardianID != uuid.Nil {
+			memberDTO.GuardianID = member.GuardianID.String()
+		}
+
+		dto.Members = append(dto.Members, memberDTO)
+	}
+
+	return dto, nil
+}
@@ -0,0 +1,165 @@
+package queries
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultTimelinePageSize = 50
+	maxTimelinePageSize     = 200
+)
+
+// GetCustomerTimeline описывает запрос страницы ленты клиента.
+// Пустой Types означает все доступные инициатору типы.
+type GetCustomerTimeline struct {
+	CustomerID string
+	Types      []string
+	PageSize   int
+	PageToken  string
+}
+
+// TimelineEntryDTO представляет запись ленты.
+type TimelineEntryDTO struct {
+	ID         string
+	CustomerID string
+	Type       string
+	Body       string
+	AuthorID   string
+	AuthorRole string
+	Visibility string
+	Pinned     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TimelinePage содержит страницу ленты и токен следующей страницы.
+type TimelinePage struct {
+	Entries       []TimelineEntryDTO
+	NextPageToken string
+}
+
+// TimelineReadModel описывает выборку ленты клиента.
+type TimelineReadModel interface {
+	ListTimeline(ctx context.Context, filter models.TimelineFilter) ([]*models.TimelineEntry, error)
+}
+
+// GetCustomerTimelineHandler возвращает ленту клиента с учётом роли инициатора.
+type GetCustomerTimelineHandler struct {
+	customers CustomerReadModel
+	timeline  TimelineReadModel
+	policy    models.TimelinePolicy
+}
+
+// NewGetCustomerTimelineHandler создаёт обработчик.
+func NewGetCustomerTimelineHandler(customers CustomerReadModel, timeline TimelineReadModel, policy models.TimelinePolicy) *GetCustomerTimelineHandler {
+	return &GetCustomerTimelineHandler{customers: customers, timeline: timeline, policy: policy}
+}
+
+// timelineCursor — содержимое токена страницы ленты.
+type timelineCursor struct {
+	Pinned bool      `json:"p"`
+	At     time.Time `json:"at"`
+	ID     uuid.UUID `json:"id"`
+}
+
+// Handle возвращает страницу ленты: закреплённые записи первыми, затем от новых к старым.
+func (h *GetCustomerTimelineHandler) Handle(ctx context.Context, query GetCustomerTimeline) (TimelinePage, error) {
+	scope := h.policy.Scope(audit.TimelineViewer(ctx))
+	if len(query.Types) > 0 {
+		requested := make(map[valueobjects.EntryType]bool, len(query.Types))
+		for _, raw := range query.Types {
+			entryType, err := parseTimelineType(raw)
+			if err != nil {
+				return TimelinePage{}, err
+			}
+			requested[entryType] = true
+		}
+
+		allowed := scope.Types[:0:0]
+		for _, entryType := range scope.Types {
+			if requested[entryType] {
+				allowed = append(allowed, entryType)
+			}
+		}
+		scope.Types = allowed
+	}
+
+	filter := models.TimelineFilter{Scope: scope, Limit: query.PageSize}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultTimelinePageSize
+	}
+	if filter.Limit > maxTimelinePageSize {
+		filter.Limit = maxTimelinePageSize
+	}
+
+	if query.PageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(query.PageToken)
+		if err != nil {
+			return TimelinePage{}, ErrInvalidPageToken
+		}
+		var cursor timelineCursor
+		if err := json.Unmarshal(raw, &cursor); err != nil {
+			return TimelinePage{}, ErrInvalidPageToken
+		}
+		filter.After = &models.TimelineCursor{Pinned: cursor.Pinned, CreatedAt: cursor.At, ID: cursor.ID}
+	}
+
+	customer, err := h.customers.GetByID(ctx, query.CustomerID)
+	if err != nil {
+		return TimelinePage{}, fmt.Errorf("get customer by id: %w", err)
+	}
+	filter.CustomerID = customer.ID()
+
+	if len(scope.Types) == 0 {
+		return TimelinePage{}, nil
+	}
+
+	pageSize := filter.Limit
+	filter.Limit++
+	entries, err := h.timeline.ListTimeline(ctx, filter)
+	if err != nil {
+		return TimelinePage{}, fmt.Errorf("list timeline: %w", err)
+	}
+
+	page := TimelinePage{}
+	if len(entries) > pageSize {
+		entries = entries[:pageSize]
+		last := entries[len(entries)-1].Cursor()
+		raw, _ := json.Marshal(timelineCursor{Pinned: last.Pinned, At: last.CreatedAt, ID: last.ID})
+		page.NextPageToken = base64.RawURLEncoding.EncodeToString(raw)
+	}
+
+	page.Entries = make([]TimelineEntryDTO, 0, len(entries))
+	for _, entry := range entries {
+		page.Entries = append(page.Entries, TimelineEntryDTO{
+			ID:         entry.ID().String(),
+			CustomerID: entry.CustomerID().String(),
+			Type:       string(entry.Type()),
+			Body:       entry.Body(),
+			AuthorID:   entry.AuthorID(),
+			AuthorRole: entry.AuthorRole(),
+			Visibility: string(entry.Visibility()),
+			Pinned:     entry.Pinned(),
+			CreatedAt:  entry.CreatedAt(),
+			UpdatedAt:  entry.UpdatedAt(),
+		})
+	}
+
+	return page, nil
+}
+
+func parseTimelineType(raw string) (valueobjects.EntryType, error) {
+	if entryType := valueobjects.EntryType(raw); entryType == valueobjects.EntryTypeSystem {
+		return entryType, nil
+	}
+	return valueobjects.ParseEntryType(raw)
+}
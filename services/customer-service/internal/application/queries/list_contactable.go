@@ -0,0 +1,107 @@
+package queries
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultContactablePageSize = 100
+	maxContactablePageSize     = 1000
+)
+
+// ListContactable описывает запрос клиентов, доступных для связи по каналу.
+type ListContactable struct {
+	Channel   string
+	PageSize  int
+	PageToken string
+}
+
+// ContactableCustomerDTO представляет клиента для рассылки.
+type ContactableCustomerDTO struct {
+	ID          string
+	FullName    string
+	Email       string
+	PhoneNumber string
+	Language    string
+	Timezone    string
+}
+
+// ContactableCustomersPage содержит страницу клиентов и токен следующей страницы.
+type ContactableCustomersPage struct {
+	Customers     []ContactableCustomerDTO
+	NextPageToken string
+}
+
+// ContactableReadModel описывает выборку клиентов с действующим согласием.
+type ContactableReadModel interface {
+	ListContactable(ctx context.Context, channel valueobjects.Channel, afterID uuid.UUID, limit int) ([]models.ContactableCustomer, error)
+}
+
+// ListContactableHandler возвращает клиентов, давших согласие на канал.
+type ListContactableHandler struct {
+	readModel ContactableReadModel
+}
+
+// NewListContactableHandler создаёт обработчик.
+func NewListContactableHandler(readModel ContactableReadModel) *ListContactableHandler {
+	return &ListContactableHandler{readModel: readModel}
+}
+
+// Handle возвращает страницу клиентов, доступных для связи по каналу.
+func (h *ListContactableHandler) Handle(ctx context.Context, query ListContactable) (ContactableCustomersPage, error) {
+	channel, err := valueobjects.ParseChannel(query.Channel)
+	if err != nil {
+		return ContactableCustomersPage{}, err
+	}
+
+	afterID := uuid.Nil
+	if query.PageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(query.PageToken)
+		if err != nil {
+			return ContactableCustomersPage{}, ErrInvalidPageToken
+		}
+		if afterID, err = uuid.FromBytes(raw); err != nil {
+			return ContactableCustomersPage{}, ErrInvalidPageToken
+		}
+	}
+
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultContactablePageSize
+	}
+	if pageSize > maxContactablePageSize {
+		pageSize = maxContactablePageSize
+	}
+
+	customers, err := h.readModel.ListContactable(ctx, channel, afterID, pageSize+1)
+	if err != nil {
+		return ContactableCustomersPage{}, fmt.Errorf("list contactable customers: %w", err)
+	}
+
+	page := ContactableCustomersPage{}
+	if len(customers) > pageSize {
+		customers = customers[:pageSize]
+		lastID := customers[len(customers)-1].ID
+		page.NextPageToken = base64.RawURLEncoding.EncodeToString(lastID[:])
+	}
+
+	page.Customers = make([]ContactableCustomerDTO, 0, len(customers))
+	for _, customer := range customers {
+		page.Customers = append(page.Customers, ContactableCustomerDTO{
+			ID:          customer.ID.String(),
+			FullName:    customer.FullName,
+			Email:       customer.Email,
+			PhoneNumber: customer.PhoneNumber,
+			Language:    customer.Language,
+			Timezone:    customer.Timezone,
+		})
+	}
+
+	return page, nil
+}
@@ -0,0 +1,220 @@
+package queries
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultCustomersPageSize = 50
+	maxCustomersPageSize     = 500
+	exportBatchSize          = 1000
+)
+
+// ListCustomers описывает запрос страницы клиентов.
+type ListCustomers struct {
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	BirthMonth  int
+	Status      string
+	SortBy      string
+	Descending  bool
+	PageSize    int
+	PageToken   string
+}
+
+// CustomerSummaryDTO представляет строку списка клиентов.
+type CustomerSummaryDTO struct {
+	ID          string
+	FullName    string
+	Email       string
+	PhoneNumber string
+	BirthDate   time.Time
+	Status      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Version     int
+}
+
+// CustomersPage содержит страницу клиентов и токен следующей страницы.
+type CustomersPage struct {
+	Customers     []CustomerSummaryDTO
+	NextPageToken string
+}
+
+// CustomerLister описывает постраничную выборку клиентов.
+type CustomerLister interface {
+	ListCustomers(ctx context.Context, filter models.CustomerListFilter) ([]models.CustomerSummary, error)
+}
+
+// ListCustomersHandler возвращает клиентов постранично и выгружает их целиком.
+type ListCustomersHandler struct {
+	lister CustomerLister
+}
+
+// NewListCustomersHandler создаёт обработчик.
+func NewListCustomersHandler(lister CustomerLister) *ListCustomersHandler {
+	return &ListCustomersHandler{lister: lister}
+}
+
+// pageCursor — содержимое непрозрачного токена страницы. Сортировка входит
+// в токен, чтобы токен нельзя было применить к выборке с другим порядком.
+type pageCursor struct {
+	Sort       models.CustomerSort `json:"s"`
+	Descending bool                `json:"d,omitempty"`
+	CreatedAt  time.Time           `json:"c"`
+	FullName   string              `json:"n,omitempty"`
+	ID         uuid.UUID           `json:"i"`
+}
+
+// Handle возвращает страницу клиентов.
+func (h *ListCustomersHandler) Handle(ctx context.Context, query ListCustomers) (CustomersPage, error) {
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultCustomersPageSize
+	}
+	if pageSize > maxCustomersPageSize {
+		pageSize = maxCustomersPageSize
+	}
+
+	filter, err := newCustomerListFilter(query)
+	if err != nil {
+		return CustomersPage{}, err
+	}
+	filter.Limit = pageSize + 1
+
+	customers, err := h.lister.ListCustomers(ctx, filter)
+	if err != nil {
+		return CustomersPage{}, fmt.Errorf("list customers: %w", err)
+	}
+
+	page := CustomersPage{}
+	if len(customers) > pageSize {
+		customers = customers[:pageSize]
+		page.NextPageToken = encodeCustomerCursor(filter, customers[len(customers)-1].Cursor())
+	}
+
+	page.Customers = make([]CustomerSummaryDTO, 0, len(customers))
+	for _, customer := range customers {
+		page.Customers = append(page.Customers, newCustomerSummaryDTO(customer))
+	}
+
+	return page, nil
+}
+
+// Export обходит всю выборку пачками и передаёт каждую строку в emit.
+// Обход прекращается при первой ошибке emit или отмене контекста.
+func (h *ListCustomersHandler) Export(ctx context.Context, query ListCustomers, emit func(CustomerSummaryDTO) error) error {
+	filter, err := newCustomerListFilter(query)
+	if err != nil {
+		return err
+	}
+	filter.Limit = exportBatchSize
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		customers, err := h.lister.ListCustomers(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("list customers: %w", err)
+		}
+
+		for _, customer := range customers {
+			if err := emit(newCustomerSummaryDTO(customer)); err != nil {
+				return err
+			}
+		}
+
+		if len(customers) < exportBatchSize {
+			return nil
+		}
+
+		cursor := customers[len(customers)-1].Cursor()
+		filter.After = &cursor
+	}
+}
+
+func newCustomerListFilter(query ListCustomers) (models.CustomerListFilter, error) {
+	status, err := models.ParseCustomerStatus(query.Status)
+	if err != nil {
+		return models.CustomerListFilter{}, err
+	}
+
+	sort, err := models.ParseCustomerSort(query.SortBy)
+	if err != nil {
+		return models.CustomerListFilter{}, err
+	}
+
+	if query.BirthMonth < 0 || query.BirthMonth > 12 {
+		return models.CustomerListFilter{}, fmt.Errorf("birth month must be between 1 and 12, got %d", query.BirthMonth)
+	}
+
+	filter := models.CustomerListFilter{
+		CreatedFrom: query.CreatedFrom,
+		CreatedTo:   query.CreatedTo,
+		BirthMonth:  query.BirthMonth,
+		Status:      status,
+		Sort:        sort,
+		Descending:  query.Descending,
+	}
+
+	if query.PageToken != "" {
+		cursor, err := decodeCustomerCursor(query.PageToken, filter)
+		if err != nil {
+			return models.CustomerListFilter{}, err
+		}
+		filter.After = &cursor
+	}
+
+	return filter, nil
+}
+
+func encodeCustomerCursor(filter models.CustomerListFilter, cursor models.CustomerCursor) string {
+	raw, _ := json.Marshal(pageCursor{
+		Sort:       filter.Sort,
+		Descending: filter.Descending,
+		CreatedAt:  cursor.CreatedAt,
+		FullName:   cursor.FullName,
+		ID:         cursor.ID,
+	})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCustomerCursor(token string, filter models.CustomerListFilter) (models.CustomerCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return models.CustomerCursor{}, ErrInvalidPageToken
+	}
+
+	var cursor pageCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return models.CustomerCursor{}, ErrInvalidPageToken
+	}
+	if cursor.Sort != filter.Sort || cursor.Descending != filter.Descending || cursor.ID == uuid.Nil {
+		return models.CustomerCursor{}, ErrInvalidPageToken
+	}
+
+	return models.CustomerCursor{CreatedAt: cursor.CreatedAt, FullName: cursor.FullName, ID: cursor.ID}, nil
+}
+
+func newCustomerSummaryDTO(customer models.CustomerSummary) CustomerSummaryDTO {
+	return CustomerSummaryDTO{
+		ID:          customer.ID.String(),
+		FullName:    customer.FullName,
+		Email:       customer.Email,
+		PhoneNumber: customer.PhoneNumber,
+		BirthDate:   customer.BirthDate,
+		Status:      string(customer.Status),
+		CreatedAt:   customer.CreatedAt,
+		UpdatedAt:   customer.UpdatedAt,
+		Version:     customer.Version,
+	}
+}
@@ -0,0 +1,90 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type fakeLister struct {
+	customers []models.CustomerSummary
+	filters   []models.CustomerListFilter
+}
+
+// ListCustomers имитирует keyset-выборку по возрастанию created_at.
+func (f *fakeLister) ListCustomers(ctx context.Context, filter models.CustomerListFilter) ([]models.CustomerSummary, error) {
+	f.filters = append(f.filters, filter)
+
+	var result []models.CustomerSummary
+	for _, customer := range f.customers {
+		if filter.After != nil && !customer.CreatedAt.After(filter.After.CreatedAt) {
+			continue
+		}
+		if len(result) == filter.Limit {
+			break
+		}
+		result = append(result, customer)
+	}
+	return result, nil
+}
+
+func summaries(n int) []models.CustomerSummary {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := make([]models.CustomerSummary, 0, n)
+	for i := 0; i < n; i++ {
+		result = append(result, models.CustomerSummary{ID: uuid.New(), CreatedAt: base.Add(time.Duration(i) * time.Minute), Status: models.CustomerStatusActive})
+	}
+	return result
+}
+
+func TestListCustomersHandler_Paging(t *testing.T) {
+	lister := &fakeLister{customers: summaries(5)}
+	handler := NewListCustomersHandler(lister)
+
+	first, err := handler.Handle(context.Background(), ListCustomers{PageSize: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first.Customers) != 2 || first.NextPageToken == "" {
+		t.Fatalf("expected first page with token, got %+v", first)
+	}
+
+	second, err := handler.Handle(context.Background(), ListCustomers{PageSize: 2, PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(second.Customers) != 2 || second.Customers[0].ID != lister.customers[2].ID.String() {
+		t.Fatalf("expected second page to continue after cursor, got %+v", second.Customers)
+	}
+
+	if _, err := handler.Handle(context.Background(), ListCustomers{SortBy: "name", PageToken: first.NextPageToken}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Fatalf("expected token to be rejected for another sort, got %v", err)
+	}
+	if _, err := handler.Handle(context.Background(), ListCustomers{PageToken: "garbage"}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Fatalf("expected invalid token error, got %v", err)
+	}
+}
+
+func TestListCustomersHandler_Export(t *testing.T) {
+	lister := &fakeLister{customers: summaries(exportBatchSize + 10)}
+	handler := NewListCustomersHandler(lister)
+
+	exported := 0
+	err := handler.Export(context.Background(), ListCustomers{Status: "active"}, func(CustomerSummaryDTO) error {
+		exported++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if exported != exportBatchSize+10 || len(lister.filters) != 2 {
+		t.Fatalf("expected all rows in two batches, got %d rows in %d batches", exported, len(lister.filters))
+	}
+	if lister.filters[0].Status != models.CustomerStatusActive {
+		t.Fatalf("expected status filter to be passed through")
+	}
+}
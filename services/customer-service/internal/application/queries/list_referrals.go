@@ -0,0 +1,114 @@
+package queries
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultReferralsPageSize = 50
+	maxReferralsPageSize     = 500
+)
+
+// ListReferrals описывает запрос клиентов, приглашённых указанным клиентом.
+type ListReferrals struct {
+	CustomerID string
+	PageSize   int
+	PageToken  string
+}
+
+// ReferralDTO представляет приглашённого клиента.
+type ReferralDTO struct {
+	CustomerID   string
+	FullName     string
+	ReferralCode string
+	ReferredAt   time.Time
+}
+
+// ReferralsPage содержит страницу приглашённых и токен следующей страницы.
+type ReferralsPage struct {
+	ReferrerID    string
+	Referrals     []ReferralDTO
+	NextPageToken string
+}
+
+// ReferralReadModel описывает выборку приглашённых клиентов.
+type ReferralReadModel interface {
+	GetByID(ctx context.Context, id string) (*models.Customer, error)
+	ListReferrals(ctx context.Context, referrerID uuid.UUID, afterAt time.Time, afterID uuid.UUID, limit int) ([]models.Referral, error)
+}
+
+// ListReferralsHandler возвращает приглашённых клиентом в порядке привлечения.
+type ListReferralsHandler struct {
+	readModel ReferralReadModel
+}
+
+// NewListReferralsHandler создаёт обработчик.
+func NewListReferralsHandler(readModel ReferralReadModel) *ListReferralsHandler {
+	return &ListReferralsHandler{readModel: readModel}
+}
+
+// referralCursor — содержимое токена страницы: позиция последнего приглашённого.
+type referralCursor struct {
+	At time.Time `json:"at"`
+	ID uuid.UUID `json:"id"`
+}
+
+// Handle возвращает страницу приглашённых. Идентификатор слитого дубликата
+// перенаправляется на основного клиента.
+func (h *ListReferralsHandler) Handle(ctx context.Context, query ListReferrals) (ReferralsPage, error) {
+	var cursor referralCursor
+	if query.PageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(query.PageToken)
+		if err != nil {
+			return ReferralsPage{}, ErrInvalidPageToken
+		}
+		if err := json.Unmarshal(raw, &cursor); err != nil {
+			return ReferralsPage{}, ErrInvalidPageToken
+		}
+	}
+
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultReferralsPageSize
+	}
+	if pageSize > maxReferralsPageSize {
+		pageSize = maxReferralsPageSize
+	}
+
+	referrer, err := h.readModel.GetByID(ctx, query.CustomerID)
+	if err != nil {
+		return ReferralsPage{}, fmt.Errorf("get customer by id: %w", err)
+	}
+
+	referrals, err := h.readModel.ListReferrals(ctx, referrer.ID(), cursor.At, cursor.ID, pageSize+1)
+	if err != nil {
+		return ReferralsPage{}, fmt.Errorf("list referrals: %w", err)
+	}
+
+	page := ReferralsPage{ReferrerID: referrer.ID().String()}
+	if len(referrals) > pageSize {
+		referrals = referrals[:pageSize]
+		last := referrals[len(referrals)-1]
+		raw, _ := json.Marshal(referralCursor{At: last.ReferredAt, ID: last.ReferredID})
+		page.NextPageToken = base64.RawURLEncoding.EncodeToString(raw)
+	}
+
+	page.Referrals = make([]ReferralDTO, 0, len(referrals))
+	for _, referral := range referrals {
+		page.Referrals = append(page.Referrals, ReferralDTO{
+			CustomerID:   referral.ReferredID.String(),
+			FullName:     referral.FullName,
+			ReferralCode: referral.ReferralCode,
+			ReferredAt:   referral.ReferredAt,
+		})
+	}
+
+	return page, nil
+}
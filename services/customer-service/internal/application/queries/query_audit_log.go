@@ -0,0 +1,142 @@
+package queries
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+)
+
+const (
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 500
+)
+
+// ErrInvalidPageToken возвращается, если токен страницы не удалось разобрать.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// QueryAuditLog описывает запрос страницы журнала аудита.
+type QueryAuditLog struct {
+	AggregateID string
+	ActorID     string
+	Action      string
+	From        time.Time
+	To          time.Time
+	PageSize    int
+	PageToken   string
+}
+
+// AuditEntryDTO представляет запись журнала аудита для отдачи наружу.
+type AuditEntryDTO struct {
+	ID            string
+	ActorID       string
+	ActorRole     string
+	Action        string
+	AggregateType string
+	AggregateID   string
+	Changes       map[string]audit.Change
+	RequestID     string
+	TraceID       string
+	OccurredAt    time.Time
+}
+
+// AuditLogPage содержит страницу записей и токен следующей страницы.
+type AuditLogPage struct {
+	Entries       []AuditEntryDTO
+	NextPageToken string
+}
+
+// AuditLogReader описывает чтение журнала аудита.
+type AuditLogReader interface {
+	List(ctx context.Context, filter audit.Filter) ([]audit.Record, error)
+}
+
+// QueryAuditLogHandler возвращает журнал аудита постранично.
+type QueryAuditLogHandler struct {
+	reader AuditLogReader
+}
+
+// NewQueryAuditLogHandler создаёт обработчик.
+func NewQueryAuditLogHandler(reader AuditLogReader) *QueryAuditLogHandler {
+	return &QueryAuditLogHandler{reader: reader}
+}
+
+// Handle возвращает страницу журнала аудита.
+func (h *QueryAuditLogHandler) Handle(ctx context.Context, query QueryAuditLog) (AuditLogPage, error) {
+	afterSeq, err := decodeSeqToken(query.PageToken)
+	if err != nil {
+		return AuditLogPage{}, err
+	}
+
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultAuditPageSize
+	}
+	if pageSize > maxAuditPageSize {
+		pageSize = maxAuditPageSize
+	}
+
+	// Запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница.
+	records, err := h.reader.List(ctx, audit.Filter{
+		AggregateID: query.AggregateID,
+		ActorID:     query.ActorID,
+		Action:      audit.Action(query.Action),
+		From:        query.From,
+		To:          query.To,
+		AfterSeq:    afterSeq,
+		Limit:       pageSize + 1,
+	})
+	if err != nil {
+		return AuditLogPage{}, fmt.Errorf("list audit records: %w", err)
+	}
+
+	page := AuditLogPage{}
+	if len(records) > pageSize {
+		records = records[:pageSize]
+		page.NextPageToken = encodeSeqToken(records[len(records)-1].Seq)
+	}
+
+	page.Entries = make([]AuditEntryDTO, 0, len(records))
+	for _, record := range records {
+		page.Entries = append(page.Entries, AuditEntryDTO{
+			ID:            record.ID.String(),
+			ActorID:       record.Actor.ID,
+			ActorRole:     record.Actor.Role,
+			Action:        string(record.Action),
+			AggregateType: record.AggregateType,
+			AggregateID:   record.AggregateID,
+			Changes:       record.Changes,
+			RequestID:     record.RequestID,
+			TraceID:       record.TraceID,
+			OccurredAt:    record.OccurredAt,
+		})
+	}
+
+	return page, nil
+}
+
+func encodeSeqToken(seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(seq, 10)))
+}
+
+func decodeSeqToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+
+	seq, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || seq < 0 {
+		return 0, ErrInvalidPageToken
+	}
+
+	return seq, nil
+}
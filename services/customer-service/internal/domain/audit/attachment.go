@@ -0,0 +1,19 @@
+package audit
+
+import "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+
+// AttachmentState возвращает отслеживаемые аудитом поля вложения.
+func AttachmentState(attachment *models.Attachment) map[string]interface{} {
+	if attachment == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"customer_id":  attachment.CustomerID().String(),
+		"kind":         string(attachment.Kind()),
+		"file_name":    attachment.FileName(),
+		"content_type": attachment.ContentType(),
+		"size":         attachment.Size(),
+		"sha256":       attachment.Checksum(),
+	}
+}
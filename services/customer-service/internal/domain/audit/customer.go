@@ -0,0 +1,76 @@
+package audit
+
+import "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+
+// Redacted заменяет значение персонального поля в записи журнала.
+const Redacted = "[redacted]"
+
+// personalFields — поля клиента, значения которых в журнал не попадают.
+// Журнал только на добавление и при удалении данных клиента не очищается,
+// поэтому в нём остаётся лишь факт изменения поля.
+var personalFields = map[string]bool{
+	"email":        true,
+	"full_name":    true,
+	"phone_number": true,
+	"birth_date":   true,
+}
+
+// CustomerState возвращает отслеживаемые аудитом поля клиента.
+// Персональные поля нужны только для сравнения снимков и обезличиваются в NewRecord.
+func CustomerState(customer *models.Customer) map[string]interface{} {
+	if customer == nil {
+		return nil
+	}
+
+	state := map[string]interface{}{
+		"email":        customer.Email().String(),
+		"full_name":    customer.FullName(),
+		"phone_number": customer.PhoneNumber().String(),
+		"birth_date":   customer.BirthDate().Format("2006-01-02"),
+		"version":      customer.Version(),
+	}
+	if mergedInto, ok := customer.MergedInto(); ok {
+		state["merged_into"] = mergedInto.String()
+	}
+	if code := customer.ReferralCode(); !code.IsZero() {
+		state["referral_code"] = code.String()
+	}
+	if referrerID, ok := customer.ReferredBy(); ok {
+		state["referred_by"] = referrerID.String()
+	}
+
+	preferences := customer.Preferences()
+	if preferences != (models.Preferences{}) {
+		state["language"] = preferences.Language.String()
+		state["timezone"] = preferences.Timezone.String()
+		state["preferred_channel"] = string(preferences.PreferredChannel)
+	}
+
+	for _, consent := range customer.Consents() {
+		status := "withdrawn"
+		if consent.Granted {
+			status = "granted:" + consent.LegalTextVersion
+		}
+		state["consent."+string(consent.Channel)] = status
+	}
+
+	return state
+}
+
+// redactPersonalData заменяет значения персональных полей маркером Redacted.
+// Отсутствующее значение остаётся пустым, чтобы было видно создание и удаление поля.
+func redactPersonalData(changes map[string]Change) map[string]Change {
+	for field, change := range changes {
+		if !personalFields[field] {
+			continue
+		}
+		if change.Before != nil {
+			change.Before = Redacted
+		}
+		if change.After != nil {
+			change.After = Redacted
+		}
+		changes[field] = change
+	}
+	return changes
+}
@@ -0,0 +1,40 @@
+package audit
+
+import "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+
+// HealthSubmissionState возвращает отслеживаемые аудитом поля анкеты.
+// Ответы и подпись в журнал не попадают: журнал не шифруется.
+func HealthSubmissionState(submission *models.HealthSubmission) map[string]interface{} {
+	if submission == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"customer_id":  submission.CustomerID().String(),
+		"code":         submission.QuestionnaireCode(),
+		"version":      submission.Version(),
+		"flags":        submission.Flags(),
+		"submitted_at": submission.SubmittedAt(),
+		"expires_at":   submission.ExpiresAt(),
+	}
+}
+
+// QuestionnaireState возвращает отслеживаемые аудитом поля определения анкеты.
+func QuestionnaireState(definition *models.QuestionnaireDefinition) map[string]interface{} {
+	if definition == nil {
+		return nil
+	}
+
+	questions := make([]string, 0, len(definition.Questions))
+	for _, question := range definition.Questions {
+		questions = append(questions, question.ID)
+	}
+
+	return map[string]interface{}{
+		"code":                 definition.Code,
+		"version":              definition.Version,
+		"questions":            questions,
+		"valid_for":            definition.ValidFor.String(),
+		"min_accepted_version": definition.MinAcceptedVersion,
+	}
+}
@@ -0,0 +1,26 @@
+package audit
+
+import "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+
+// HouseholdState возвращает отслеживаемые аудитом поля домохозяйства.
+func HouseholdState(household *models.Household) map[string]interface{} {
+	if household == nil {
+		return nil
+	}
+
+	members := make(map[string]interface{})
+	for _, member := range household.Members() {
+		guardian := ""
+		if member.Minor {
+			guardian = member.GuardianID.String()
+		}
+		members[member.CustomerID.String()] = guardian
+	}
+
+	return map[string]interface{}{
+		"name":             household.Name(),
+		"primary_payer_id": household.PrimaryPayerID().String(),
+		"members":          members,
+		"version":          household.Version(),
+	}
+}
@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewRecord собирает запись аудита из контекста запроса и снимков агрегата.
+func NewRecord(ctx context.Context, action Action, aggregateType, aggregateID string, before, after map[string]interface{}, occurredAt time.Time) Record {
+	var traceID string
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.HasTraceID() {
+		traceID = spanCtx.TraceID().String()
+	}
+
+	return Record{
+		ID:            uuid.New(),
+		Actor:         ActorFromContext(ctx),
+		Action:        action,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Changes:       redactPersonalData(Diff(before, after)),
+		RequestID:     RequestIDFromContext(ctx),
+		TraceID:       traceID,
+		OccurredAt:    occurredAt,
+	}
+}
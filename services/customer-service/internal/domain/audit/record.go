@@ -0,0 +1,115 @@
+package audit
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Action описывает тип мутации, попавшей в журнал аудита.
+type Action string
+
+const (
+	// ActionCustomerRegistered фиксирует регистрацию клиента.
+	ActionCustomerRegistered Action = "customer.registered"
+	// ActionCustomerUpdated фиксирует изменение профиля клиента.
+	ActionCustomerUpdated Action = "customer.updated"
+	// ActionCustomersMerged фиксирует слияние дубликата с основным клиентом.
+	ActionCustomersMerged Action = "customer.merged"
+	// ActionConsentChanged фиксирует изменение согласия на коммуникации.
+	ActionConsentChanged Action = "customer.consent_changed"
+	// ActionPreferencesUpdated фиксирует изменение предпочтений коммуникации.
+	ActionPreferencesUpdated Action = "customer.preferences_updated"
+
+	ActionHouseholdCreated           Action = "household.created"
+	ActionHouseholdMemberAdded       Action = "household.member_added"
+	ActionHouseholdMemberRemoved     Action = "household.member_removed"
+	ActionHouseholdMemberTransferred Action = "household.member_transferred"
+	ActionTimelineEntryAdded         Action = "timeline.entry_added"
+	ActionTimelineEntryEdited        Action = "timeline.entry_edited"
+	ActionTimelineEntryDeleted       Action = "timeline.entry_deleted"
+	ActionQuestionnairePublished     Action = "health.questionnaire_published"
+	ActionHealthSubmitted            Action = "health.submitted"
+	ActionAttachmentUploaded         Action = "attachment.uploaded"
+	ActionAttachmentDeleted          Action = "attachment.deleted"
+	// ActionHealthRecordViewed фиксирует чтение ответов анкеты медицинским сотрудником.
+	ActionHealthRecordViewed Action = "health.record_viewed"
+	// ActionCustomerErased фиксирует удаление персональных данных клиента по запросу.
+	ActionCustomerErased Action = "customer.erased"
+	// ActionCrossTenantDenied фиксирует отклонённую попытку обращения к данным другого арендатора.
+	ActionCrossTenantDenied Action = "tenant.cross_access_denied"
+)
+
+// Типы агрегатов в журнале аудита.
+const (
+	AggregateCustomer      = "customer"
+	AggregateHousehold     = "household"
+	AggregateTenant        = "tenant"
+	AggregateTimeline      = "timeline_entry"
+	AggregateHealth        = "health_submission"
+	AggregateQuestionnaire = "questionnaire"
+	AggregateAttachment    = "attachment"
+)
+
+// Actor описывает инициатора изменения.
+type Actor struct {
+	ID   string
+	Role string
+}
+
+// SystemActor используется, когда инициатор не передан в метаданных запроса.
+var SystemActor = Actor{ID: "system", Role: "system"}
+
+// Change хранит значение поля до и после изменения.
+type Change struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// Record представляет неизменяемую запись журнала аудита.
+type Record struct {
+	Seq           int64
+	ID            uuid.UUID
+	Actor         Actor
+	Action        Action
+	AggregateType string
+	AggregateID   string
+	Changes       map[string]Change
+	RequestID     string
+	TraceID       string
+	OccurredAt    time.Time
+}
+
+// Filter задаёт условия выборки записей журнала.
+type Filter struct {
+	AggregateID string
+	ActorID     string
+	Action      Action
+	From        time.Time
+	To          time.Time
+	AfterSeq    int64
+	Limit       int
+}
+
+// Diff строит набор изменений между двумя снимками агрегата.
+// Пустой before означает создание агрегата, пустой after — удаление.
+func Diff(before, after map[string]interface{}) map[string]Change {
+	changes := make(map[string]Change)
+
+	for key, newValue := range after {
+		oldValue, ok := before[key]
+		if ok && reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		changes[key] = Change{Before: oldValue, After: newValue}
+	}
+
+	for key, oldValue := range before {
+		if _, ok := after[key]; !ok {
+			changes[key] = Change{Before: oldValue}
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,40 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+)
+
+// TimelineEntryState возвращает отслеживаемые аудитом поля записи ленты.
+// Текст записи (травмы, жалобы) в журнал не попадает: по хэшу и длине видно,
+// что он изменился, но не что в нём написано.
+func TimelineEntryState(entry *models.TimelineEntry) map[string]interface{} {
+	if entry == nil {
+		return nil
+	}
+
+	digest := sha256.Sum256([]byte(entry.Body()))
+	state := map[string]interface{}{
+		"customer_id": entry.CustomerID().String(),
+		"type":        string(entry.Type()),
+		"body_sha256": hex.EncodeToString(digest[:]),
+		"body_length": len([]rune(entry.Body())),
+		"author_id":   entry.AuthorID(),
+		"visibility":  string(entry.Visibility()),
+		"pinned":      entry.Pinned(),
+	}
+	if !entry.DeletedAt().IsZero() {
+		state["deleted_at"] = entry.DeletedAt()
+	}
+
+	return state
+}
+
+// TimelineViewer возвращает инициатора запроса как читателя ленты.
+func TimelineViewer(ctx context.Context) models.TimelineViewer {
+	actor := ActorFromContext(ctx)
+	return models.TimelineViewer{ID: actor.ID, Role: actor.Role}
+}
@@ -0,0 +1,156 @@
+package dedup
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Веса признаков совпадения. Сумма равна 1, поэтому итоговый балл лежит в [0, 1].
+const (
+	phoneWeight     = 0.45
+	birthDateWeight = 0.25
+	nameWeight      = 0.30
+)
+
+// Profile содержит признаки клиента, по которым ищутся дубликаты.
+type Profile struct {
+	CustomerID      string
+	FullName        string
+	Email           string
+	PhoneNormalized string
+	BirthDate       time.Time
+}
+
+// Candidate описывает возможный дубликат и причины совпадения.
+type Candidate struct {
+	Profile
+	Score   float64
+	Reasons []string
+}
+
+// Score оценивает сходство двух профилей по телефону, дате рождения и имени.
+func Score(probe, other Profile) Candidate {
+	candidate := Candidate{Profile: other}
+
+	if probe.PhoneNormalized != "" && probe.PhoneNormalized == other.PhoneNormalized {
+		candidate.Score += phoneWeight
+		candidate.Reasons = append(candidate.Reasons, "phone")
+	}
+
+	if !probe.BirthDate.IsZero() && sameDate(probe.BirthDate, other.BirthDate) {
+		candidate.Score += birthDateWeight
+		candidate.Reasons = append(candidate.Reasons, "birth_date")
+	}
+
+	similarity := NameSimilarity(probe.FullName, other.FullName)
+	if similarity >= 0.85 {
+		candidate.Reasons = append(candidate.Reasons, "full_name")
+	}
+	candidate.Score += nameWeight * similarity
+
+	return candidate
+}
+
+// Rank оценивает кандидатов, отбрасывает сам probe и слабые совпадения
+// и возвращает не более limit лучших по убыванию балла.
+func Rank(probe Profile, others []Profile, minScore float64, limit int) []Candidate {
+	var ranked []Candidate
+	for _, other := range others {
+		if other.CustomerID == probe.CustomerID && probe.CustomerID != "" {
+			continue
+		}
+		if candidate := Score(probe, other); candidate.Score >= minScore {
+			ranked = append(ranked, candidate)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].Score > ranked[j].Score })
+
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// NameSimilarity возвращает сходство Джаро–Винклера для нормализованных имён.
+// Токены сортируются, чтобы «Doe John» совпадало с «John Doe».
+func NameSimilarity(a, b string) float64 {
+	return jaroWinkler(NormalizeName(a), NormalizeName(b))
+}
+
+// NormalizeName приводит имя к нижнему регистру, убирает пунктуацию и сортирует слова.
+func NormalizeName(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	sort.Strings(fields)
+	return strings.Join(fields, " ")
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func jaroWinkler(a, b string) float64 {
+	s1, s2 := []rune(a), []rune(b)
+	if len(s1) == 0 && len(s2) == 0 {
+		return 1
+	}
+	if len(s1) == 0 || len(s2) == 0 {
+		return 0
+	}
+
+	window := max(len(s1), len(s2))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	matched1 := make([]bool, len(s1))
+	matched2 := make([]bool, len(s2))
+	matches := 0
+
+	for i := range s1 {
+		lo := max(0, i-window)
+		hi := min(len(s2), i+window+1)
+		for j := lo; j < hi; j++ {
+			if matched2[j] || s1[i] != s2[j] {
+				continue
+			}
+			matched1[i], matched2[j] = true, true
+			matches++
+			break
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions, k := 0, 0
+	for i := range s1 {
+		if !matched1[i] {
+			continue
+		}
+		for !matched2[k] {
+			k++
+		}
+		if s1[i] != s2[k] {
+			transpositions++
+		}
+		k++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(s1)) + m/float64(len(s2)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(s1), len(s2)) && s1[prefix] == s2[prefix] {
+		prefix++
+	}
+
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
@@ -0,0 +1,49 @@
+package dedup
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		min  float64
+		max  float64
+	}{
+		{name: "identical", a: "John Doe", b: "john doe", min: 1, max: 1},
+		{name: "reordered", a: "Doe, John", b: "John Doe", min: 1, max: 1},
+		{name: "typo", a: "Jonh Doe", b: "John Doe", min: 0.9, max: 0.99},
+		{name: "different", a: "Alice Smith", b: "Bob Jones", min: 0, max: 0.6},
+		{name: "empty", a: "", b: "John", min: 0, max: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NameSimilarity(tt.a, tt.b)
+			if got < tt.min || got > tt.max {
+				t.Fatalf("similarity %q vs %q = %.3f, want [%.2f, %.2f]", tt.a, tt.b, got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	birth := time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC)
+	probe := Profile{CustomerID: "new", FullName: "Jonh Doe", PhoneNormalized: "1234567890", BirthDate: birth}
+
+	ranked := Rank(probe, []Profile{
+		{CustomerID: "new", FullName: "Jonh Doe", PhoneNormalized: "1234567890", BirthDate: birth},
+		{CustomerID: "weak", FullName: "Alice Smith", PhoneNormalized: "5550000", BirthDate: birth.AddDate(1, 0, 0)},
+		{CustomerID: "name-only", FullName: "John Doe", PhoneNormalized: "5550001", BirthDate: birth.AddDate(0, 0, 1)},
+		{CustomerID: "strong", FullName: "John Doe", PhoneNormalized: "1234567890", BirthDate: birth},
+	}, 0.5, 10)
+
+	if len(ranked) != 1 {
+		t.Fatalf("expected one candidate above threshold, got %+v", ranked)
+	}
+	if ranked[0].CustomerID != "strong" || len(ranked[0].Reasons) != 3 {
+		t.Fatalf("unexpected best candidate: %+v", ranked[0])
+	}
+}
@@ -0,0 +1,27 @@
+package events
+
+import "time"
+
+const (
+	TypeConsentChanged             Type = "customer.consent_changed"
+	TypeCustomerPreferencesUpdated Type = "customer.preferences_updated"
+)
+
+// ConsentChanged фиксирует согласие или отказ клиента от коммуникаций по каналу.
+type ConsentChanged struct {
+	CustomerID       string
+	Channel          string
+	Granted          bool
+	Source           string
+	LegalTextVersion string
+	OccurredAt       time.Time
+}
+
+// CustomerPreferencesUpdated фиксирует изменение предпочтений коммуникации.
+type CustomerPreferencesUpdated struct {
+	CustomerID       string
+	Language         string
+	Timezone         string
+	PreferredChannel string
+	OccurredAt       time.Time
+}
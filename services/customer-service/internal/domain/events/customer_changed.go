@@ -0,0 +1,24 @@
+package events
+
+import "time"
+
+// CustomerEmailChanged фиксирует смену email клиента.
+type CustomerEmailChanged struct {
+	CustomerID string
+	Email      string
+	OccurredAt time.Time
+}
+
+// CustomerPhoneNumberChanged фиксирует смену номера телефона клиента.
+type CustomerPhoneNumberChanged struct {
+	CustomerID  string
+	PhoneNumber string
+	OccurredAt  time.Time
+}
+
+// CustomerFullNameChanged фиксирует смену имени клиента.
+type CustomerFullNameChanged struct {
+	CustomerID string
+	FullName   string
+	OccurredAt time.Time
+}
@@ -0,0 +1,12 @@
+package events
+
+import "time"
+
+const TypeCustomerErased Type = "customer.erased"
+
+// CustomerErased закрывает поток клиента, чьи персональные данные удалены.
+// Событие не содержит персональных данных; предшествующие события потока удаляются.
+type CustomerErased struct {
+	CustomerID string
+	OccurredAt time.Time
+}
@@ -4,8 +4,11 @@ import "time"
 
 // CustomerRegistered описывает доменное событие регистрации клиента.
 type CustomerRegistered struct {
-	CustomerID string
-	Email      string
-	FullName   string
-	OccurredAt time.Time
+	CustomerID   string
+	Email        string
+	FullName     string
+	PhoneNumber  string
+	BirthDate    time.Time
+	ReferralCode string
+	OccurredAt   time.Time
 }
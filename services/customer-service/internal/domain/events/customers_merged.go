@@ -0,0 +1,11 @@
+package events
+
+import "time"
+
+// CustomersMerged сообщает, что дубликат MergedID объединён с клиентом SurvivorID.
+// Сервисы-потребители должны перенести ссылки с MergedID на SurvivorID.
+type CustomersMerged struct {
+	SurvivorID string
+	MergedID   string
+	OccurredAt time.Time
+}
@@ -0,0 +1,23 @@
+package events
+
+import "time"
+
+// Type идентифицирует тип события в потоке агрегата.
+type Type string
+
+const (
+	TypeCustomerRegistered         Type = "customer.registered"
+	TypeCustomerEmailChanged       Type = "customer.email_changed"
+	TypeCustomerPhoneNumberChanged Type = "customer.phone_number_changed"
+	TypeCustomerFullNameChanged    Type = "customer.full_name_changed"
+	TypeCustomersMerged            Type = "customer.merged"
+)
+
+// Envelope оборачивает доменное событие метаданными упорядоченного потока агрегата.
+type Envelope struct {
+	AggregateID string
+	Version     int
+	Type        Type
+	Payload     interface{}
+	OccurredAt  time.Time
+}
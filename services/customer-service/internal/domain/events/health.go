@@ -0,0 +1,29 @@
+package events
+
+import "time"
+
+const (
+	TypeQuestionnairePublished       Type = "health.questionnaire_published"
+	TypeHealthQuestionnaireSubmitted Type = "health.questionnaire_submitted"
+)
+
+// QuestionnairePublished фиксирует публикацию новой версии анкеты здоровья.
+type QuestionnairePublished struct {
+	QuestionnaireID    string
+	Code               string
+	Version            int
+	MinAcceptedVersion int
+	OccurredAt         time.Time
+}
+
+// HealthQuestionnaireSubmitted сообщает о новой анкете клиента. Ответы в событие
+// не попадают: потребителям достаточно производных флагов и срока действия.
+type HealthQuestionnaireSubmitted struct {
+	CustomerID   string
+	SubmissionID string
+	Code         string
+	Version      int
+	Flags        []string
+	ExpiresAt    time.Time
+	OccurredAt   time.Time
+}
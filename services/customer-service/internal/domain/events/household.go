@@ -0,0 +1,43 @@
+package events
+
+import "time"
+
+const (
+	TypeHouseholdCreated           Type = "household.created"
+	TypeHouseholdMemberAdded       Type = "household.member_added"
+	TypeHouseholdMemberRemoved     Type = "household.member_removed"
+	TypeHouseholdMemberTransferred Type = "household.member_transferred"
+)
+
+// HouseholdCreated фиксирует создание домохозяйства с основным плательщиком.
+type HouseholdCreated struct {
+	HouseholdID    string
+	Name           string
+	PrimaryPayerID string
+	OccurredAt     time.Time
+}
+
+// HouseholdMemberAdded фиксирует добавление клиента в домохозяйство.
+type HouseholdMemberAdded struct {
+	HouseholdID string
+	CustomerID  string
+	GuardianID  string
+	Minor       bool
+	OccurredAt  time.Time
+}
+
+// HouseholdMemberRemoved фиксирует исключение клиента из домохозяйства.
+type HouseholdMemberRemoved struct {
+	HouseholdID string
+	CustomerID  string
+	OccurredAt  time.Time
+}
+
+// HouseholdMemberTransferred фиксирует перевод клиента в другое домохозяйство.
+type HouseholdMemberTransferred struct {
+	FromHouseholdID string
+	ToHouseholdID   string
+	CustomerID      string
+	GuardianID      string
+	OccurredAt      time.Time
+}
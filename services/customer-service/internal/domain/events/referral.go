@@ -0,0 +1,14 @@
+package events
+
+import "time"
+
+const TypeCustomerReferred Type = "customer.referred"
+
+// CustomerReferred фиксирует, что клиент ReferredID пришёл по коду клиента ReferrerID.
+// Биллинг начисляет вознаграждение по этому событию.
+type CustomerReferred struct {
+	ReferrerID   string
+	ReferredID   string
+	ReferralCode string
+	OccurredAt   time.Time
+}
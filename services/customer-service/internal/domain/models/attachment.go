@@ -0,0 +1,132 @@
+package models
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+// DefaultAttachmentTypes перечисляет допустимые типы содержимого по видам вложений.
+// Тип определяется по содержимому файла, а не по заявленному клиентом.
+var DefaultAttachmentTypes = map[valueobjects.AttachmentKind][]string{
+	valueobjects.AttachmentKindPhoto:      {"image/jpeg", "image/png", "image/webp"},
+	valueobjects.AttachmentKindWaiver:     {"application/pdf", "image/jpeg", "image/png"},
+	valueobjects.AttachmentKindIDDocument: {"application/pdf", "image/jpeg", "image/png"},
+}
+
+// AttachmentPolicy ограничивает размер и типы загружаемых файлов.
+type AttachmentPolicy struct {
+	MaxSize      int64
+	AllowedTypes map[valueobjects.AttachmentKind][]string
+}
+
+// Allows сообщает, можно ли сохранить файл типа contentType как вложение вида kind.
+func (p AttachmentPolicy) Allows(kind valueobjects.AttachmentKind, contentType string) bool {
+	allowed := p.AllowedTypes
+	if allowed == nil {
+		allowed = DefaultAttachmentTypes
+	}
+	return containsRole(allowed[kind], contentType)
+}
+
+// Attachment — метаданные файла клиента; содержимое хранится в blob-хранилище по StorageKey.
+type Attachment struct {
+	id          uuid.UUID
+	customerID  uuid.UUID
+	kind        valueobjects.AttachmentKind
+	fileName    string
+	contentType string
+	size        int64
+	checksum    string
+	storageKey  string
+	uploadedBy  string
+	createdAt   time.Time
+}
+
+// NewAttachment проверяет загруженный файл по политике и создаёт метаданные.
+// checksum — SHA-256 содержимого в hex.
+func NewAttachment(id, customerID uuid.UUID, kind valueobjects.AttachmentKind, fileName, contentType string, size int64, checksum, storageKey, uploadedBy string, policy AttachmentPolicy, now time.Time) (*Attachment, error) {
+	if size <= 0 {
+		return nil, valueobjects.ErrEmptyAttachment
+	}
+	if policy.MaxSize > 0 && size > policy.MaxSize {
+		return nil, valueobjects.ErrAttachmentTooLarge
+	}
+	if !policy.Allows(kind, contentType) {
+		return nil, valueobjects.ErrUnsupportedContentType
+	}
+
+	return &Attachment{
+		id:          id,
+		customerID:  customerID,
+		kind:        kind,
+		fileName:    CleanFileName(fileName),
+		contentType: contentType,
+		size:        size,
+		checksum:    checksum,
+		storageKey:  storageKey,
+		uploadedBy:  uploadedBy,
+		createdAt:   now,
+	}, nil
+}
+
+// RehydrateAttachment восстанавливает метаданные из слоя хранения.
+func RehydrateAttachment(id, customerID uuid.UUID, kind valueobjects.AttachmentKind, fileName, contentType string, size int64, checksum, storageKey, uploadedBy string, createdAt time.Time) *Attachment {
+	return &Attachment{
+		id:          id,
+		customerID:  customerID,
+		kind:        kind,
+		fileName:    fileName,
+		contentType: contentType,
+		size:        size,
+		checksum:    checksum,
+		storageKey:  storageKey,
+		uploadedBy:  uploadedBy,
+		createdAt:   createdAt,
+	}
+}
+
+// CleanFileName убирает из имени файла путь и ограничивает длину.
+func CleanFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(strings.TrimSpace(name), `\`, "/"))
+	if name == "." || name == "/" || name == "" {
+		return "attachment"
+	}
+	if runes := []rune(name); len(runes) > 255 {
+		name = string(runes[len(runes)-255:])
+	}
+	return name
+}
+
+// ID возвращает идентификатор вложения.
+func (a *Attachment) ID() uuid.UUID { return a.id }
+
+// CustomerID возвращает клиента, к которому относится файл.
+func (a *Attachment) CustomerID() uuid.UUID { return a.customerID }
+
+// Kind возвращает вид вложения.
+func (a *Attachment) Kind() valueobjects.AttachmentKind { return a.kind }
+
+// FileName возвращает исходное имя файла.
+func (a *Attachment) FileName() string { return a.fileName }
+
+// ContentType возвращает тип содержимого, определённый по файлу.
+func (a *Attachment) ContentType() string { return a.contentType }
+
+// Size возвращает размер в байтах.
+func (a *Attachment) Size() int64 { return a.size }
+
+// Checksum возвращает SHA-256 содержимого в hex.
+func (a *Attachment) Checksum() string { return a.checksum }
+
+// StorageKey возвращает ключ объекта в blob-хранилище.
+func (a *Attachment) StorageKey() string { return a.storageKey }
+
+// UploadedBy возвращает идентификатор загрузившего сотрудника.
+func (a *Attachment) UploadedBy() string { return a.uploadedBy }
+
+// CreatedAt возвращает время загрузки.
+func (a *Attachment) CreatedAt() time.Time { return a.createdAt }
@@ -0,0 +1,171 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+// Consent описывает согласие клиента на коммуникации по одному каналу.
+type Consent struct {
+	Channel          valueobjects.Channel
+	Granted          bool
+	Source           string
+	LegalTextVersion string
+	ChangedAt        time.Time
+}
+
+// Preferences описывает предпочтения клиента по коммуникациям.
+type Preferences struct {
+	Language         valueobjects.Language
+	Timezone         valueobjects.Timezone
+	PreferredChannel valueobjects.Channel
+}
+
+// ContactableCustomer описывает клиента, с которым можно связаться по каналу.
+type ContactableCustomer struct {
+	ID          uuid.UUID
+	FullName    string
+	Email       string
+	PhoneNumber string
+	Language    string
+	Timezone    string
+}
+
+// ChangeConsent фиксирует согласие или отказ по каналу. Повтор текущего
+// статуса с той же версией юридического текста не создаёт событие.
+func (c *Customer) ChangeConsent(channel valueobjects.Channel, granted bool, source, legalTextVersion string) error {
+	if source == "" {
+		return valueobjects.ErrConsentSourceMissing
+	}
+	if granted && legalTextVersion == "" {
+		return valueobjects.ErrLegalTextMissing
+	}
+
+	if current, ok := c.consents[channel]; ok && current.Granted == granted && current.LegalTextVersion == legalTextVersion {
+		return nil
+	}
+
+	c.touch()
+	c.setConsent(Consent{
+		Channel:          channel,
+		Granted:          granted,
+		Source:           source,
+		LegalTextVersion: legalTextVersion,
+		ChangedAt:        c.updatedAt,
+	})
+	c.record(events.TypeConsentChanged, events.ConsentChanged{
+		CustomerID:       c.id.String(),
+		Channel:          string(channel),
+		Granted:          granted,
+		Source:           source,
+		LegalTextVersion: legalTextVersion,
+		OccurredAt:       c.updatedAt,
+	})
+	return nil
+}
+
+// UpdatePreferences изменяет язык, часовой пояс и предпочтительный канал.
+func (c *Customer) UpdatePreferences(preferences Preferences) {
+	if preferences == c.preferences {
+		return
+	}
+
+	c.preferences = preferences
+	c.touch()
+	c.record(events.TypeCustomerPreferencesUpdated, events.CustomerPreferencesUpdated{
+		CustomerID:       c.id.String(),
+		Language:         preferences.Language.String(),
+		Timezone:         preferences.Timezone.String(),
+		PreferredChannel: string(preferences.PreferredChannel),
+		OccurredAt:       c.updatedAt,
+	})
+}
+
+// WithContactSettings дополняет восстановленный из хранилища агрегат согласиями и предпочтениями.
+func (c *Customer) WithContactSettings(preferences Preferences, consents []Consent) *Customer {
+	c.preferences = preferences
+	for _, consent := range consents {
+		c.setConsent(consent)
+	}
+	return c
+}
+
+// Consents возвращает согласия клиента, упорядоченные по каналу.
+func (c *Customer) Consents() []Consent {
+	consents := make([]Consent, 0, len(c.consents))
+	for _, consent := range c.consents {
+		consents = append(consents, consent)
+	}
+	sort.Slice(consents, func(i, j int) bool { return consents[i].Channel < consents[j].Channel })
+	return consents
+}
+
+// Preferences возвращает предпочтения клиента по коммуникациям.
+func (c *Customer) Preferences() Preferences { return c.preferences }
+
+// IsContactable сообщает, дал ли клиент действующее согласие на канал.
+func (c *Customer) IsContactable(channel valueobjects.Channel) bool {
+	consent, ok := c.consents[channel]
+	return ok && consent.Granted && !c.IsMerged()
+}
+
+func (c *Customer) setConsent(consent Consent) {
+	if c.consents == nil {
+		c.consents = make(map[valueobjects.Channel]Consent)
+	}
+	c.consents[consent.Channel] = consent
+}
+
+func (c *Customer) applyConsentChanged(event events.ConsentChanged, occurredAt time.Time) error {
+	channel, err := valueobjects.ParseChannel(event.Channel)
+	if err != nil {
+		return err
+	}
+	c.setConsent(Consent{
+		Channel:          channel,
+		Granted:          event.Granted,
+		Source:           event.Source,
+		LegalTextVersion: event.LegalTextVersion,
+		ChangedAt:        occurredAt,
+	})
+	return nil
+}
+
+func (c *Customer) applyPreferencesUpdated(event events.CustomerPreferencesUpdated) error {
+	preferences, err := ParsePreferences(event.Language, event.Timezone, event.PreferredChannel)
+	if err != nil {
+		return err
+	}
+	c.preferences = preferences
+	return nil
+}
+
+// ParsePreferences валидирует предпочтения; пустые значения остаются незаданными.
+func ParsePreferences(language, timezone, preferredChannel string) (Preferences, error) {
+	var (
+		preferences Preferences
+		err         error
+	)
+
+	if language != "" {
+		if preferences.Language, err = valueobjects.NewLanguage(language); err != nil {
+			return Preferences{}, err
+		}
+	}
+	if timezone != "" {
+		if preferences.Timezone, err = valueobjects.NewTimezone(timezone); err != nil {
+			return Preferences{}, err
+		}
+	}
+	if preferredChannel != "" {
+		if preferences.PreferredChannel, err = valueobjects.ParseChannel(preferredChannel); err != nil {
+			return Preferences{}, err
+		}
+	}
+
+	return preferences, nil
+}
@@ -1,8 +1,10 @@
 package models
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
 	"github.com/google/uuid"
 )
@@ -17,6 +19,15 @@ type Customer struct {
 	createdAt   time.Time
 	updatedAt   time.Time
 	version     int
+	mergedInto  uuid.UUID
+	consents    map[valueobjects.Channel]Consent
+	preferences Preferences
+	pending     []events.Envelope
+
+	referralCode valueobjects.ReferralCode
+	referredBy   uuid.UUID
+
+	erasedAt time.Time
 }
 
 // NewCustomer создаёт нового клиента и валидирует входные данные.
@@ -25,18 +36,36 @@ func NewCustomer(fullName string, email valueobjects.Email, phone valueobjects.P
 		return nil, valueobjects.ErrEmptyFullName
 	}
 
+	referralCode, err := valueobjects.NewReferralCode()
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now().UTC()
 
-	return &Customer{
-		id:          uuid.New(),
-		email:       email,
-		fullName:    fullName,
-		birthDate:   birthDate,
-		phoneNumber: phone,
-		createdAt:   now,
-		updatedAt:   now,
-		version:     1,
-	}, nil
+	customer := &Customer{
+		id:           uuid.New(),
+		email:        email,
+		fullName:     fullName,
+		birthDate:    birthDate,
+		phoneNumber:  phone,
+		createdAt:    now,
+		updatedAt:    now,
+		version:      1,
+		referralCode: referralCode,
+	}
+
+	customer.record(events.TypeCustomerRegistered, events.CustomerRegistered{
+		CustomerID:   customer.id.String(),
+		Email:        email.String(),
+		FullName:     fullName,
+		PhoneNumber:  phone.String(),
+		BirthDate:    birthDate,
+		ReferralCode: referralCode.String(),
+		OccurredAt:   now,
+	})
+
+	return customer, nil
 }
 
 // RehydrateCustomer восстанавливает агрегат из слоя хранения.
@@ -55,23 +84,82 @@ func RehydrateCustomer(id uuid.UUID, email valueobjects.Email, fullName string,
 
 // UpdateEmail обновляет email и инкрементирует версию.
 func (c *Customer) UpdateEmail(email valueobjects.Email) {
+	if email == c.email {
+		return
+	}
 	c.email = email
 	c.touch()
+	c.record(events.TypeCustomerEmailChanged, events.CustomerEmailChanged{
+		CustomerID: c.id.String(),
+		Email:      email.String(),
+		OccurredAt: c.updatedAt,
+	})
 }
 
 // UpdatePhoneNumber обновляет номер телефона.
 func (c *Customer) UpdatePhoneNumber(phone valueobjects.PhoneNumber) {
+	if phone == c.phoneNumber {
+		return
+	}
 	c.phoneNumber = phone
 	c.touch()
+	c.record(events.TypeCustomerPhoneNumberChanged, events.CustomerPhoneNumberChanged{
+		CustomerID:  c.id.String(),
+		PhoneNumber: phone.String(),
+		OccurredAt:  c.updatedAt,
+	})
 }
 
 // UpdateFullName обновляет имя клиента.
 func (c *Customer) UpdateFullName(name string) {
-	if name == "" {
+	if name == "" || name == c.fullName {
 		return
 	}
 	c.fullName = name
 	c.touch()
+	c.record(events.TypeCustomerFullNameChanged, events.CustomerFullNameChanged{
+		CustomerID: c.id.String(),
+		FullName:   name,
+		OccurredAt: c.updatedAt,
+	})
+}
+
+// MergeInto помечает клиента как дубликат survivor. После слияния
+// идентификатор клиента перенаправляется на survivor, поэтому удалённый
+// клиент не может участвовать в слиянии: чтение по ссылке вернуло бы NotFound.
+func (c *Customer) MergeInto(survivor *Customer) error {
+	if survivor.id == c.id {
+		return valueobjects.ErrSelfMerge
+	}
+	if c.IsErased() || survivor.IsErased() {
+		return valueobjects.ErrCustomerErased
+	}
+	if c.IsMerged() || survivor.IsMerged() {
+		return valueobjects.ErrAlreadyMerged
+	}
+
+	c.mergedInto = survivor.id
+	c.touch()
+	c.record(events.TypeCustomersMerged, events.CustomersMerged{
+		SurvivorID: survivor.id.String(),
+		MergedID:   c.id.String(),
+		OccurredAt: c.updatedAt,
+	})
+	return nil
+}
+
+// Erase удаляет персональные данные клиента и закрывает поток событием
+// CustomerErased. Повторный вызов для удалённого клиента ничего не меняет.
+func (c *Customer) Erase() {
+	if c.IsErased() {
+		return
+	}
+	c.touch()
+	c.clearPersonalData(c.updatedAt)
+	c.record(events.TypeCustomerErased, events.CustomerErased{
+		CustomerID: c.id.String(),
+		OccurredAt: c.updatedAt,
+	})
 }
 
 // ID возвращает идентификатор клиента.
@@ -98,7 +186,120 @@ func (c *Customer) UpdatedAt() time.Time { return c.updatedAt }
 // Version возвращает текущую версию агрегата.
 func (c *Customer) Version() int { return c.version }
 
+// MergedInto возвращает идентификатор основного клиента, если этот клиент был слит.
+func (c *Customer) MergedInto() (uuid.UUID, bool) { return c.mergedInto, c.IsMerged() }
+
+// IsMerged сообщает, был ли клиент слит с другим.
+func (c *Customer) IsMerged() bool { return c.mergedInto != uuid.Nil }
+
+// ErasedAt возвращает время удаления персональных данных клиента.
+func (c *Customer) ErasedAt() (time.Time, bool) { return c.erasedAt, c.IsErased() }
+
+// IsErased сообщает, удалены ли персональные данные клиента.
+func (c *Customer) IsErased() bool { return !c.erasedAt.IsZero() }
+
+// PendingEvents возвращает события, ещё не сохранённые в поток агрегата.
+func (c *Customer) PendingEvents() []events.Envelope { return c.pending }
+
+// PersistedVersion возвращает версию агрегата на момент загрузки из хранилища.
+func (c *Customer) PersistedVersion() int { return c.version - len(c.pending) }
+
+// MarkEventsCommitted очищает список несохранённых событий после коммита.
+func (c *Customer) MarkEventsCommitted() { c.pending = nil }
+
+// Apply применяет событие из потока к состоянию агрегата при восстановлении.
+func (c *Customer) Apply(event events.Envelope) error {
+	switch payload := event.Payload.(type) {
+	case events.CustomerRegistered:
+		email, err := valueobjects.NewEmail(payload.Email)
+		if err != nil {
+			return err
+		}
+		phone, err := valueobjects.NewPhoneNumber(payload.PhoneNumber)
+		if err != nil {
+			return err
+		}
+		id, err := uuid.Parse(payload.CustomerID)
+		if err != nil {
+			return fmt.Errorf("customer event: invalid aggregate id: %w", err)
+		}
+		c.id = id
+		c.email = email
+		c.fullName = payload.FullName
+		c.phoneNumber = phone
+		c.birthDate = payload.BirthDate
+		c.createdAt = event.OccurredAt
+		// События, записанные до появления реферальной программы, кода не содержат.
+		if payload.ReferralCode != "" {
+			if c.referralCode, err = valueobjects.ParseReferralCode(payload.ReferralCode); err != nil {
+				return err
+			}
+		}
+	case events.CustomerEmailChanged:
+		email, err := valueobjects.NewEmail(payload.Email)
+		if err != nil {
+			return err
+		}
+		c.email = email
+	case events.CustomerPhoneNumberChanged:
+		phone, err := valueobjects.NewPhoneNumber(payload.PhoneNumber)
+		if err != nil {
+			return err
+		}
+		c.phoneNumber = phone
+	case events.CustomerFullNameChanged:
+		c.fullName = payload.FullName
+	case events.CustomersMerged:
+		survivorID, err := uuid.Parse(payload.SurvivorID)
+		if err != nil {
+			return fmt.Errorf("customer event: invalid survivor id: %w", err)
+		}
+		c.mergedInto = survivorID
+	case events.ConsentChanged:
+		if err := c.applyConsentChanged(payload, event.OccurredAt); err != nil {
+			return err
+		}
+	case events.CustomerPreferencesUpdated:
+		if err := c.applyPreferencesUpdated(payload); err != nil {
+			return err
+		}
+	case events.CustomerReferred:
+		if err := c.applyCustomerReferred(payload); err != nil {
+			return err
+		}
+	case events.CustomerErased:
+		c.clearPersonalData(event.OccurredAt)
+	default:
+		return fmt.Errorf("customer event: unsupported payload %T", event.Payload)
+	}
+
+	c.updatedAt = event.OccurredAt
+	c.version = event.Version
+	return nil
+}
+
+func (c *Customer) clearPersonalData(at time.Time) {
+	c.email = valueobjects.Email{}
+	c.fullName = ""
+	c.phoneNumber = valueobjects.PhoneNumber{}
+	c.birthDate = time.Time{}
+	c.consents = nil
+	c.preferences = Preferences{}
+	c.referredBy = uuid.Nil
+	c.erasedAt = at
+}
+
 func (c *Customer) touch() {
 	c.updatedAt = time.Now().UTC()
 	c.version++
 }
+
+func (c *Customer) record(eventType events.Type, payload interface{}) {
+	c.pending = append(c.pending, events.Envelope{
+		AggregateID: c.id.String(),
+		Version:     c.version,
+		Type:        eventType,
+		Payload:     payload,
+		OccurredAt:  c.updatedAt,
+	})
+}
@@ -0,0 +1,203 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+)
+
+func TestCustomerRecordsEvents(t *testing.T) {
+	customer := newTestCustomer(t)
+
+	customer.UpdatePhoneNumber(mustPhone(t, "+1987654321"))
+	customer.UpdateFullName(customer.FullName())
+
+	pending := customer.PendingEvents()
+	if len(pending) != 2 {
+		t.Fatalf("expected 2 pending events, got %d", len(pending))
+	}
+	if pending[0].Type != events.TypeCustomerRegistered || pending[0].Version != 1 {
+		t.Fatalf("unexpected first event: %+v", pending[0])
+	}
+	if pending[1].Type != events.TypeCustomerPhoneNumberChanged || pending[1].Version != 2 {
+		t.Fatalf("unexpected second event: %+v", pending[1])
+	}
+	if customer.PersistedVersion() != 0 {
+		t.Fatalf("expected new customer to have no persisted version, got %d", customer.PersistedVersion())
+	}
+}
+
+func TestReplayCustomer(t *testing.T) {
+	customer := newTestCustomer(t)
+	customer.UpdateEmail(mustEmail(t, "jane@example.com"))
+	customer.UpdateFullName("Jane Doe")
+
+	stream := customer.PendingEvents()
+
+	replayed, err := ReplayCustomer(nil, stream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if replayed.Email().String() != "jane@example.com" || replayed.FullName() != "Jane Doe" || replayed.Version() != 3 {
+		t.Fatalf("unexpected replayed state: %+v", replayed.Snapshot())
+	}
+
+	asOfRegistration, err := ReplayCustomer(nil, stream[:1])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if asOfRegistration.Email().String() != "john@example.com" {
+		t.Fatalf("expected original email, got %s", asOfRegistration.Email().String())
+	}
+
+	snapshot := asOfRegistration.Snapshot()
+	fromSnapshot, err := ReplayCustomer(&snapshot, stream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fromSnapshot.Snapshot(), replayed.Snapshot()) {
+		t.Fatalf("expected snapshot replay to match full replay")
+	}
+}
+
+func TestCustomerAttributeReferral(t *testing.T) {
+	referrer := newTestCustomer(t)
+	friend, err := NewCustomer("Jane Roe", mustEmail(t, "jane@example.com"), mustPhone(t, "+1987654321"), time.Date(1992, 1, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("new customer: %v", err)
+	}
+
+	if err := referrer.AttributeReferral(referrer); !errors.Is(err, valueobjects.ErrSelfReferral) {
+		t.Fatalf("expected self-referral error, got %v", err)
+	}
+
+	twin, err := NewCustomer("John Doe", mustEmail(t, "other@example.com"), mustPhone(t, "+1 234 567 890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("new customer: %v", err)
+	}
+	if err := twin.AttributeReferral(referrer); !errors.Is(err, valueobjects.ErrSelfReferral) {
+		t.Fatalf("expected self-referral by phone to be rejected, got %v", err)
+	}
+
+	if err := friend.AttributeReferral(referrer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := friend.AttributeReferral(referrer); !errors.Is(err, valueobjects.ErrAlreadyReferred) {
+		t.Fatalf("expected already referred error, got %v", err)
+	}
+
+	replayed, err := ReplayCustomer(nil, friend.PendingEvents())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	referredBy, ok := replayed.ReferredBy()
+	if !ok || referredBy != referrer.ID() || replayed.ReferralCode() != friend.ReferralCode() {
+		t.Fatalf("expected referral to survive replay, got %v %v", referredBy, replayed.ReferralCode())
+	}
+}
+
+func TestCustomerRegenerateReferralCode(t *testing.T) {
+	customer := newTestCustomer(t)
+	previous := customer.ReferralCode()
+
+	if err := customer.RegenerateReferralCode(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if customer.ReferralCode() == previous {
+		t.Fatalf("expected a new referral code")
+	}
+
+	replayed, err := ReplayCustomer(nil, customer.PendingEvents())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if replayed.ReferralCode() != customer.ReferralCode() {
+		t.Fatalf("expected registration event to carry the new code, got %v", replayed.ReferralCode())
+	}
+
+	customer.MarkEventsCommitted()
+	if err := customer.RegenerateReferralCode(); !errors.Is(err, valueobjects.ErrReferralCodeFixed) {
+		t.Fatalf("expected saved customer code to be fixed, got %v", err)
+	}
+}
+
+func TestCustomerErase(t *testing.T) {
+	customer := newTestCustomer(t)
+	customer.MarkEventsCommitted()
+
+	customer.Erase()
+	customer.Erase()
+
+	pending := customer.PendingEvents()
+	if len(pending) != 1 || pending[0].Type != events.TypeCustomerErased || pending[0].Version != 2 {
+		t.Fatalf("expected a single tombstone event, got %+v", pending)
+	}
+	if !customer.IsErased() || customer.Email().String() != "" || customer.FullName() != "" || customer.PhoneNumber().String() != "" || !customer.BirthDate().IsZero() {
+		t.Fatalf("expected personal data to be cleared, got %+v", customer.Snapshot())
+	}
+
+	snapshot := customer.Snapshot()
+	restored, err := ReplayCustomer(&snapshot, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !restored.IsErased() || restored.ReferralCode() != customer.ReferralCode() {
+		t.Fatalf("expected erased snapshot to restore, got %+v", restored.Snapshot())
+	}
+}
+
+func TestCustomerMergeIntoErased(t *testing.T) {
+	erased := newTestCustomer(t)
+	erased.Erase()
+	erased.MarkEventsCommitted()
+	active := newTestCustomer(t)
+	active.MarkEventsCommitted()
+
+	if err := active.MergeInto(erased); !errors.Is(err, valueobjects.ErrCustomerErased) {
+		t.Fatalf("expected merge into erased survivor to fail, got %v", err)
+	}
+	if err := erased.MergeInto(active); !errors.Is(err, valueobjects.ErrCustomerErased) {
+		t.Fatalf("expected merge of erased duplicate to fail, got %v", err)
+	}
+	if active.IsMerged() || erased.IsMerged() || len(active.PendingEvents()) != 0 {
+		t.Fatalf("expected rejected merge to leave both customers unchanged")
+	}
+}
+
+func TestReplayCustomerEmpty(t *testing.T) {
+	customer, err := ReplayCustomer(nil, nil)
+	if err != nil || customer != nil {
+		t.Fatalf("expected nil customer for empty stream, got %v, %v", customer, err)
+	}
+}
+
+func newTestCustomer(t *testing.T) *Customer {
+	t.Helper()
+	customer, err := NewCustomer("John Doe", mustEmail(t, "john@example.com"), mustPhone(t, "+1234567890"), time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("new customer: %v", err)
+	}
+	return customer
+}
+
+func mustEmail(t *testing.T, val string) valueobjects.Email {
+	t.Helper()
+	email, err := valueobjects.NewEmail(val)
+	if err != nil {
+		t.Fatalf("email: %v", err)
+	}
+	return email
+}
+
+func mustPhone(t *testing.T, val string) valueobjects.PhoneNumber {
+	t.Helper()
+	phone, err := valueobjects.NewPhoneNumber(val)
+	if err != nil {
+		t.Fatalf("phone: %v", err)
+	}
+	return phone
+}
@@ -0,0 +1,272 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+const (
+	// FlagRequiresDoctorClearance — типовой производный флаг: перед услугой нужен допуск врача.
+	FlagRequiresDoctorClearance = "requires_doctor_clearance"
+	// CustomerRole — роль, с которой клиент действует от своего имени.
+	CustomerRole = "customer"
+)
+
+// QuestionKind задаёт формат ответа на вопрос анкеты.
+type QuestionKind string
+
+const (
+	// QuestionYesNo принимает ответы "yes" и "no"; ответ "yes" поднимает флаги вопроса.
+	QuestionYesNo QuestionKind = "yes_no"
+	// QuestionText принимает произвольный текст, например описание противопоказаний.
+	QuestionText QuestionKind = "text"
+)
+
+// Question описывает вопрос анкеты здоровья.
+type Question struct {
+	ID         string       `json:"id"`
+	Text       string       `json:"text"`
+	Kind       QuestionKind `json:"kind"`
+	Required   bool         `json:"required"`
+	FlagsOnYes []string     `json:"flags_on_yes,omitempty"`
+}
+
+// QuestionnaireDefinition — опубликованная версия анкеты (PAR-Q и аналоги).
+// MinAcceptedVersion — младшая версия, заполненная анкета которой ещё действует:
+// версия с обязательным переподписанием поднимает её до своего номера.
+type QuestionnaireDefinition struct {
+	ID                 uuid.UUID
+	Code               string
+	Version            int
+	Title              string
+	Questions          []Question
+	ValidFor           time.Duration
+	MinAcceptedVersion int
+	PublishedAt        time.Time
+}
+
+// NewQuestionnaireDefinition публикует следующую версию анкеты code. previous —
+// текущая версия или nil для первой публикации.
+func NewQuestionnaireDefinition(code, title string, questions []Question, validFor time.Duration, requireReconsent bool, previous *QuestionnaireDefinition, now time.Time) (*QuestionnaireDefinition, error) {
+	code = strings.TrimSpace(code)
+	if code == "" || len(questions) == 0 || validFor <= 0 {
+		return nil, valueobjects.ErrInvalidQuestionnaire
+	}
+
+	seen := make(map[string]bool, len(questions))
+	for _, question := range questions {
+		if question.ID == "" || seen[question.ID] || strings.TrimSpace(question.Text) == "" {
+			return nil, valueobjects.ErrInvalidQuestionnaire
+		}
+		if question.Kind != QuestionYesNo && question.Kind != QuestionText {
+			return nil, valueobjects.ErrInvalidQuestionnaire
+		}
+		if question.Kind != QuestionYesNo && len(question.FlagsOnYes) > 0 {
+			return nil, valueobjects.ErrInvalidQuestionnaire
+		}
+		seen[question.ID] = true
+	}
+
+	definition := &QuestionnaireDefinition{
+		ID:                 uuid.New(),
+		Code:               code,
+		Version:            1,
+		Title:              title,
+		Questions:          questions,
+		ValidFor:           validFor,
+		MinAcceptedVersion: 1,
+		PublishedAt:        now,
+	}
+	if previous != nil {
+		definition.Version = previous.Version + 1
+		definition.MinAcceptedVersion = previous.MinAcceptedVersion
+	}
+	if requireReconsent {
+		definition.MinAcceptedVersion = definition.Version
+	}
+
+	return definition, nil
+}
+
+// Signature фиксирует подпись клиента под анкетой.
+type Signature struct {
+	SignerName string    `json:"signer_name"`
+	Data       string    `json:"data"`
+	SignedAt   time.Time `json:"signed_at"`
+}
+
+// HealthStatus описывает актуальность анкеты клиента.
+type HealthStatus string
+
+const (
+	HealthStatusValid    HealthStatus = "valid"
+	HealthStatusMissing  HealthStatus = "missing"
+	HealthStatusExpired  HealthStatus = "expired"
+	HealthStatusOutdated HealthStatus = "outdated"
+)
+
+// HealthSubmission — заполненная и подписанная клиентом анкета.
+// Ответы и подпись — медицинские данные; флаги выводятся из ответов.
+type HealthSubmission struct {
+	id                uuid.UUID
+	customerID        uuid.UUID
+	definitionID      uuid.UUID
+	questionnaireCode string
+	version           int
+	answers           map[string]string
+	signature         Signature
+	flags             []string
+	submittedAt       time.Time
+	expiresAt         time.Time
+}
+
+// NewHealthSubmission проверяет ответы по определению анкеты и выводит флаги.
+func NewHealthSubmission(customerID uuid.UUID, definition *QuestionnaireDefinition, answers map[string]string, signature Signature, now time.Time) (*HealthSubmission, error) {
+	if strings.TrimSpace(signature.SignerName) == "" || strings.TrimSpace(signature.Data) == "" {
+		return nil, valueobjects.ErrSignatureRequired
+	}
+	if signature.SignedAt.IsZero() {
+		signature.SignedAt = now
+	}
+
+	questions := make(map[string]Question, len(definition.Questions))
+	for _, question := range definition.Questions {
+		questions[question.ID] = question
+	}
+
+	normalized := make(map[string]string, len(answers))
+	flags := make(map[string]bool)
+	for id, raw := range answers {
+		question, ok := questions[id]
+		if !ok {
+			return nil, valueobjects.ErrUnknownQuestion
+		}
+
+		answer := strings.TrimSpace(raw)
+		if question.Kind == QuestionYesNo {
+			answer = strings.ToLower(answer)
+			if answer != "yes" && answer != "no" {
+				return nil, valueobjects.ErrInvalidAnswer
+			}
+			if answer == "yes" {
+				for _, flag := range question.FlagsOnYes {
+					flags[flag] = true
+				}
+			}
+		}
+		if answer != "" {
+			normalized[id] = answer
+		}
+	}
+
+	for _, question := range definition.Questions {
+		if _, ok := normalized[question.ID]; question.Required && !ok {
+			return nil, valueobjects.ErrMissingAnswer
+		}
+	}
+
+	derived := make([]string, 0, len(flags))
+	for flag := range flags {
+		derived = append(derived, flag)
+	}
+	sort.Strings(derived)
+
+	return &HealthSubmission{
+		id:                uuid.New(),
+		customerID:        customerID,
+		definitionID:      definition.ID,
+		questionnaireCode: definition.Code,
+		version:           definition.Version,
+		answers:           normalized,
+		signature:         signature,
+		flags:             derived,
+		submittedAt:       now,
+		expiresAt:         now.Add(definition.ValidFor),
+	}, nil
+}
+
+// RehydrateHealthSubmission восстанавливает анкету из слоя хранения.
+func RehydrateHealthSubmission(id, customerID, definitionID uuid.UUID, code string, version int, answers map[string]string, signature Signature, flags []string, submittedAt, expiresAt time.Time) *HealthSubmission {
+	return &HealthSubmission{
+		id:                id,
+		customerID:        customerID,
+		definitionID:      definitionID,
+		questionnaireCode: code,
+		version:           version,
+		answers:           answers,
+		signature:         signature,
+		flags:             flags,
+		submittedAt:       submittedAt,
+		expiresAt:         expiresAt,
+	}
+}
+
+// Status сообщает, действует ли анкета относительно текущей версии определения.
+func (s *HealthSubmission) Status(current *QuestionnaireDefinition, now time.Time) HealthStatus {
+	if s == nil {
+		return HealthStatusMissing
+	}
+	if current != nil && s.version < current.MinAcceptedVersion {
+		return HealthStatusOutdated
+	}
+	if !now.Before(s.expiresAt) {
+		return HealthStatusExpired
+	}
+	return HealthStatusValid
+}
+
+// ID возвращает идентификатор анкеты.
+func (s *HealthSubmission) ID() uuid.UUID { return s.id }
+
+// CustomerID возвращает клиента, заполнившего анкету.
+func (s *HealthSubmission) CustomerID() uuid.UUID { return s.customerID }
+
+// DefinitionID возвращает идентификатор версии определения.
+func (s *HealthSubmission) DefinitionID() uuid.UUID { return s.definitionID }
+
+// QuestionnaireCode возвращает код анкеты.
+func (s *HealthSubmission) QuestionnaireCode() string { return s.questionnaireCode }
+
+// Version возвращает версию определения, по которой заполнена анкета.
+func (s *HealthSubmission) Version() int { return s.version }
+
+// Answers возвращает копию ответов.
+func (s *HealthSubmission) Answers() map[string]string {
+	answers := make(map[string]string, len(s.answers))
+	for id, answer := range s.answers {
+		answers[id] = answer
+	}
+	return answers
+}
+
+// Signature возвращает подпись клиента.
+func (s *HealthSubmission) Signature() Signature { return s.signature }
+
+// Flags возвращает производные флаги, отсортированные по имени.
+func (s *HealthSubmission) Flags() []string { return append([]string(nil), s.flags...) }
+
+// SubmittedAt возвращает время заполнения.
+func (s *HealthSubmission) SubmittedAt() time.Time { return s.submittedAt }
+
+// ExpiresAt возвращает время, после которого анкету нужно заполнить заново.
+func (s *HealthSubmission) ExpiresAt() time.Time { return s.expiresAt }
+
+// HealthAccessPolicy ограничивает доступ к медицинским данным.
+type HealthAccessPolicy struct {
+	MedicalRoles []string
+}
+
+// CanReadRecords сообщает, что роль может видеть ответы и подписи.
+func (p HealthAccessPolicy) CanReadRecords(role string) bool {
+	return containsRole(p.MedicalRoles, role)
+}
+
+// CanSubmit сообщает, что инициатор может подать анкету за клиента:
+// это медицинский сотрудник либо сам клиент.
+func (p HealthAccessPolicy) CanSubmit(actorID, role string, customerID uuid.UUID) bool {
+	return p.CanReadRecords(role) || (role == CustomerRole && actorID == customerID.String())
+}
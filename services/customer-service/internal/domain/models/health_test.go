@@ -0,0 +1,77 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+func testQuestions() []Question {
+	return []Question{
+		{ID: "heart", Text: "Heart condition?", Kind: QuestionYesNo, Required: true, FlagsOnYes: []string{FlagRequiresDoctorClearance}},
+		{ID: "pregnant", Text: "Pregnant?", Kind: QuestionYesNo, FlagsOnYes: []string{"no_hot_procedures"}},
+		{ID: "notes", Text: "Other contraindications", Kind: QuestionText},
+	}
+}
+
+func TestHealthSubmissionDerivesFlags(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	definition, err := NewQuestionnaireDefinition("par-q", "PAR-Q", testQuestions(), 365*24*time.Hour, false, nil, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	signature := Signature{SignerName: "John Doe", Data: "typed:John Doe"}
+
+	if _, err := NewHealthSubmission(uuid.New(), definition, map[string]string{"pregnant": "no"}, signature, now); !errors.Is(err, valueobjects.ErrMissingAnswer) {
+		t.Fatalf("expected missing answer error, got %v", err)
+	}
+	if _, err := NewHealthSubmission(uuid.New(), definition, map[string]string{"heart": "maybe"}, signature, now); !errors.Is(err, valueobjects.ErrInvalidAnswer) {
+		t.Fatalf("expected invalid answer error, got %v", err)
+	}
+	if _, err := NewHealthSubmission(uuid.New(), definition, map[string]string{"heart": "no"}, Signature{}, now); !errors.Is(err, valueobjects.ErrSignatureRequired) {
+		t.Fatalf("expected signature error, got %v", err)
+	}
+
+	submission, err := NewHealthSubmission(uuid.New(), definition, map[string]string{"heart": "Yes", "pregnant": "yes"}, signature, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"no_hot_procedures", FlagRequiresDoctorClearance}; !reflect.DeepEqual(submission.Flags(), want) {
+		t.Fatalf("expected flags %v, got %v", want, submission.Flags())
+	}
+	if !submission.ExpiresAt().Equal(now.AddDate(1, 0, 0)) {
+		t.Fatalf("unexpected expiry: %v", submission.ExpiresAt())
+	}
+}
+
+func TestHealthSubmissionStatus(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	v1, _ := NewQuestionnaireDefinition("par-q", "PAR-Q", testQuestions(), 30*24*time.Hour, false, nil, now)
+	submission, err := NewHealthSubmission(uuid.New(), v1, map[string]string{"heart": "no"}, Signature{SignerName: "John", Data: "sig"}, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	v2, _ := NewQuestionnaireDefinition("par-q", "PAR-Q", testQuestions(), 30*24*time.Hour, false, v1, now)
+	if status := submission.Status(v2, now.Add(time.Hour)); status != HealthStatusValid {
+		t.Fatalf("expected minor revision to keep submission valid, got %s", status)
+	}
+	if status := submission.Status(v2, now.AddDate(0, 1, 0)); status != HealthStatusExpired {
+		t.Fatalf("expected expired status, got %s", status)
+	}
+
+	v3, _ := NewQuestionnaireDefinition("par-q", "PAR-Q", testQuestions(), 30*24*time.Hour, true, v2, now)
+	v4, _ := NewQuestionnaireDefinition("par-q", "PAR-Q", testQuestions(), 30*24*time.Hour, false, v3, now)
+	if status := submission.Status(v4, now.Add(time.Hour)); status != HealthStatusOutdated || v4.MinAcceptedVersion != 3 {
+		t.Fatalf("expected re-consent to outlive later revisions, got %s (min %d)", status, v4.MinAcceptedVersion)
+	}
+
+	var missing *HealthSubmission
+	if status := missing.Status(v4, now); status != HealthStatusMissing {
+		t.Fatalf("expected missing status, got %s", status)
+	}
+}
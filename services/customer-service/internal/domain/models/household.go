@@ -0,0 +1,289 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+// GuardianPolicy определяет, с какого возраста клиенту не нужен опекун.
+type GuardianPolicy struct {
+	AdultAge int
+}
+
+// IsMinor сообщает, что клиенту с датой рождения birthDate на момент now нужен опекун.
+func (p GuardianPolicy) IsMinor(birthDate, now time.Time) bool {
+	if birthDate.IsZero() {
+		return false
+	}
+	return now.Before(birthDate.AddDate(p.AdultAge, 0, 0))
+}
+
+// HouseholdMember описывает участника домохозяйства. Minor фиксируется при
+// вступлении; проверки опекунства пересчитывают его по BirthDate.
+type HouseholdMember struct {
+	CustomerID uuid.UUID
+	GuardianID uuid.UUID
+	Minor      bool
+	BirthDate  time.Time
+	JoinedAt   time.Time
+}
+
+// minorAt сообщает, нужен ли участнику опекун на момент now. Без даты
+// рождения (например, у удалённого клиента) используется признак при вступлении.
+func (m HouseholdMember) minorAt(policy GuardianPolicy, now time.Time) bool {
+	if m.BirthDate.IsZero() {
+		return m.Minor
+	}
+	return policy.IsMinor(m.BirthDate, now)
+}
+
+// Household отражает семейное членство с основным плательщиком и участниками.
+type Household struct {
+	id             uuid.UUID
+	name           string
+	primaryPayerID uuid.UUID
+	members        map[uuid.UUID]HouseholdMember
+	createdAt      time.Time
+	updatedAt      time.Time
+	version        int
+	persisted      int
+	pending        []events.Envelope
+}
+
+// NewHousehold создаёт домохозяйство, где payer становится основным плательщиком.
+func NewHousehold(name string, payer *Customer, policy GuardianPolicy, now time.Time) (*Household, error) {
+	if policy.IsMinor(payer.BirthDate(), now) {
+		return nil, valueobjects.ErrPayerMustBeAdult
+	}
+
+	h := &Household{
+		id:             uuid.New(),
+		name:           name,
+		primaryPayerID: payer.ID(),
+		members: map[uuid.UUID]HouseholdMember{
+			payer.ID(): {CustomerID: payer.ID(), BirthDate: payer.BirthDate(), JoinedAt: now},
+		},
+		createdAt: now,
+		updatedAt: now,
+		version:   1,
+	}
+
+	h.record(events.TypeHouseholdCreated, events.HouseholdCreated{
+		HouseholdID:    h.id.String(),
+		Name:           name,
+		PrimaryPayerID: payer.ID().String(),
+		OccurredAt:     now,
+	})
+
+	return h, nil
+}
+
+// RehydrateHousehold восстанавливает агрегат из слоя хранения.
+func RehydrateHousehold(id uuid.UUID, name string, primaryPayerID uuid.UUID, members []HouseholdMember, createdAt, updatedAt time.Time, version int) *Household {
+	h := &Household{
+		id:             id,
+		name:           name,
+		primaryPayerID: primaryPayerID,
+		members:        make(map[uuid.UUID]HouseholdMember, len(members)),
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+		version:        version,
+		persisted:      version,
+	}
+	for _, member := range members {
+		h.members[member.CustomerID] = member
+	}
+	return h
+}
+
+// AddMember добавляет клиента. Несовершеннолетнему нужен опекун —
+// взрослый участник этого же домохозяйства.
+func (h *Household) AddMember(customer *Customer, guardianID uuid.UUID, policy GuardianPolicy, now time.Time) error {
+	member, err := h.admit(customer, guardianID, policy, now)
+	if err != nil {
+		return err
+	}
+
+	h.touch(now)
+	h.record(events.TypeHouseholdMemberAdded, events.HouseholdMemberAdded{
+		HouseholdID: h.id.String(),
+		CustomerID:  member.CustomerID.String(),
+		GuardianID:  uuidString(member.GuardianID),
+		Minor:       member.Minor,
+		OccurredAt:  now,
+	})
+	return nil
+}
+
+// RemoveMember исключает клиента из домохозяйства.
+func (h *Household) RemoveMember(customerID uuid.UUID, policy GuardianPolicy, now time.Time) error {
+	if err := h.release(customerID, policy, now); err != nil {
+		return err
+	}
+
+	h.touch(now)
+	h.record(events.TypeHouseholdMemberRemoved, events.HouseholdMemberRemoved{
+		HouseholdID: h.id.String(),
+		CustomerID:  customerID.String(),
+		OccurredAt:  now,
+	})
+	return nil
+}
+
+// TransferMember переводит клиента в домохозяйство target. guardianID
+// задаёт опекуна в новом домохозяйстве, если клиент несовершеннолетний.
+func (h *Household) TransferMember(customer *Customer, target *Household, guardianID uuid.UUID, policy GuardianPolicy, now time.Time) error {
+	if target.id == h.id {
+		return valueobjects.ErrAlreadyHouseholdMember
+	}
+	if _, ok := h.members[customer.ID()]; !ok {
+		return valueobjects.ErrNotHouseholdMember
+	}
+	if err := h.canRelease(customer.ID(), policy, now); err != nil {
+		return err
+	}
+
+	member, err := target.admit(customer, guardianID, policy, now)
+	if err != nil {
+		return err
+	}
+	delete(h.members, customer.ID())
+
+	h.touch(now)
+	target.touch(now)
+
+	h.record(events.TypeHouseholdMemberTransferred, events.HouseholdMemberTransferred{
+		FromHouseholdID: h.id.String(),
+		ToHouseholdID:   target.id.String(),
+		CustomerID:      customer.ID().String(),
+		GuardianID:      uuidString(member.GuardianID),
+		OccurredAt:      now,
+	})
+	// Целевое домохозяйство получает собственное событие, чтобы его поток и
+	// проекции видели нового участника без разбора чужого агрегата.
+	target.record(events.TypeHouseholdMemberAdded, events.HouseholdMemberAdded{
+		HouseholdID: target.id.String(),
+		CustomerID:  member.CustomerID.String(),
+		GuardianID:  uuidString(member.GuardianID),
+		Minor:       member.Minor,
+		OccurredAt:  now,
+	})
+	return nil
+}
+
+// ID возвращает идентификатор домохозяйства.
+func (h *Household) ID() uuid.UUID { return h.id }
+
+// Name возвращает название домохозяйства.
+func (h *Household) Name() string { return h.name }
+
+// PrimaryPayerID возвращает идентификатор основного плательщика.
+func (h *Household) PrimaryPayerID() uuid.UUID { return h.primaryPayerID }
+
+// Members возвращает участников в порядке вступления.
+func (h *Household) Members() []HouseholdMember {
+	members := make([]HouseholdMember, 0, len(h.members))
+	for _, member := range h.members {
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].JoinedAt.Equal(members[j].JoinedAt) {
+			return members[i].CustomerID.String() < members[j].CustomerID.String()
+		}
+		return members[i].JoinedAt.Before(members[j].JoinedAt)
+	})
+	return members
+}
+
+// CreatedAt возвращает время создания домохозяйства.
+func (h *Household) CreatedAt() time.Time { return h.createdAt }
+
+// UpdatedAt возвращает время последнего изменения.
+func (h *Household) UpdatedAt() time.Time { return h.updatedAt }
+
+// Version возвращает текущую версию агрегата.
+func (h *Household) Version() int { return h.version }
+
+// PersistedVersion возвращает версию агрегата на момент загрузки из хранилища.
+func (h *Household) PersistedVersion() int { return h.persisted }
+
+// PendingEvents возвращает события, ещё не опубликованные наружу.
+func (h *Household) PendingEvents() []events.Envelope { return h.pending }
+
+// MarkEventsCommitted очищает список неопубликованных событий.
+func (h *Household) MarkEventsCommitted() {
+	h.pending = nil
+	h.persisted = h.version
+}
+
+func (h *Household) admit(customer *Customer, guardianID uuid.UUID, policy GuardianPolicy, now time.Time) (HouseholdMember, error) {
+	if _, ok := h.members[customer.ID()]; ok {
+		return HouseholdMember{}, valueobjects.ErrAlreadyHouseholdMember
+	}
+
+	member := HouseholdMember{CustomerID: customer.ID(), BirthDate: customer.BirthDate(), JoinedAt: now}
+
+	if policy.IsMinor(customer.BirthDate(), now) {
+		if guardianID == uuid.Nil {
+			return HouseholdMember{}, valueobjects.ErrGuardianRequired
+		}
+		guardian, ok := h.members[guardianID]
+		if !ok || guardian.minorAt(policy, now) {
+			return HouseholdMember{}, valueobjects.ErrInvalidGuardian
+		}
+		member.Minor = true
+		member.GuardianID = guardianID
+	}
+
+	h.members[member.CustomerID] = member
+	return member, nil
+}
+
+func (h *Household) release(customerID uuid.UUID, policy GuardianPolicy, now time.Time) error {
+	if _, ok := h.members[customerID]; !ok {
+		return valueobjects.ErrNotHouseholdMember
+	}
+	if err := h.canRelease(customerID, policy, now); err != nil {
+		return err
+	}
+	delete(h.members, customerID)
+	return nil
+}
+
+func (h *Household) canRelease(customerID uuid.UUID, policy GuardianPolicy, now time.Time) error {
+	if customerID == h.primaryPayerID {
+		return valueobjects.ErrPrimaryPayerRemoval
+	}
+	for _, member := range h.members {
+		if member.GuardianID == customerID && member.minorAt(policy, now) {
+			return valueobjects.ErrGuardianHasDependents
+		}
+	}
+	return nil
+}
+
+func (h *Household) touch(now time.Time) {
+	h.updatedAt = now
+	h.version++
+}
+
+func (h *Household) record(eventType events.Type, payload interface{}) {
+	h.pending = append(h.pending, events.Envelope{
+		AggregateID: h.id.String(),
+		Version:     h.version,
+		Type:        eventType,
+		Payload:     payload,
+		OccurredAt:  h.updatedAt,
+	})
+}
+
+func uuidString(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+var testPolicy = GuardianPolicy{AdultAge: 18}
+
+func TestHouseholdGuardianRules(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	parent := newCustomerBornAt(t, "Parent", time.Date(1985, 1, 1, 0, 0, 0, 0, time.UTC))
+	child := newCustomerBornAt(t, "Child", time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	teen := newCustomerBornAt(t, "Teen", time.Date(2008, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	if _, err := NewHousehold("Minors", child, testPolicy, now); !errors.Is(err, valueobjects.ErrPayerMustBeAdult) {
+		t.Fatalf("expected minor payer to be rejected, got %v", err)
+	}
+
+	household, err := NewHousehold("Doe family", parent, testPolicy, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := household.AddMember(child, uuid.Nil, testPolicy, now); !errors.Is(err, valueobjects.ErrGuardianRequired) {
+		t.Fatalf("expected guardian to be required, got %v", err)
+	}
+	if err := household.AddMember(child, uuid.New(), testPolicy, now); !errors.Is(err, valueobjects.ErrInvalidGuardian) {
+		t.Fatalf("expected outside guardian to be rejected, got %v", err)
+	}
+	if err := household.AddMember(child, parent.ID(), testPolicy, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := household.AddMember(teen, child.ID(), testPolicy, now); !errors.Is(err, valueobjects.ErrInvalidGuardian) {
+		t.Fatalf("expected minor guardian to be rejected, got %v", err)
+	}
+
+	if err := household.RemoveMember(parent.ID(), testPolicy, now); !errors.Is(err, valueobjects.ErrPrimaryPayerRemoval) {
+		t.Fatalf("expected primary payer removal to be rejected, got %v", err)
+	}
+
+	pending := household.PendingEvents()
+	if len(pending) != 2 || pending[1].Type != events.TypeHouseholdMemberAdded || household.Version() != 2 {
+		t.Fatalf("unexpected events: %+v", pending)
+	}
+}
+
+func TestHouseholdTransferMember(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	parent := newCustomerBornAt(t, "Parent", time.Date(1985, 1, 1, 0, 0, 0, 0, time.UTC))
+	otherParent := newCustomerBornAt(t, "Other Parent", time.Date(1986, 1, 1, 0, 0, 0, 0, time.UTC))
+	child := newCustomerBornAt(t, "Child", time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	source, _ := NewHousehold("Source", parent, testPolicy, now)
+	target, _ := NewHousehold("Target", otherParent, testPolicy, now)
+	if err := source.AddMember(child, parent.ID(), testPolicy, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	source.MarkEventsCommitted()
+	target.MarkEventsCommitted()
+
+	if err := source.TransferMember(child, target, uuid.Nil, testPolicy, now); !errors.Is(err, valueobjects.ErrGuardianRequired) {
+		t.Fatalf("expected guardian in target household, got %v", err)
+	}
+	if err := source.TransferMember(child, target, otherParent.ID(), testPolicy, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(source.Members()) != 1 || len(target.Members()) != 2 {
+		t.Fatalf("unexpected membership after transfer")
+	}
+	if target.PersistedVersion() != 1 || target.Version() != 2 {
+		t.Fatalf("expected target version to advance, got persisted %d version %d", target.PersistedVersion(), target.Version())
+	}
+	if pending := source.PendingEvents(); len(pending) != 1 || pending[0].Type != events.TypeHouseholdMemberTransferred {
+		t.Fatalf("expected transfer event, got %+v", pending)
+	}
+	pending := target.PendingEvents()
+	if len(pending) != 1 || pending[0].Type != events.TypeHouseholdMemberAdded || pending[0].Version != 2 {
+		t.Fatalf("expected member added event on target, got %+v", pending)
+	}
+	if added := pending[0].Payload.(events.HouseholdMemberAdded); added.CustomerID != child.ID().String() || added.GuardianID != otherParent.ID().String() {
+		t.Fatalf("unexpected member added payload: %+v", added)
+	}
+}
+
+func TestHouseholdMemberComesOfAge(t *testing.T) {
+	joined := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	later := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	parent := newCustomerBornAt(t, "Parent", time.Date(1985, 1, 1, 0, 0, 0, 0, time.UTC))
+	aunt := newCustomerBornAt(t, "Aunt", time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC))
+	teen := newCustomerBornAt(t, "Teen", time.Date(2008, 3, 1, 0, 0, 0, 0, time.UTC))
+	baby := newCustomerBornAt(t, "Baby", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	household, _ := NewHousehold("Doe family", parent, testPolicy, joined)
+	if err := household.AddMember(aunt, uuid.Nil, testPolicy, joined); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := household.AddMember(teen, aunt.ID(), testPolicy, joined); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := household.RemoveMember(aunt.ID(), testPolicy, joined); !errors.Is(err, valueobjects.ErrGuardianHasDependents) {
+		t.Fatalf("expected guardian of a minor to stay, got %v", err)
+	}
+	if err := household.AddMember(baby, teen.ID(), testPolicy, later); err != nil {
+		t.Fatalf("expected member who came of age to act as guardian, got %v", err)
+	}
+	if err := household.RemoveMember(aunt.ID(), testPolicy, later); err != nil {
+		t.Fatalf("expected guardian of a member who came of age to be removable, got %v", err)
+	}
+
+	// Восстановленное без даты рождения домохозяйство опирается на признак при вступлении.
+	restored := RehydrateHousehold(household.ID(), household.Name(), parent.ID(), []HouseholdMember{
+		{CustomerID: parent.ID(), JoinedAt: joined},
+		{CustomerID: aunt.ID(), JoinedAt: joined},
+		{CustomerID: teen.ID(), GuardianID: aunt.ID(), Minor: true, JoinedAt: joined},
+	}, joined, joined, 3)
+	if err := restored.RemoveMember(aunt.ID(), testPolicy, later); !errors.Is(err, valueobjects.ErrGuardianHasDependents) {
+		t.Fatalf("expected stored minor flag without birth date, got %v", err)
+	}
+}
+
+func newCustomerBornAt(t *testing.T, name string, birthDate time.Time) *Customer {
+	t.Helper()
+	customer, err := NewCustomer(name, mustEmail(t, "member@example.com"), mustPhone(t, "+1234567890"), birthDate)
+	if err != nil {
+		t.Fatalf("new customer: %v", err)
+	}
+	return customer
+}
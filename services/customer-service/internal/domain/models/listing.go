@@ -0,0 +1,89 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CustomerStatus описывает состояние записи клиента для выборок.
+type CustomerStatus string
+
+const (
+	// CustomerStatusActive — действующий клиент.
+	CustomerStatusActive CustomerStatus = "active"
+	// CustomerStatusMerged — дубликат, слитый в другого клиента.
+	CustomerStatusMerged CustomerStatus = "merged"
+)
+
+// CustomerSort задаёт поле сортировки списка клиентов.
+type CustomerSort string
+
+const (
+	// SortByCreatedAt сортирует по дате создания.
+	SortByCreatedAt CustomerSort = "created_at"
+	// SortByName сортирует по ФИО.
+	SortByName CustomerSort = "full_name"
+)
+
+// ParseCustomerStatus разбирает статус; пустая строка означает любой статус.
+func ParseCustomerStatus(raw string) (CustomerStatus, error) {
+	status := CustomerStatus(strings.ToLower(strings.TrimSpace(raw)))
+	switch status {
+	case "", CustomerStatusActive, CustomerStatusMerged:
+		return status, nil
+	default:
+		return "", fmt.Errorf("unknown customer status %q", raw)
+	}
+}
+
+// ParseCustomerSort разбирает поле сортировки; по умолчанию — дата создания.
+func ParseCustomerSort(raw string) (CustomerSort, error) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "", "created", string(SortByCreatedAt):
+		return SortByCreatedAt, nil
+	case "name", string(SortByName):
+		return SortByName, nil
+	default:
+		return "", fmt.Errorf("unknown customer sort %q", raw)
+	}
+}
+
+// CustomerCursor фиксирует позицию последней строки страницы для keyset-пагинации.
+type CustomerCursor struct {
+	CreatedAt time.Time
+	FullName  string
+	ID        uuid.UUID
+}
+
+// CustomerListFilter задаёт фильтры, сортировку и позицию выборки клиентов.
+type CustomerListFilter struct {
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	BirthMonth  int
+	Status      CustomerStatus
+	Sort        CustomerSort
+	Descending  bool
+	After       *CustomerCursor
+	Limit       int
+}
+
+// CustomerSummary — строка списка клиентов без согласий и событий.
+type CustomerSummary struct {
+	ID          uuid.UUID
+	Email       string
+	FullName    string
+	PhoneNumber string
+	BirthDate   time.Time
+	Status      CustomerStatus
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Version     int
+}
+
+// Cursor возвращает позицию строки для следующей страницы.
+func (s CustomerSummary) Cursor() CustomerCursor {
+	return CustomerCursor{CreatedAt: s.CreatedAt, FullName: s.FullName, ID: s.ID}
+}
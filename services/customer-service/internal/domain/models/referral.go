@@ -0,0 +1,85 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+// Referral описывает клиента, пришедшего по персональному коду.
+type Referral struct {
+	ReferredID   uuid.UUID
+	FullName     string
+	ReferralCode string
+	ReferredAt   time.Time
+}
+
+// AttributeReferral привязывает клиента к пригласившему. Приглашение самого себя,
+// в том числе под другим профилем с тем же email или телефоном, запрещено.
+func (c *Customer) AttributeReferral(referrer *Customer) error {
+	if c.referredBy != uuid.Nil {
+		return valueobjects.ErrAlreadyReferred
+	}
+	if referrer.id == c.id ||
+		referrer.email == c.email ||
+		referrer.phoneNumber.Normalized() == c.phoneNumber.Normalized() {
+		return valueobjects.ErrSelfReferral
+	}
+
+	c.referredBy = referrer.id
+	c.touch()
+	c.record(events.TypeCustomerReferred, events.CustomerReferred{
+		ReferrerID:   referrer.id.String(),
+		ReferredID:   c.id.String(),
+		ReferralCode: referrer.referralCode.String(),
+		OccurredAt:   c.updatedAt,
+	})
+	return nil
+}
+
+// WithReferral восстанавливает реферальные данные агрегата из хранилища.
+func (c *Customer) WithReferral(code valueobjects.ReferralCode, referredBy uuid.UUID) *Customer {
+	c.referralCode = code
+	c.referredBy = referredBy
+	return c
+}
+
+// RegenerateReferralCode выдаёт новый код несохранённому клиенту, если прежний
+// оказался занят. Код уже записан в событие регистрации, поэтому оно обновляется тоже.
+func (c *Customer) RegenerateReferralCode() error {
+	if c.PersistedVersion() != 0 {
+		return valueobjects.ErrReferralCodeFixed
+	}
+
+	code, err := valueobjects.NewReferralCode()
+	if err != nil {
+		return err
+	}
+	c.referralCode = code
+
+	for i, event := range c.pending {
+		if registered, ok := event.Payload.(events.CustomerRegistered); ok {
+			registered.ReferralCode = code.String()
+			c.pending[i].Payload = registered
+		}
+	}
+	return nil
+}
+
+// ReferralCode возвращает персональный код клиента.
+func (c *Customer) ReferralCode() valueobjects.ReferralCode { return c.referralCode }
+
+// ReferredBy возвращает идентификатор пригласившего клиента, если он есть.
+func (c *Customer) ReferredBy() (uuid.UUID, bool) { return c.referredBy, c.referredBy != uuid.Nil }
+
+func (c *Customer) applyCustomerReferred(payload events.CustomerReferred) error {
+	referrerID, err := uuid.Parse(payload.ReferrerID)
+	if err != nil {
+		return fmt.Errorf("customer event: invalid referrer id: %w", err)
+	}
+	c.referredBy = referrerID
+	return nil
+}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+// CustomerSnapshot хранит состояние агрегата на определённой версии потока.
+type CustomerSnapshot struct {
+	ID          uuid.UUID `json:"id"`
+	Email       string    `json:"email"`
+	FullName    string    `json:"full_name"`
+	PhoneNumber string    `json:"phone_number"`
+	BirthDate   time.Time `json:"birth_date"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Version     int       `json:"version"`
+	MergedInto  uuid.UUID `json:"merged_into"`
+
+	Language         string            `json:"language,omitempty"`
+	Timezone         string            `json:"timezone,omitempty"`
+	PreferredChannel string            `json:"preferred_channel,omitempty"`
+	Consents         []ConsentSnapshot `json:"consents,omitempty"`
+
+	ReferralCode string    `json:"referral_code,omitempty"`
+	ReferredBy   uuid.UUID `json:"referred_by"`
+
+	ErasedAt time.Time `json:"erased_at,omitempty"`
+}
+
+// ConsentSnapshot хранит согласие по каналу в снимке агрегата.
+type ConsentSnapshot struct {
+	Channel          string    `json:"channel"`
+	Granted          bool      `json:"granted"`
+	Source           string    `json:"source"`
+	LegalTextVersion string    `json:"legal_text_version"`
+	ChangedAt        time.Time `json:"changed_at"`
+}
+
+// Snapshot возвращает снимок текущего состояния агрегата.
+func (c *Customer) Snapshot() CustomerSnapshot {
+	snapshot := CustomerSnapshot{
+		ID:          c.id,
+		Email:       c.email.String(),
+		FullName:    c.fullName,
+		PhoneNumber: c.phoneNumber.String(),
+		BirthDate:   c.birthDate,
+		CreatedAt:   c.createdAt,
+		UpdatedAt:   c.updatedAt,
+		Version:     c.version,
+		MergedInto:  c.mergedInto,
+
+		Language:         c.preferences.Language.String(),
+		Timezone:         c.preferences.Timezone.String(),
+		PreferredChannel: string(c.preferences.PreferredChannel),
+
+		ReferralCode: c.referralCode.String(),
+		ReferredBy:   c.referredBy,
+
+		ErasedAt: c.erasedAt,
+	}
+
+	for _, consent := range c.Consents() {
+		snapshot.Consents = append(snapshot.Consents, ConsentSnapshot{
+			Channel:          string(consent.Channel),
+			Granted:          consent.Granted,
+			Source:           consent.Source,
+			LegalTextVersion: consent.LegalTextVersion,
+			ChangedAt:        consent.ChangedAt,
+		})
+	}
+
+	return snapshot
+}
+
+// ReplayCustomer восстанавливает агрегат из необязательного снимка и последующих событий потока.
+// Возвращает nil, если ни снимка, ни событий нет.
+func ReplayCustomer(snapshot *CustomerSnapshot, stream []events.Envelope) (*Customer, error) {
+	if snapshot == nil && len(stream) == 0 {
+		return nil, nil
+	}
+
+	customer := &Customer{}
+	if snapshot != nil {
+		var (
+			email valueobjects.Email
+			phone valueobjects.PhoneNumber
+			err   error
+		)
+		// В снимке удалённого клиента контактов нет.
+		if snapshot.ErasedAt.IsZero() {
+			if email, err = valueobjects.NewEmail(snapshot.Email); err != nil {
+				return nil, err
+			}
+			if phone, err = valueobjects.NewPhoneNumber(snapshot.PhoneNumber); err != nil {
+				return nil, err
+			}
+		}
+		customer = RehydrateCustomer(snapshot.ID, email, snapshot.FullName, phone, snapshot.BirthDate, snapshot.CreatedAt, snapshot.UpdatedAt, snapshot.Version)
+		customer.mergedInto = snapshot.MergedInto
+		customer.referredBy = snapshot.ReferredBy
+		customer.erasedAt = snapshot.ErasedAt
+		if snapshot.ReferralCode != "" {
+			if customer.referralCode, err = valueobjects.ParseReferralCode(snapshot.ReferralCode); err != nil {
+				return nil, err
+			}
+		}
+
+		if customer.preferences, err = ParsePreferences(snapshot.Language, snapshot.Timezone, snapshot.PreferredChannel); err != nil {
+			return nil, err
+		}
+		for _, consent := range snapshot.Consents {
+			if err := customer.applyConsentChanged(events.ConsentChanged{
+				Channel:          consent.Channel,
+				Granted:          consent.Granted,
+				Source:           consent.Source,
+				LegalTextVersion: consent.LegalTextVersion,
+			}, consent.ChangedAt); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, event := range stream {
+		if event.Version <= customer.version {
+			continue
+		}
+		if err := customer.Apply(event); err != nil {
+			return nil, err
+		}
+	}
+
+	return customer, nil
+}
@@ -0,0 +1,272 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+// TimelineViewer описывает сотрудника, который читает или изменяет ленту.
+type TimelineViewer struct {
+	ID   string
+	Role string
+}
+
+// TimelinePolicy ограничивает доступ к чувствительным типам записей по ролям.
+// Типы, отсутствующие в RestrictedTypes, доступны всем сотрудникам.
+type TimelinePolicy struct {
+	RestrictedTypes map[valueobjects.EntryType][]string
+	ManagerRoles    []string
+}
+
+// CanAccessType сообщает, может ли роль читать и создавать записи типа entryType.
+func (p TimelinePolicy) CanAccessType(role string, entryType valueobjects.EntryType) bool {
+	roles, restricted := p.RestrictedTypes[entryType]
+	if !restricted {
+		return true
+	}
+	return containsRole(roles, role)
+}
+
+// IsManager сообщает, что роль относится к руководителям.
+func (p TimelinePolicy) IsManager(role string) bool {
+	return containsRole(p.ManagerRoles, role)
+}
+
+// CanRead сообщает, видна ли запись сотруднику с учётом типа и уровня видимости.
+func (p TimelinePolicy) CanRead(viewer TimelineViewer, entry *TimelineEntry) bool {
+	if !p.CanAccessType(viewer.Role, entry.entryType) {
+		return false
+	}
+
+	switch entry.visibility {
+	case valueobjects.VisibilityManagers:
+		return p.IsManager(viewer.Role) || entry.authorID == viewer.ID
+	case valueobjects.VisibilityAuthor:
+		return entry.authorID == viewer.ID
+	default:
+		return true
+	}
+}
+
+// Scope возвращает область видимости ленты для выборки в хранилище.
+func (p TimelinePolicy) Scope(viewer TimelineViewer) TimelineScope {
+	scope := TimelineScope{ViewerID: viewer.ID, Managers: p.IsManager(viewer.Role)}
+	for _, entryType := range valueobjects.EntryTypes {
+		if p.CanAccessType(viewer.Role, entryType) {
+			scope.Types = append(scope.Types, entryType)
+		}
+	}
+	if p.CanAccessType(viewer.Role, valueobjects.EntryTypeSystem) {
+		scope.Types = append(scope.Types, valueobjects.EntryTypeSystem)
+	}
+	return scope
+}
+
+// TimelineScope задаёт доступные сотруднику типы и уровни видимости записей.
+type TimelineScope struct {
+	Types    []valueobjects.EntryType
+	Managers bool
+	ViewerID string
+}
+
+// TimelineCursor — позиция записи в ленте: закреплённые идут первыми, затем новые.
+type TimelineCursor struct {
+	Pinned    bool
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// TimelineFilter задаёт выборку страницы ленты клиента.
+type TimelineFilter struct {
+	CustomerID uuid.UUID
+	Scope      TimelineScope
+	After      *TimelineCursor
+	Limit      int
+}
+
+// TimelineEdit описывает изменение записи; nil-поля не изменяются.
+type TimelineEdit struct {
+	Body       *string
+	Visibility *valueobjects.Visibility
+	Pinned     *bool
+}
+
+// TimelineEntry — запись в ленте взаимодействий с клиентом.
+type TimelineEntry struct {
+	id         uuid.UUID
+	customerID uuid.UUID
+	entryType  valueobjects.EntryType
+	body       string
+	authorID   string
+	authorRole string
+	visibility valueobjects.Visibility
+	pinned     bool
+	sourceID   string
+	createdAt  time.Time
+	updatedAt  time.Time
+	deletedAt  time.Time
+}
+
+// NewTimelineEntry создаёт запись сотрудника после проверки доступа к типу.
+func NewTimelineEntry(customerID uuid.UUID, entryType valueobjects.EntryType, body string, visibility valueobjects.Visibility, pinned bool, author TimelineViewer, policy TimelinePolicy, now time.Time) (*TimelineEntry, error) {
+	if !policy.CanAccessType(author.Role, entryType) {
+		return nil, valueobjects.ErrTimelineAccessDenied
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, valueobjects.ErrEmptyEntryBody
+	}
+
+	return &TimelineEntry{
+		id:         uuid.New(),
+		customerID: customerID,
+		entryType:  entryType,
+		body:       body,
+		authorID:   author.ID,
+		authorRole: author.Role,
+		visibility: visibility,
+		pinned:     pinned,
+		createdAt:  now,
+		updatedAt:  now,
+	}, nil
+}
+
+// NewSystemTimelineEntry создаёт запись о доменном событии. sourceID
+// однозначно определяет событие и защищает от повторной записи.
+func NewSystemTimelineEntry(customerID uuid.UUID, body, sourceID string, occurredAt time.Time) *TimelineEntry {
+	return &TimelineEntry{
+		id:         uuid.New(),
+		customerID: customerID,
+		entryType:  valueobjects.EntryTypeSystem,
+		body:       body,
+		authorID:   "system",
+		authorRole: "system",
+		visibility: valueobjects.VisibilityStaff,
+		sourceID:   sourceID,
+		createdAt:  occurredAt,
+		updatedAt:  occurredAt,
+	}
+}
+
+// RehydrateTimelineEntry восстанавливает запись из слоя хранения.
+func RehydrateTimelineEntry(id, customerID uuid.UUID, entryType valueobjects.EntryType, body, authorID, authorRole string, visibility valueobjects.Visibility, pinned bool, sourceID string, createdAt, updatedAt time.Time) *TimelineEntry {
+	return &TimelineEntry{
+		id:         id,
+		customerID: customerID,
+		entryType:  entryType,
+		body:       body,
+		authorID:   authorID,
+		authorRole: authorRole,
+		visibility: visibility,
+		pinned:     pinned,
+		sourceID:   sourceID,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// Edit применяет изменение. Текст правит только автор; видимость и закрепление —
+// автор или руководитель. У системных записей можно менять лишь закрепление.
+func (e *TimelineEntry) Edit(editor TimelineViewer, policy TimelinePolicy, edit TimelineEdit, now time.Time) error {
+	if !policy.CanRead(editor, e) {
+		return valueobjects.ErrTimelineAccessDenied
+	}
+
+	author := e.authorID == editor.ID
+	manager := policy.IsManager(editor.Role)
+
+	if e.entryType == valueobjects.EntryTypeSystem && (edit.Body != nil || edit.Visibility != nil) {
+		return valueobjects.ErrSystemEntryImmutable
+	}
+	if edit.Body != nil && !author {
+		return valueobjects.ErrTimelineAccessDenied
+	}
+	if (edit.Visibility != nil || edit.Pinned != nil) && !author && !manager {
+		return valueobjects.ErrTimelineAccessDenied
+	}
+
+	if edit.Body != nil {
+		body := strings.TrimSpace(*edit.Body)
+		if body == "" {
+			return valueobjects.ErrEmptyEntryBody
+		}
+		e.body = body
+	}
+	if edit.Visibility != nil {
+		e.visibility = *edit.Visibility
+	}
+	if edit.Pinned != nil {
+		e.pinned = *edit.Pinned
+	}
+
+	e.updatedAt = now
+	return nil
+}
+
+// Delete помечает запись удалённой. Удалить запись может автор или руководитель.
+func (e *TimelineEntry) Delete(editor TimelineViewer, policy TimelinePolicy, now time.Time) error {
+	if e.entryType == valueobjects.EntryTypeSystem {
+		return valueobjects.ErrSystemEntryImmutable
+	}
+	if !policy.CanRead(editor, e) || (e.authorID != editor.ID && !policy.IsManager(editor.Role)) {
+		return valueobjects.ErrTimelineAccessDenied
+	}
+
+	e.deletedAt = now
+	e.updatedAt = now
+	return nil
+}
+
+// ID возвращает идентификатор записи.
+func (e *TimelineEntry) ID() uuid.UUID { return e.id }
+
+// CustomerID возвращает клиента, к ленте которого относится запись.
+func (e *TimelineEntry) CustomerID() uuid.UUID { return e.customerID }
+
+// Type возвращает тип записи.
+func (e *TimelineEntry) Type() valueobjects.EntryType { return e.entryType }
+
+// Body возвращает текст записи.
+func (e *TimelineEntry) Body() string { return e.body }
+
+// AuthorID возвращает идентификатор автора.
+func (e *TimelineEntry) AuthorID() string { return e.authorID }
+
+// AuthorRole возвращает роль автора на момент создания записи.
+func (e *TimelineEntry) AuthorRole() string { return e.authorRole }
+
+// Visibility возвращает уровень видимости.
+func (e *TimelineEntry) Visibility() valueobjects.Visibility { return e.visibility }
+
+// Pinned сообщает, что запись закреплена вверху ленты.
+func (e *TimelineEntry) Pinned() bool { return e.pinned }
+
+// SourceID возвращает идентификатор исходного события системной записи.
+func (e *TimelineEntry) SourceID() string { return e.sourceID }
+
+// CreatedAt возвращает время создания.
+func (e *TimelineEntry) CreatedAt() time.Time { return e.createdAt }
+
+// UpdatedAt возвращает время последнего изменения.
+func (e *TimelineEntry) UpdatedAt() time.Time { return e.updatedAt }
+
+// DeletedAt возвращает время удаления или нулевое время.
+func (e *TimelineEntry) DeletedAt() time.Time { return e.deletedAt }
+
+// Cursor возвращает позицию записи в ленте.
+func (e *TimelineEntry) Cursor() TimelineCursor {
+	return TimelineCursor{Pinned: e.pinned, CreatedAt: e.createdAt, ID: e.id}
+}
+
+func containsRole(roles []string, role string) bool {
+	for _, candidate := range roles {
+		if candidate == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+func testTimelinePolicy() TimelinePolicy {
+	return TimelinePolicy{
+		RestrictedTypes: map[valueobjects.EntryType][]string{
+			valueobjects.EntryTypeInjury: {"trainer", "manager"},
+		},
+		ManagerRoles: []string{"manager"},
+	}
+}
+
+func TestTimelinePolicyRestrictsSensitiveTypes(t *testing.T) {
+	policy := testTimelinePolicy()
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	trainer := TimelineViewer{ID: "trainer-1", Role: "trainer"}
+	frontDesk := TimelineViewer{ID: "desk-1", Role: "front_desk"}
+
+	if _, err := NewTimelineEntry(uuid.New(), valueobjects.EntryTypeInjury, "knee", valueobjects.VisibilityStaff, false, frontDesk, policy, now); !errors.Is(err, valueobjects.ErrTimelineAccessDenied) {
+		t.Fatalf("expected front desk to be denied injury notes, got %v", err)
+	}
+
+	entry, err := NewTimelineEntry(uuid.New(), valueobjects.EntryTypeInjury, "knee", valueobjects.VisibilityStaff, false, trainer, policy, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.CanRead(frontDesk, entry) {
+		t.Fatalf("expected injury note to be hidden from front desk")
+	}
+
+	scope := policy.Scope(frontDesk)
+	for _, entryType := range scope.Types {
+		if entryType == valueobjects.EntryTypeInjury {
+			t.Fatalf("expected injury type to be excluded from front desk scope")
+		}
+	}
+}
+
+func TestTimelineEntryEditRules(t *testing.T) {
+	policy := testTimelinePolicy()
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	author := TimelineViewer{ID: "desk-1", Role: "front_desk"}
+	colleague := TimelineViewer{ID: "desk-2", Role: "front_desk"}
+	manager := TimelineViewer{ID: "boss", Role: "manager"}
+
+	entry, err := NewTimelineEntry(uuid.New(), valueobjects.EntryTypeNote, "prefers morning", valueobjects.VisibilityStaff, false, author, policy, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body := "prefers evening"
+	if err := entry.Edit(colleague, policy, TimelineEdit{Body: &body}, now); !errors.Is(err, valueobjects.ErrTimelineAccessDenied) {
+		t.Fatalf("expected colleague edit to be denied, got %v", err)
+	}
+
+	pinned := true
+	if err := entry.Edit(manager, policy, TimelineEdit{Pinned: &pinned}, now); err != nil || !entry.Pinned() {
+		t.Fatalf("expected manager to pin entry, got %v", err)
+	}
+
+	if err := entry.Edit(author, policy, TimelineEdit{Body: &body}, now.Add(time.Minute)); err != nil || entry.Body() != body {
+		t.Fatalf("expected author to edit body, got %v", err)
+	}
+
+	if err := entry.Delete(colleague, policy, now); !errors.Is(err, valueobjects.ErrTimelineAccessDenied) {
+		t.Fatalf("expected colleague delete to be denied, got %v", err)
+	}
+
+	system := NewSystemTimelineEntry(uuid.New(), "Customer registered", "customers/0/1", now)
+	if err := system.Delete(manager, policy, now); !errors.Is(err, valueobjects.ErrSystemEntryImmutable) {
+		t.Fatalf("expected system entry to be immutable, got %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrMissing возвращается, если в контексте нет арендатора.
+	ErrMissing = errors.New("tenant is not specified")
+	// ErrInvalid возвращается для некорректного идентификатора арендатора.
+	ErrInvalid = errors.New("invalid tenant id")
+	// ErrCrossTenant возвращается при попытке обратиться к данным другого арендатора.
+	ErrCrossTenant = errors.New("cross-tenant access denied")
+)
+
+// idPattern ограничивает идентификатор символами, допустимыми в именах
+// индексов OpenSearch и настройках сессии PostgreSQL.
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// ID — идентификатор арендатора (бренда сети).
+type ID string
+
+// Parse нормализует и проверяет идентификатор арендатора.
+func Parse(raw string) (ID, error) {
+	value := strings.ToLower(strings.TrimSpace(raw))
+	if !idPattern.MatchString(value) {
+		return "", fmt.Errorf("%w: %q", ErrInvalid, raw)
+	}
+	return ID(value), nil
+}
+
+// String возвращает строковое представление.
+func (id ID) String() string {
+	return string(id)
+}
+
+type tenantKey struct{}
+
+// WithTenant сохраняет арендатора в контексте.
+func WithTenant(ctx context.Context, id ID) context.Context {
+	return context.WithValue(ctx, tenantKey{}, id)
+}
+
+// FromContext возвращает арендатора из контекста.
+func FromContext(ctx context.Context) (ID, bool) {
+	id, ok := ctx.Value(tenantKey{}).(ID)
+	return id, ok && id != ""
+}
+
+// Require возвращает арендатора из контекста или ErrMissing.
+func Require(ctx context.Context) (ID, error) {
+	id, ok := FromContext(ctx)
+	if !ok {
+		return "", ErrMissing
+	}
+	return id, nil
+}
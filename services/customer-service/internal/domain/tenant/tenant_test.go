@@ -0,0 +1,31 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	id, err := Parse("  Brand_1 ")
+	if err != nil || id != "brand_1" {
+		t.Fatalf("expected normalized id, got %q, %v", id, err)
+	}
+
+	for _, raw := range []string{"", "-brand", "brand/a", "brand a"} {
+		if _, err := Parse(raw); !errors.Is(err, ErrInvalid) {
+			t.Fatalf("expected %q to be rejected, got %v", raw, err)
+		}
+	}
+}
+
+func TestRequire(t *testing.T) {
+	if _, err := Require(context.Background()); !errors.Is(err, ErrMissing) {
+		t.Fatalf("expected missing tenant error, got %v", err)
+	}
+
+	id, err := Require(WithTenant(context.Background(), "brand"))
+	if err != nil || id != "brand" {
+		t.Fatalf("expected tenant from context, got %q, %v", id, err)
+	}
+}
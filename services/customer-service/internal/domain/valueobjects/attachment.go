@@ -0,0 +1,22 @@
+package valueobjects
+
+import "strings"
+
+// AttachmentKind описывает назначение файла, прикреплённого к клиенту.
+type AttachmentKind string
+
+const (
+	AttachmentKindPhoto      AttachmentKind = "photo"
+	AttachmentKindWaiver     AttachmentKind = "waiver"
+	AttachmentKindIDDocument AttachmentKind = "id_document"
+)
+
+// ParseAttachmentKind валидирует вид вложения.
+func ParseAttachmentKind(raw string) (AttachmentKind, error) {
+	switch kind := AttachmentKind(strings.ToLower(strings.TrimSpace(raw))); kind {
+	case AttachmentKindPhoto, AttachmentKindWaiver, AttachmentKindIDDocument:
+		return kind, nil
+	default:
+		return "", ErrInvalidAttachmentKind
+	}
+}
@@ -0,0 +1,73 @@
+package valueobjects
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Channel описывает канал коммуникации с клиентом.
+type Channel string
+
+const (
+	ChannelEmail Channel = "email"
+	ChannelSMS   Channel = "sms"
+	ChannelPush  Channel = "push"
+)
+
+// Channels перечисляет поддерживаемые каналы.
+var Channels = []Channel{ChannelEmail, ChannelSMS, ChannelPush}
+
+// ParseChannel валидирует название канала.
+func ParseChannel(raw string) (Channel, error) {
+	channel := Channel(strings.ToLower(strings.TrimSpace(raw)))
+	for _, known := range Channels {
+		if channel == known {
+			return channel, nil
+		}
+	}
+	return "", ErrInvalidChannel
+}
+
+var languageRegexp = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// Language представляет языковой тег в духе BCP 47, например "ru" или "en-US".
+type Language struct {
+	value string
+}
+
+// NewLanguage валидирует языковой тег.
+func NewLanguage(raw string) (Language, error) {
+	normalized := strings.TrimSpace(raw)
+	if !languageRegexp.MatchString(normalized) {
+		return Language{}, ErrInvalidLanguage
+	}
+	return Language{value: normalized}, nil
+}
+
+// String возвращает строковое представление языка.
+func (l Language) String() string {
+	return l.value
+}
+
+// Timezone представляет часовой пояс из базы IANA.
+type Timezone struct {
+	value string
+}
+
+// NewTimezone валидирует часовой пояс, например "Europe/Moscow".
+func NewTimezone(raw string) (Timezone, error) {
+	normalized := strings.TrimSpace(raw)
+	if normalized == "" || strings.EqualFold(normalized, "local") {
+		return Timezone{}, ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(normalized); err != nil {
+		return Timezone{}, ErrInvalidTimezone
+	}
+	return Timezone{value: normalized}, nil
+}
+
+// String возвращает строковое представление часового пояса.
+func (t Timezone) String() string {
+	return t.value
+}
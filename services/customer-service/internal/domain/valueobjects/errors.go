@@ -7,4 +7,52 @@ var (
 	ErrInvalidPhone    = errors.New("invalid phone number")
 	ErrEmptyFullName   = errors.New("full name must not be empty")
 	ErrInvalidBirthDay = errors.New("birth date must be in the past")
+	ErrAlreadyMerged   = errors.New("customer is already merged into another customer")
+	ErrSelfMerge       = errors.New("customer cannot be merged into itself")
+	ErrCustomerErased  = errors.New("customer personal data has been erased")
+
+	ErrPayerMustBeAdult       = errors.New("household primary payer must be an adult")
+	ErrGuardianRequired       = errors.New("guardian is required for a minor household member")
+	ErrInvalidGuardian        = errors.New("guardian must be an adult member of the same household")
+	ErrAlreadyHouseholdMember = errors.New("customer already belongs to a household")
+	ErrNotHouseholdMember     = errors.New("customer is not a member of the household")
+	ErrPrimaryPayerRemoval    = errors.New("primary payer cannot leave the household")
+	ErrGuardianHasDependents  = errors.New("guardian still has minor dependents in the household")
+
+	ErrInvalidChannel       = errors.New("unsupported communication channel")
+	ErrInvalidLanguage      = errors.New("invalid language tag")
+	ErrInvalidTimezone      = errors.New("invalid timezone")
+	ErrConsentSourceMissing = errors.New("consent source must not be empty")
+	ErrLegalTextMissing     = errors.New("legal text version is required to grant consent")
+
+	ErrInvalidReferralCode = errors.New("invalid referral code")
+	ErrUnknownReferralCode = errors.New("referral code does not exist")
+	ErrSelfReferral        = errors.New("customer cannot refer themselves")
+	ErrAlreadyReferred     = errors.New("customer already has a referrer")
+	ErrReferralCodeFixed   = errors.New("referral code cannot change after the customer is saved")
+
+	ErrInvalidEntryType      = errors.New("unsupported timeline entry type")
+	ErrInvalidVisibility     = errors.New("unsupported timeline entry visibility")
+	ErrEmptyEntryBody        = errors.New("timeline entry body must not be empty")
+	ErrTimelineAccessDenied  = errors.New("role is not allowed to access this timeline entry")
+	ErrSystemEntryImmutable  = errors.New("system timeline entries cannot be edited or deleted")
+	ErrTimelineEntryNotFound = errors.New("timeline entry not found")
+
+	ErrInvalidQuestionnaire  = errors.New("invalid questionnaire definition")
+	ErrQuestionnaireNotFound = errors.New("questionnaire definition not found")
+	ErrUnknownQuestion       = errors.New("answer refers to an unknown question")
+	ErrMissingAnswer         = errors.New("required question is not answered")
+	ErrInvalidAnswer         = errors.New("answer does not match question kind")
+	ErrSignatureRequired     = errors.New("health questionnaire must be signed")
+	ErrHealthAccessDenied    = errors.New("role is not allowed to access health records")
+	ErrHealthRecordNotFound  = errors.New("health questionnaire submission not found")
+	ErrHealthRecordsDisabled = errors.New("health records are disabled: encryption keys are not configured")
+
+	ErrInvalidAttachmentKind  = errors.New("unsupported attachment kind")
+	ErrEmptyAttachment        = errors.New("attachment must not be empty")
+	ErrAttachmentTooLarge     = errors.New("attachment exceeds size limit")
+	ErrUnsupportedContentType = errors.New("attachment content type is not allowed for this kind")
+	ErrChecksumMismatch       = errors.New("attachment checksum does not match uploaded content")
+	ErrAttachmentNotFound     = errors.New("attachment not found")
+	ErrAttachmentsDisabled    = errors.New("attachments are disabled: storage is not configured")
 )
@@ -25,3 +25,13 @@ func NewPhoneNumber(raw string) (PhoneNumber, error) {
 func (p PhoneNumber) String() string {
 	return p.value
 }
+
+// Normalized возвращает только цифры номера для сравнения и поиска дубликатов.
+func (p PhoneNumber) Normalized() string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, p.value)
+}
@@ -0,0 +1,57 @@
+package valueobjects
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+)
+
+// referralAlphabet — алфавит Crockford base32 без легко путаемых символов I, L, O, U.
+const (
+	referralAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	referralLength   = 8
+)
+
+// ReferralCode — персональный код клиента для программы «приведи друга».
+type ReferralCode struct {
+	value string
+}
+
+// NewReferralCode генерирует случайный код.
+func NewReferralCode() (ReferralCode, error) {
+	buf := make([]byte, referralLength)
+	if _, err := rand.Read(buf); err != nil {
+		return ReferralCode{}, fmt.Errorf("generate referral code: %w", err)
+	}
+
+	code := make([]byte, referralLength)
+	for i, b := range buf {
+		code[i] = referralAlphabet[int(b)%len(referralAlphabet)]
+	}
+
+	return ReferralCode{value: string(code)}, nil
+}
+
+// ParseReferralCode нормализует введённый код: регистр и дефисы не важны.
+func ParseReferralCode(raw string) (ReferralCode, error) {
+	value := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(raw), "-", ""))
+	if len(value) != referralLength {
+		return ReferralCode{}, ErrInvalidReferralCode
+	}
+	for _, r := range value {
+		if !strings.ContainsRune(referralAlphabet, r) {
+			return ReferralCode{}, ErrInvalidReferralCode
+		}
+	}
+	return ReferralCode{value: value}, nil
+}
+
+// String возвращает строковое представление.
+func (c ReferralCode) String() string {
+	return c.value
+}
+
+// IsZero сообщает, что код не задан.
+func (c ReferralCode) IsZero() bool {
+	return c.value == ""
+}
@@ -0,0 +1,52 @@
+package valueobjects
+
+import "strings"
+
+// EntryType описывает тип записи в ленте клиента.
+type EntryType string
+
+const (
+	EntryTypeNote      EntryType = "note"
+	EntryTypeCall      EntryType = "call"
+	EntryTypeComplaint EntryType = "complaint"
+	EntryTypeInjury    EntryType = "injury"
+	EntryTypeSystem    EntryType = "system"
+)
+
+// EntryTypes перечисляет типы записей, которые сотрудники добавляют вручную.
+var EntryTypes = []EntryType{EntryTypeNote, EntryTypeCall, EntryTypeComplaint, EntryTypeInjury}
+
+// ParseEntryType валидирует тип записи. Системные записи создаются только из событий.
+func ParseEntryType(raw string) (EntryType, error) {
+	entryType := EntryType(strings.ToLower(strings.TrimSpace(raw)))
+	for _, known := range EntryTypes {
+		if entryType == known {
+			return entryType, nil
+		}
+	}
+	return "", ErrInvalidEntryType
+}
+
+// Visibility определяет, кому из сотрудников видна запись.
+type Visibility string
+
+const (
+	// VisibilityStaff — запись видна всем сотрудникам с доступом к её типу.
+	VisibilityStaff Visibility = "staff"
+	// VisibilityManagers — запись видна только руководителям.
+	VisibilityManagers Visibility = "managers"
+	// VisibilityAuthor — личная заметка автора.
+	VisibilityAuthor Visibility = "author"
+)
+
+// ParseVisibility валидирует уровень видимости; пустое значение означает VisibilityStaff.
+func ParseVisibility(raw string) (Visibility, error) {
+	switch visibility := Visibility(strings.ToLower(strings.TrimSpace(raw))); visibility {
+	case "":
+		return VisibilityStaff, nil
+	case VisibilityStaff, VisibilityManagers, VisibilityAuthor:
+		return visibility, nil
+	default:
+		return "", ErrInvalidVisibility
+	}
+}
@@ -0,0 +1,192 @@
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidKey возвращается для ключа объекта, выходящего за пределы хранилища.
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// LocalStore хранит объекты в локальной файловой системе. Ссылки на скачивание
+// подписываются HMAC и обслуживаются самим хранилищем через ServeHTTP.
+type LocalStore struct {
+	root     string
+	baseURL  string
+	secret   []byte
+	clockNow func() time.Time
+}
+
+// NewLocalStore создаёт хранилище в каталоге root. baseURL — внешний адрес,
+// по которому смонтирован ServeHTTP.
+func NewLocalStore(root, baseURL string, secret []byte) (*LocalStore, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("blob: local store requires a signing secret")
+	}
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("blob: create root: %w", err)
+	}
+
+	return &LocalStore{
+		root:     root,
+		baseURL:  strings.TrimSuffix(baseURL, "/") + "/",
+		secret:   secret,
+		clockNow: time.Now,
+	}, nil
+}
+
+// Put записывает объект целиком во временный файл и атомарно переименовывает его.
+func (s *LocalStore) Put(ctx context.Context, key string, body io.Reader, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("blob: create dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("blob: create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, &contextReader{ctx: ctx, r: body}); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("blob: write %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("blob: close %s: %w", key, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("blob: commit %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// Delete удаляет объект; отсутствующий объект не считается ошибкой.
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("blob: delete %s: %w", key, err)
+	}
+	return nil
+}
+
+// PresignGet возвращает ссылку на скачивание, действующую ttl.
+func (s *LocalStore) PresignGet(ctx context.Context, key, fileName string, ttl time.Duration) (string, error) {
+	if _, err := s.path(key); err != nil {
+		return "", err
+	}
+
+	expires := strconv.FormatInt(s.clockNow().Add(ttl).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("name", fileName)
+	query.Set("signature", s.sign(key, expires, fileName))
+
+	return s.baseURL + (&url.URL{Path: key}).EscapedPath() + "?" + query.Encode(), nil
+}
+
+// ServeHTTP отдаёт объект по подписанной ссылке. Путь запроса — ключ объекта,
+// поэтому обработчик монтируется через http.StripPrefix.
+func (s *LocalStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	query := r.URL.Query()
+	expires, name := query.Get("expires"), query.Get("name")
+
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || !hmac.Equal([]byte(query.Get("signature")), []byte(s.sign(key, expires, name))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+	if s.clockNow().Unix() > unix {
+		http.Error(w, "link expired", http.StatusForbidden)
+		return
+	}
+
+	path, err := s.path(key)
+	if err != nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	if name != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+	}
+	http.ServeContent(w, r, "", info.ModTime(), file)
+}
+
+// WithClock позволяет переопределить таймер в тестах.
+func (s *LocalStore) WithClock(clock func() time.Time) {
+	if clock != nil {
+		s.clockNow = clock
+	}
+}
+
+func (s *LocalStore) sign(key, expires, fileName string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(key + "\n" + expires + "\n" + fileName))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// path переводит ключ в путь внутри root, отклоняя выход за его пределы.
+func (s *LocalStore) path(key string) (string, error) {
+	if key == "" || strings.ContainsRune(key, '\\') {
+		return "", ErrInvalidKey
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", ErrInvalidKey
+		}
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// contextReader прерывает чтение после отмены контекста.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
@@ -0,0 +1,69 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalStorePresignedDownload(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir(), "http://files.local/attachments", []byte("secret"))
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	store.WithClock(func() time.Time { return now })
+
+	ctx := context.Background()
+	if err := store.Put(ctx, "brand/customer/1", strings.NewReader("waiver"), "application/pdf"); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if err := store.Put(ctx, "brand/../../escape", strings.NewReader("x"), "text/plain"); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected invalid key, got %v", err)
+	}
+
+	link, err := store.PresignGet(ctx, "brand/customer/1", "waiver.pdf", time.Minute)
+	if err != nil {
+		t.Fatalf("presign: %v", err)
+	}
+	parsed, _ := url.Parse(link)
+	download := func(query url.Values) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/attachments/brand/customer/1?"+query.Encode(), nil)
+		http.StripPrefix("/attachments/", store).ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := download(parsed.Query())
+	body, _ := io.ReadAll(rec.Body)
+	if rec.Code != http.StatusOK || string(body) != "waiver" {
+		t.Fatalf("expected download, got %d %q", rec.Code, body)
+	}
+	if !strings.Contains(rec.Header().Get("Content-Disposition"), "waiver.pdf") {
+		t.Fatalf("expected file name in content disposition, got %q", rec.Header().Get("Content-Disposition"))
+	}
+
+	tampered := parsed.Query()
+	tampered.Set("name", "other.pdf")
+	if rec := download(tampered); rec.Code != http.StatusForbidden {
+		t.Fatalf("expected tampered link to be rejected, got %d", rec.Code)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if rec := download(parsed.Query()); rec.Code != http.StatusForbidden {
+		t.Fatalf("expected expired link to be rejected, got %d", rec.Code)
+	}
+
+	if err := store.Delete(ctx, "brand/customer/1"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := store.Delete(ctx, "brand/customer/1"); err != nil {
+		t.Fatalf("expected repeated delete to succeed, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// partSize — размер части multipart-загрузки для потоков неизвестной длины.
+const partSize = 8 << 20
+
+// S3Config описывает подключение к S3-совместимому хранилищу (AWS S3, MinIO).
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+}
+
+// S3Store хранит объекты в бакете S3-совместимого хранилища.
+type S3Store struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewS3Store создаёт клиента хранилища.
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("blob: s3 client: %w", err)
+	}
+
+	return &S3Store{client: client, bucket: cfg.Bucket}, nil
+}
+
+// EnsureBucket создаёт бакет, если его ещё нет.
+func (s *S3Store) EnsureBucket(ctx context.Context, region string) error {
+	exists, err := s.client.BucketExists(ctx, s.bucket)
+	if err != nil {
+		return fmt.Errorf("blob: check bucket %s: %w", s.bucket, err)
+	}
+	if exists {
+		return nil
+	}
+	if err := s.client.MakeBucket(ctx, s.bucket, minio.MakeBucketOptions{Region: region}); err != nil {
+		return fmt.Errorf("blob: create bucket %s: %w", s.bucket, err)
+	}
+	return nil
+}
+
+// Put загружает объект потоком, не зная его длины заранее.
+func (s *S3Store) Put(ctx context.Context, key string, body io.Reader, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, body, -1, minio.PutObjectOptions{
+		ContentType: contentType,
+		PartSize:    partSize,
+	})
+	if err != nil {
+		return fmt.Errorf("blob: s3 put %s: %w", key, err)
+	}
+	return nil
+}
+
+// Delete удаляет объект; отсутствующий объект не считается ошибкой.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+	if err != nil && minio.ToErrorResponse(err).Code != "NoSuchKey" {
+		return fmt.Errorf("blob: s3 delete %s: %w", key, err)
+	}
+	return nil
+}
+
+// PresignGet возвращает подписанную ссылку на скачивание, действующую ttl.
+func (s *S3Store) PresignGet(ctx context.Context, key, fileName string, ttl time.Duration) (string, error) {
+	params := url.Values{}
+	if fileName != "" {
+		params.Set("response-content-disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	}
+
+	link, err := s.client.PresignedGetObject(ctx, s.bucket, key, ttl, params)
+	if err != nil {
+		return "", fmt.Errorf("blob: s3 presign %s: %w", key, err)
+	}
+	return link.String(), nil
+}
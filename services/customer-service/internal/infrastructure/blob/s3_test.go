@@ -0,0 +1,233 @@
+package blob
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3 — минимальная замена MinIO: бакеты, multipart-загрузка с потоковой
+// подписью aws-chunked и удаление объектов. Подписи не проверяются.
+type fakeS3 struct {
+	mu      sync.Mutex
+	buckets map[string]bool
+	objects map[string][]byte
+	types   map[string]string
+	uploads map[string]map[int][]byte
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		buckets: make(map[string]bool),
+		objects: make(map[string][]byte),
+		types:   make(map[string]string),
+		uploads: make(map[string]map[int][]byte),
+	}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	if key == "" {
+		switch r.Method {
+		case http.MethodHead:
+			if !f.buckets[bucket] {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case http.MethodPut:
+			f.buckets[bucket] = true
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+		return
+	}
+
+	if !f.buckets[bucket] {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	object := bucket + "/" + key
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadID := fmt.Sprintf("upload-%d", len(f.uploads)+1)
+		f.uploads[uploadID] = make(map[int][]byte)
+		f.types[object] = r.Header.Get("Content-Type")
+		writeXML(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: bucket, Key: key, UploadId: uploadID})
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		parts, ok := f.uploads[query.Get("uploadId")]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		data, err := readS3Body(r)
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		parts[number] = data
+		w.Header().Set("ETag", fmt.Sprintf(`"etag-%d"`, number))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		parts, ok := f.uploads[query.Get("uploadId")]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		var content []byte
+		for number := 1; number <= len(parts); number++ {
+			content = append(content, parts[number]...)
+		}
+		f.objects[object] = content
+		delete(f.uploads, query.Get("uploadId"))
+		writeXML(w, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Bucket  string
+			Key     string
+			ETag    string
+		}{Bucket: bucket, Key: key, ETag: `"etag-complete"`})
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(f.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		if _, ok := f.objects[object]; !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		delete(f.objects, object)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// readS3Body снимает обёртку aws-chunked, если клиент подписывал тело потоково.
+func readS3Body(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var (
+		content []byte
+		reader  = bufio.NewReader(r.Body)
+	)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(header), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return content, nil
+		}
+		chunk := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, chunk); err != nil {
+			return nil, err
+		}
+		content = append(content, chunk[:size]...)
+	}
+}
+
+func writeXML(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(body)
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+	}{Code: code, Message: code})
+}
+
+func TestS3StoreAgainstFakeMinIO(t *testing.T) {
+	fake := newFakeS3()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	endpoint, _ := url.Parse(server.URL)
+	store, err := NewS3Store(S3Config{
+		Endpoint:  endpoint.Host,
+		Region:    "us-east-1",
+		Bucket:    "attachments",
+		AccessKey: "minio",
+		SecretKey: "minio-secret",
+	})
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := store.EnsureBucket(ctx, "us-east-1"); err != nil {
+		t.Fatalf("ensure bucket: %v", err)
+	}
+	if !fake.buckets["attachments"] {
+		t.Fatalf("expected bucket to be created")
+	}
+	if err := store.EnsureBucket(ctx, "us-east-1"); err != nil {
+		t.Fatalf("expected existing bucket to be accepted, got %v", err)
+	}
+
+	content := bytes.Repeat([]byte("waiver "), 1024)
+	if err := store.Put(ctx, "brand/customer/1", bytes.NewReader(content), "application/pdf"); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if got := fake.objects["attachments/brand/customer/1"]; !bytes.Equal(got, content) {
+		t.Fatalf("expected stored object to match upload, got %d bytes", len(got))
+	}
+	if got := fake.types["attachments/brand/customer/1"]; got != "application/pdf" {
+		t.Fatalf("expected content type to be stored, got %q", got)
+	}
+
+	link, err := store.PresignGet(ctx, "brand/customer/1", "waiver.pdf", time.Minute)
+	if err != nil {
+		t.Fatalf("presign: %v", err)
+	}
+	parsed, _ := url.Parse(link)
+	if parsed.Host != endpoint.Host || parsed.Path != "/attachments/brand/customer/1" {
+		t.Fatalf("expected link to the object, got %s", link)
+	}
+	if parsed.Query().Get("X-Amz-Expires") != "60" || parsed.Query().Get("X-Amz-Signature") == "" {
+		t.Fatalf("expected signed link valid for a minute, got %s", link)
+	}
+	if !strings.Contains(parsed.Query().Get("response-content-disposition"), "waiver.pdf") {
+		t.Fatalf("expected file name in content disposition, got %s", link)
+	}
+
+	if err := store.Delete(ctx, "brand/customer/1"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, ok := fake.objects["attachments/brand/customer/1"]; ok {
+		t.Fatalf("expected object to be deleted")
+	}
+	if err := store.Delete(ctx, "brand/customer/1"); err != nil {
+		t.Fatalf("expected repeated delete to succeed, got %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// formatVersion — версия формата шифротекста: [версия][длина id ключа][id ключа][nonce][данные].
+const formatVersion = 1
+
+var (
+	// ErrUnknownKey возвращается, если шифротекст создан ключом, которого нет в связке.
+	ErrUnknownKey = errors.New("crypto: unknown encryption key")
+	// ErrMalformedCiphertext возвращается для повреждённого или чужого шифротекста.
+	ErrMalformedCiphertext = errors.New("crypto: malformed ciphertext")
+)
+
+// Keyring шифрует данные AES-256-GCM активным ключом и расшифровывает любым
+// известным, что позволяет ротировать ключи без перешифрования старых записей.
+type Keyring struct {
+	active string
+	keys   map[string]cipher.AEAD
+}
+
+// NewKeyring создаёт связку ключей; каждый ключ — 32 байта.
+func NewKeyring(activeID string, keys map[string][]byte) (*Keyring, error) {
+	if _, ok := keys[activeID]; !ok {
+		return nil, fmt.Errorf("crypto: active key %q is not configured", activeID)
+	}
+
+	ring := &Keyring{active: activeID, keys: make(map[string]cipher.AEAD, len(keys))}
+	for id, key := range keys {
+		if id == "" || len(id) > 255 {
+			return nil, fmt.Errorf("crypto: invalid key id %q", id)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("crypto: key %q must be 32 bytes, got %d", id, len(key))
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("crypto: key %q: %w", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("crypto: key %q: %w", id, err)
+		}
+		ring.keys[id] = aead
+	}
+
+	return ring, nil
+}
+
+// ParseKeys декодирует ключи из base64, как они задаются в конфигурации.
+func ParseKeys(encoded map[string]string) (map[string][]byte, error) {
+	keys := make(map[string][]byte, len(encoded))
+	for id, value := range encoded {
+		key, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("crypto: decode key %q: %w", id, err)
+		}
+		keys[id] = key
+	}
+	return keys, nil
+}
+
+// Encrypt шифрует plaintext активным ключом. aad привязывает шифротекст
+// к записи: расшифровать его с другим aad нельзя.
+func (k *Keyring) Encrypt(plaintext, aad []byte) ([]byte, error) {
+	aead := k.keys[k.active]
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("crypto: nonce: %w", err)
+	}
+
+	out := make([]byte, 0, 2+len(k.active)+len(nonce)+len(plaintext)+aead.Overhead())
+	out = append(out, formatVersion, byte(len(k.active)))
+	out = append(out, k.active...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, plaintext, aad), nil
+}
+
+// Decrypt расшифровывает данные ключом, идентификатор которого записан в шифротексте.
+func (k *Keyring) Decrypt(ciphertext, aad []byte) ([]byte, error) {
+	if len(ciphertext) < 2 || ciphertext[0] != formatVersion {
+		return nil, ErrMalformedCiphertext
+	}
+
+	idLen := int(ciphertext[1])
+	if len(ciphertext) < 2+idLen {
+		return nil, ErrMalformedCiphertext
+	}
+	aead, ok := k.keys[string(ciphertext[2:2+idLen])]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	rest := ciphertext[2+idLen:]
+	if len(rest) < aead.NonceSize() {
+		return nil, ErrMalformedCiphertext
+	}
+
+	plaintext, err := aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], aad)
+	if err != nil {
+		return nil, ErrMalformedCiphertext
+	}
+	return plaintext, nil
+}
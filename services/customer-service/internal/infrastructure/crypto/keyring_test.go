@@ -0,0 +1,51 @@
+package crypto
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestKeyringRoundTripAndRotation(t *testing.T) {
+	oldKey := bytes.Repeat([]byte{1}, 32)
+	newKey := bytes.Repeat([]byte{2}, 32)
+
+	before, err := NewKeyring("k1", map[string][]byte{"k1": oldKey})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sealed, err := before.Encrypt([]byte("knee injury"), []byte("record-1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bytes.Contains(sealed, []byte("knee injury")) {
+		t.Fatalf("expected plaintext to be encrypted")
+	}
+
+	rotated, err := NewKeyring("k2", map[string][]byte{"k1": oldKey, "k2": newKey})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	opened, err := rotated.Decrypt(sealed, []byte("record-1"))
+	if err != nil || string(opened) != "knee injury" {
+		t.Fatalf("expected old ciphertext to decrypt after rotation, got %q, %v", opened, err)
+	}
+
+	if _, err := rotated.Decrypt(sealed, []byte("record-2")); !errors.Is(err, ErrMalformedCiphertext) {
+		t.Fatalf("expected aad mismatch to fail, got %v", err)
+	}
+
+	onlyNew, _ := NewKeyring("k2", map[string][]byte{"k2": newKey})
+	if _, err := onlyNew.Decrypt(sealed, []byte("record-1")); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("expected unknown key error, got %v", err)
+	}
+}
+
+func TestNewKeyringValidatesKeys(t *testing.T) {
+	if _, err := NewKeyring("k1", map[string][]byte{"k1": []byte("short")}); err == nil {
+		t.Fatalf("expected short key to be rejected")
+	}
+	if _, err := NewKeyring("missing", map[string][]byte{"k1": bytes.Repeat([]byte{1}, 32)}); err == nil {
+		t.Fatalf("expected missing active key to be rejected")
+	}
+}
@@ -0,0 +1,78 @@
+// Package healthcheck содержит проверки внешних зависимостей customer-service для pkg/health.
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/health"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/segmentio/kafka-go"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// Postgres проверяет пул соединений запросом ping.
+func Postgres(pool *pgxpool.Pool) health.CheckFunc {
+	return func(ctx context.Context) error {
+		return pool.Ping(ctx)
+	}
+}
+
+// Kafka считает брокеров доступными, если удаётся подключиться хотя бы к одному из них.
+func Kafka(brokers []string) health.CheckFunc {
+	return func(ctx context.Context) error {
+		if len(brokers) == 0 {
+			return errors.New("kafka: no brokers configured")
+		}
+		var errs []error
+		for _, broker := range brokers {
+			conn, err := kafka.DialContext(ctx, "tcp", broker)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", broker, err))
+				continue
+			}
+			return conn.Close()
+		}
+		return fmt.Errorf("kafka: %w", errors.Join(errs...))
+	}
+}
+
+// OpenSearch запрашивает состояние кластера: red — сбой, yellow — деградация.
+func OpenSearch(client *opensearch.Client) health.CheckFunc {
+	return func(ctx context.Context) error {
+		res, err := client.Cluster.Health(client.Cluster.Health.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("opensearch: %w", err)
+		}
+		defer res.Body.Close()
+		if res.IsError() {
+			return fmt.Errorf("opensearch: cluster health: %s", res.Status())
+		}
+
+		var body struct {
+			Status string `json:"status"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+			return fmt.Errorf("opensearch: decode cluster health: %w", err)
+		}
+		switch body.Status {
+		case "green":
+			return nil
+		case "yellow":
+			return fmt.Errorf("opensearch: cluster is yellow: %w", health.ErrDegraded)
+		default:
+			return fmt.Errorf("opensearch: cluster is %s", body.Status)
+		}
+	}
+}
+
+// Mongo проверяет доступность primary.
+func Mongo(client *mongo.Client) health.CheckFunc {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx, readpref.Primary())
+	}
+}
@@ -4,12 +4,26 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/evgeniySeleznev/nwHS/pkg/deadline"
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
 	mongodlq "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/infrastructure/mongo"
 	"github.com/segmentio/kafka-go"
 )
 
+// Заголовки сообщений: тип события различает сообщения одного топика,
+// арендатор позволяет потребителям не смешивать данные брендов.
+const (
+	headerEventType = "event_type"
+	headerTenantID  = "tenant_id"
+)
+
+// dlqTimeout ограничивает запись в DLQ. Она не наследует дедлайн вызова: запись в Kafka
+// чаще всего падает как раз по нему, и событие иначе было бы потеряно.
+const dlqTimeout = 5 * time.Second
+
 // Publisher публикует доменные события в Kafka topic.
 type Publisher struct {
 	writer *kafka.Writer
@@ -29,18 +43,111 @@ func (p *Publisher) PublishCustomerRegistered(ctx context.Context, event events.
 		return fmt.Errorf("marshal event: %w", err)
 	}
 
-	message := kafka.Message{
-		Topic: p.topic,
-		Key:   []byte(event.CustomerID),
-		Value: payload,
+	if err := p.write(ctx, events.TypeCustomerRegistered, event.CustomerID, payload); err != nil {
+		if p.dlq != nil {
+			dlqCtx, cancel := dlqContext(ctx)
+			_ = p.dlq.SaveCustomerEvent(dlqCtx, event, payload, err)
+			cancel()
+		}
+		return err
+	}
+
+	return nil
+}
+
+// PublishCustomersMerged публикует событие слияния клиентов.
+func (p *Publisher) PublishCustomersMerged(ctx context.Context, event events.CustomersMerged) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	if err := p.write(ctx, events.TypeCustomersMerged, event.MergedID, payload); err != nil {
+		if p.dlq != nil {
+			dlqCtx, cancel := dlqContext(ctx)
+			_ = p.dlq.SaveEvent(dlqCtx, string(events.TypeCustomersMerged), event.MergedID, payload, err)
+			cancel()
+		}
+		return err
+	}
+
+	return nil
+}
+
+// PublishCustomerReferred публикует атрибуцию приглашения; ключом служит
+// приглашённый клиент, чтобы события по нему шли в одну партицию.
+func (p *Publisher) PublishCustomerReferred(ctx context.Context, event events.CustomerReferred) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
 	}
 
-	if err := p.writer.WriteMessages(ctx, message); err != nil {
+	if err := p.write(ctx, events.TypeCustomerReferred, event.ReferredID, payload); err != nil {
 		if p.dlq != nil {
-			_ = p.dlq.SaveCustomerEvent(ctx, event, payload, err)
+			dlqCtx, cancel := dlqContext(ctx)
+			_ = p.dlq.SaveEvent(dlqCtx, string(events.TypeCustomerReferred), event.ReferredID, payload, err)
+			cancel()
 		}
+		return err
+	}
+
+	return nil
+}
+
+// PublishEvents публикует события агрегата одной пачкой; ключом сообщения служит
+// идентификатор агрегата. При ошибке записи все события пачки уходят в DLQ.
+func (p *Publisher) PublishEvents(ctx context.Context, envelopes []events.Envelope) error {
+	if len(envelopes) == 0 {
+		return nil
+	}
+
+	messages := make([]kafka.Message, 0, len(envelopes))
+	for _, envelope := range envelopes {
+		payload, err := json.Marshal(envelope.Payload)
+		if err != nil {
+			return fmt.Errorf("marshal event: %w", err)
+		}
+		messages = append(messages, p.message(ctx, envelope.Type, envelope.AggregateID, payload))
+	}
+
+	if err := p.writer.WriteMessages(ctx, messages...); err != nil {
+		deadline.Observe(ctx, "kafka", err)
+		if p.dlq != nil {
+			dlqCtx, cancel := dlqContext(ctx)
+			for i, envelope := range envelopes {
+				_ = p.dlq.SaveEvent(dlqCtx, string(envelope.Type), envelope.AggregateID, messages[i].Value, err)
+			}
+			cancel()
+		}
+		return fmt.Errorf("write messages: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Publisher) write(ctx context.Context, eventType events.Type, key string, payload []byte) error {
+	if err := p.writer.WriteMessages(ctx, p.message(ctx, eventType, key, payload)); err != nil {
+		deadline.Observe(ctx, "kafka", err)
 		return fmt.Errorf("write message: %w", err)
 	}
 
 	return nil
 }
+
+func dlqContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), dlqTimeout)
+}
+
+func (p *Publisher) message(ctx context.Context, eventType events.Type, key string, payload []byte) kafka.Message {
+	headers := []kafka.Header{{Key: headerEventType, Value: []byte(eventType)}}
+	if id, ok := tenant.FromContext(ctx); ok {
+		headers = append(headers, kafka.Header{Key: headerTenantID, Value: []byte(id)})
+	}
+
+	return kafka.Message{
+		Topic:   p.topic,
+		Key:     []byte(key),
+		Value:   payload,
+		Headers: headers,
+	}
+}
@@ -0,0 +1,18 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/deadline"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// DeadlineMonitor отмечает этап "mongo", если команда оборвалась по дедлайну вызова.
+func DeadlineMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			deadline.Observe(ctx, "mongo", errors.New(evt.Failure))
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -44,6 +45,37 @@ func (r *DeadLetterRepository) SaveCustomerEvent(ctx context.Context, event even
 		"created_at": time.Now().UTC(),
 	}
 
+	if id, ok := tenant.FromContext(ctx); ok {
+		doc["tenant_id"] = id.String()
+	}
+
+	_, err := r.collection.InsertOne(ctx, doc)
+	return err
+}
+
+// SaveEvent сохраняет произвольное событие, которое не удалось записать в Kafka.
+func (r *DeadLetterRepository) SaveEvent(ctx context.Context, eventType, key string, payload []byte, publishErr error) error {
+	if r == nil || r.collection == nil {
+		return nil
+	}
+
+	errMsg := ""
+	if publishErr != nil {
+		errMsg = publishErr.Error()
+	}
+
+	doc := bson.M{
+		"event_type": eventType,
+		"key":        key,
+		"payload":    payload,
+		"error":      errMsg,
+		"created_at": time.Now().UTC(),
+	}
+
+	if id, ok := tenant.FromContext(ctx); ok {
+		doc["tenant_id"] = id.String()
+	}
+
 	_, err := r.collection.InsertOne(ctx, doc)
 	return err
 }
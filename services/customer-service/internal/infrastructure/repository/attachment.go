@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const attachmentColumns = `id, customer_id, kind, file_name, content_type, size_bytes, sha256, storage_key, uploaded_by, created_at`
+
+// AttachmentRepository хранит метаданные вложений клиентов в PostgreSQL.
+type AttachmentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAttachmentRepository создаёт экземпляр.
+func NewAttachmentRepository(pool *pgxpool.Pool) *AttachmentRepository {
+	return &AttachmentRepository{pool: pool}
+}
+
+// Save сохраняет метаданные загруженного файла.
+func (r *AttachmentRepository) Save(ctx context.Context, attachment *models.Attachment) error {
+	const stmt = `INSERT INTO customer_attachments (
+        id, customer_id, kind, file_name, content_type, size_bytes, sha256, storage_key, uploaded_by, created_at, tenant_id
+    ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn(ctx, r.pool).Exec(ctx, stmt,
+		attachment.ID(),
+		attachment.CustomerID(),
+		string(attachment.Kind()),
+		attachment.FileName(),
+		attachment.ContentType(),
+		attachment.Size(),
+		attachment.Checksum(),
+		attachment.StorageKey(),
+		attachment.UploadedBy(),
+		attachment.CreatedAt(),
+		tenant,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres save attachment: %w", err)
+	}
+
+	return nil
+}
+
+// GetAttachment возвращает метаданные вложения.
+func (r *AttachmentRepository) GetAttachment(ctx context.Context, id string) (*models.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM customer_attachments WHERE id = $1 AND tenant_id = $2`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, valueobjects.ErrAttachmentNotFound
+	}
+
+	attachment, err := scanAttachment(conn(ctx, r.pool).QueryRow(ctx, query, attachmentID, tenant))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, valueobjects.ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("postgres get attachment: %w", err)
+	}
+
+	return attachment, nil
+}
+
+// ListAttachments возвращает вложения клиента от новых к старым,
+// включая вложения слитых с ним дубликатов.
+func (r *AttachmentRepository) ListAttachments(ctx context.Context, customerID uuid.UUID) ([]*models.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM customer_attachments
+        WHERE tenant_id = $1
+          AND (customer_id = $2 OR customer_id IN (SELECT id FROM customers WHERE merged_into = $2 AND tenant_id = $1))
+        ORDER BY created_at DESC, id DESC`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenant, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("postgres list attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []*models.Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("postgres scan attachment: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres list attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// DeleteAttachments удаляет метаданные вложений.
+func (r *AttachmentRepository) DeleteAttachments(ctx context.Context, ids []uuid.UUID) error {
+	const stmt = `DELETE FROM customer_attachments WHERE tenant_id = $1 AND id = ANY($2)`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn(ctx, r.pool).Exec(ctx, stmt, tenant, ids); err != nil {
+		return fmt.Errorf("postgres delete attachments: %w", err)
+	}
+
+	return nil
+}
+
+func scanAttachment(row pgx.Row) (*models.Attachment, error) {
+	var (
+		id          uuid.UUID
+		customerID  uuid.UUID
+		kind        string
+		fileName    string
+		contentType string
+		size        int64
+		checksum    string
+		storageKey  string
+		uploadedBy  string
+		createdAt   time.Time
+	)
+
+	if err := row.Scan(&id, &customerID, &kind, &fileName, &contentType, &size, &checksum, &storageKey, &uploadedBy, &createdAt); err != nil {
+		return nil, err
+	}
+
+	return models.RehydrateAttachment(id, customerID, valueobjects.AttachmentKind(kind), fileName, contentType, size, checksum, storageKey, uploadedBy, createdAt), nil
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AuditLogRepository хранит журнал аудита в append-only таблице PostgreSQL.
+type AuditLogRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAuditLogRepository создаёт экземпляр.
+func NewAuditLogRepository(pool *pgxpool.Pool) *AuditLogRepository {
+	return &AuditLogRepository{pool: pool}
+}
+
+// Append добавляет запись в журнал, используя транзакцию из контекста.
+func (r *AuditLogRepository) Append(ctx context.Context, record audit.Record) error {
+	const stmt = `INSERT INTO customer_audit_log (
+        id, actor_id, actor_role, action, aggregate_type, aggregate_id, changes, request_id, trace_id, occurred_at, tenant_id
+    ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	changes, err := json.Marshal(record.Changes)
+	if err != nil {
+		return fmt.Errorf("marshal audit changes: %w", err)
+	}
+
+	_, err = conn(ctx, r.pool).Exec(ctx, stmt,
+		record.ID,
+		record.Actor.ID,
+		record.Actor.Role,
+		string(record.Action),
+		record.AggregateType,
+		record.AggregateID,
+		changes,
+		record.RequestID,
+		record.TraceID,
+		record.OccurredAt,
+		tenant,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres append audit record: %w", err)
+	}
+
+	return nil
+}
+
+// AppendBatch добавляет пачку записей через COPY, используя транзакцию из контекста.
+func (r *AuditLogRepository) AppendBatch(ctx context.Context, records []audit.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]any, 0, len(records))
+	for _, record := range records {
+		changes, err := json.Marshal(record.Changes)
+		if err != nil {
+			return fmt.Errorf("marshal audit changes: %w", err)
+		}
+		rows = append(rows, []any{
+			record.ID,
+			record.Actor.ID,
+			record.Actor.Role,
+			string(record.Action),
+			record.AggregateType,
+			record.AggregateID,
+			changes,
+			record.RequestID,
+			record.TraceID,
+			record.OccurredAt,
+			tenant,
+		})
+	}
+
+	columns := []string{"id", "actor_id", "actor_role", "action", "aggregate_type", "aggregate_id", "changes", "request_id", "trace_id", "occurred_at", "tenant_id"}
+	if _, err := conn(ctx, r.pool).CopyFrom(ctx, pgx.Identifier{"customer_audit_log"}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("postgres copy audit records: %w", err)
+	}
+
+	return nil
+}
+
+// List возвращает записи журнала по фильтру в порядке добавления.
+func (r *AuditLogRepository) List(ctx context.Context, filter audit.Filter) ([]audit.Record, error) {
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		conditions = []string{"tenant_id = $1", "seq > $2"}
+		args       = []any{tenant, filter.AfterSeq}
+	)
+
+	addCondition := func(expr string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(expr, len(args)))
+	}
+
+	if filter.AggregateID != "" {
+		addCondition("aggregate_id = $%d", filter.AggregateID)
+	}
+	if filter.ActorID != "" {
+		addCondition("actor_id = $%d", filter.ActorID)
+	}
+	if filter.Action != "" {
+		addCondition("action = $%d", string(filter.Action))
+	}
+	if !filter.From.IsZero() {
+		addCondition("occurred_at >= $%d", filter.From)
+	}
+	if !filter.To.IsZero() {
+		addCondition("occurred_at < $%d", filter.To)
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`SELECT seq, id, actor_id, actor_role, action, aggregate_type, aggregate_id, changes, request_id, trace_id, occurred_at
+        FROM customer_audit_log WHERE %s ORDER BY seq LIMIT $%d`, strings.Join(conditions, " AND "), len(args))
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres list audit records: %w", err)
+	}
+	defer rows.Close()
+
+	var records []audit.Record
+	for rows.Next() {
+		var (
+			record  audit.Record
+			action  string
+			changes []byte
+		)
+		if err := rows.Scan(
+			&record.Seq,
+			&record.ID,
+			&record.Actor.ID,
+			&record.Actor.Role,
+			&action,
+			&record.AggregateType,
+			&record.AggregateID,
+			&changes,
+			&record.RequestID,
+			&record.TraceID,
+			&record.OccurredAt,
+		); err != nil {
+			return nil, fmt.Errorf("postgres scan audit record: %w", err)
+		}
+
+		record.Action = audit.Action(action)
+		if err := json.Unmarshal(changes, &record.Changes); err != nil {
+			return nil, fmt.Errorf("unmarshal audit changes: %w", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres list audit records: %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+// ListContactable возвращает клиентов с действующим согласием на канал,
+// упорядоченных по идентификатору, начиная после afterID.
+func (r *PostgresRepository) ListContactable(ctx context.Context, channel valueobjects.Channel, afterID uuid.UUID, limit int) ([]models.ContactableCustomer, error) {
+	const query = `SELECT c.id, c.full_name, c.email, c.phone_number, c.language, c.timezone
+        FROM customer_consents cc JOIN customers c ON c.id = cc.customer_id
+        WHERE cc.tenant_id = $4 AND c.tenant_id = $4
+          AND cc.channel = $1 AND cc.granted AND c.merged_into IS NULL AND c.id > $2
+        ORDER BY c.id LIMIT $3`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, string(channel), afterID, limit, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("postgres list contactable customers: %w", err)
+	}
+	defer rows.Close()
+
+	var customers []models.ContactableCustomer
+	for rows.Next() {
+		var customer models.ContactableCustomer
+		if err := rows.Scan(&customer.ID, &customer.FullName, &customer.Email, &customer.PhoneNumber, &customer.Language, &customer.Timezone); err != nil {
+			return nil, fmt.Errorf("postgres scan contactable customer: %w", err)
+		}
+		customers = append(customers, customer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres list contactable customers: %w", err)
+	}
+
+	return customers, nil
+}
+
+func saveContactSettings(ctx context.Context, q querier, tenant string, customer *models.Customer) error {
+	const (
+		preferencesStmt = `UPDATE customers SET language = $2, timezone = $3, preferred_channel = $4 WHERE id = $1 AND tenant_id = $5`
+		consentStmt     = `INSERT INTO customer_consents (customer_id, channel, granted, source, legal_text_version, changed_at, tenant_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        ON CONFLICT (customer_id, channel) DO UPDATE
+        SET granted = EXCLUDED.granted, source = EXCLUDED.source,
+            legal_text_version = EXCLUDED.legal_text_version, changed_at = EXCLUDED.changed_at`
+	)
+
+	preferences := customer.Preferences()
+	if _, err := q.Exec(ctx, preferencesStmt, customer.ID(), preferences.Language.String(), preferences.Timezone.String(), string(preferences.PreferredChannel), tenant); err != nil {
+		return fmt.Errorf("postgres save customer preferences: %w", err)
+	}
+
+	for _, consent := range customer.Consents() {
+		if _, err := q.Exec(ctx, consentStmt, customer.ID(), string(consent.Channel), consent.Granted, consent.Source, consent.LegalTextVersion, consent.ChangedAt, tenant); err != nil {
+			return fmt.Errorf("postgres save customer consent: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func loadConsents(ctx context.Context, q querier, tenant string, customerID uuid.UUID) ([]models.Consent, error) {
+	const query = `SELECT channel, granted, source, legal_text_version, changed_at
+        FROM customer_consents WHERE customer_id = $1 AND tenant_id = $2`
+
+	rows, err := q.Query(ctx, query, customerID, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("postgres load customer consents: %w", err)
+	}
+	defer rows.Close()
+
+	var consents []models.Consent
+	for rows.Next() {
+		var (
+			consent models.Consent
+			channel string
+		)
+		if err := rows.Scan(&channel, &consent.Granted, &consent.Source, &consent.LegalTextVersion, &consent.ChangedAt); err != nil {
+			return nil, fmt.Errorf("postgres scan customer consent: %w", err)
+		}
+		if consent.Channel, err = valueobjects.ParseChannel(channel); err != nil {
+			return nil, err
+		}
+		consents = append(consents, consent)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres load customer consents: %w", err)
+	}
+
+	return consents, nil
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/deadline"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// EnableDeadlineTracking отмечает этап "postgres", если запрос оборвался по дедлайну вызова.
+func EnableDeadlineTracking(cfg *pgxpool.Config) {
+	cfg.ConnConfig.Tracer = deadlineTracer{}
+}
+
+type deadlineTracer struct{}
+
+func (deadlineTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	return ctx
+}
+
+func (deadlineTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	deadline.Observe(ctx, "postgres", data.Err)
+}
@@ -0,0 +1,334 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultSnapshotEvery задаёт, через сколько версий агрегата сохраняется снимок.
+const DefaultSnapshotEvery = 20
+
+// ErrCustomerNotFound возвращается, если поток агрегата пуст.
+var ErrCustomerNotFound = errors.New("customer not found")
+
+// EventStore хранит упорядоченный поток событий клиента и его снимки.
+type EventStore struct {
+	pool          *pgxpool.Pool
+	snapshotEvery int
+}
+
+// NewEventStore создаёт хранилище событий.
+func NewEventStore(pool *pgxpool.Pool, snapshotEvery int) *EventStore {
+	if snapshotEvery <= 0 {
+		snapshotEvery = DefaultSnapshotEvery
+	}
+	return &EventStore{pool: pool, snapshotEvery: snapshotEvery}
+}
+
+// Append сохраняет несохранённые события агрегата и, при пересечении границы, снимок.
+// Конфликт первичного ключа (aggregate_id, version) означает конкурентную запись.
+func (s *EventStore) Append(ctx context.Context, customer *models.Customer) error {
+	const stmt = `INSERT INTO customer_events (aggregate_id, version, event_type, payload, occurred_at, tenant_id)
+        VALUES ($1, $2, $3, $4, $5, $6)`
+
+	pending := customer.PendingEvents()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	q := conn(ctx, s.pool)
+	takeSnapshot := false
+
+	for _, event := range pending {
+		payload, err := json.Marshal(event.Payload)
+		if err != nil {
+			return fmt.Errorf("marshal customer event: %w", err)
+		}
+
+		if _, err := q.Exec(ctx, stmt, customer.ID(), event.Version, string(event.Type), payload, event.OccurredAt, tenant); err != nil {
+			return fmt.Errorf("postgres append customer event: %w", err)
+		}
+
+		if event.Version%s.snapshotEvery == 0 {
+			takeSnapshot = true
+		}
+	}
+
+	if !takeSnapshot {
+		return nil
+	}
+
+	return s.saveSnapshot(ctx, q, tenant, customer.Snapshot())
+}
+
+// AppendBatch записывает несохранённые события нескольких новых агрегатов через COPY.
+// Снимки не создаются: пакетная запись используется только для свежих агрегатов.
+func (s *EventStore) AppendBatch(ctx context.Context, customers []*models.Customer) error {
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	var rows [][]any
+	for _, customer := range customers {
+		for _, event := range customer.PendingEvents() {
+			payload, err := json.Marshal(event.Payload)
+			if err != nil {
+				return fmt.Errorf("marshal customer event: %w", err)
+			}
+			rows = append(rows, []any{customer.ID(), event.Version, string(event.Type), payload, event.OccurredAt, tenant})
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns := []string{"aggregate_id", "version", "event_type", "payload", "occurred_at", "tenant_id"}
+	if _, err := conn(ctx, s.pool).CopyFrom(ctx, pgx.Identifier{"customer_events"}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("postgres copy customer events: %w", err)
+	}
+
+	return nil
+}
+
+// Load восстанавливает актуальное состояние клиента из снимка и потока.
+func (s *EventStore) Load(ctx context.Context, id string) (*models.Customer, error) {
+	return s.LoadAsOf(ctx, id, time.Time{})
+}
+
+// LoadAsOf восстанавливает состояние клиента на момент времени at.
+// Нулевое at означает последнее состояние.
+func (s *EventStore) LoadAsOf(ctx context.Context, id string, at time.Time) (*models.Customer, error) {
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := conn(ctx, s.pool)
+
+	snapshot, err := s.latestSnapshot(ctx, q, tenant, id, at)
+	if err != nil {
+		return nil, err
+	}
+
+	fromVersion := 0
+	if snapshot != nil {
+		fromVersion = snapshot.Version
+	}
+
+	stream, err := s.readStream(ctx, q, tenant, id, fromVersion, at)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := models.ReplayCustomer(snapshot, stream)
+	if err != nil {
+		return nil, fmt.Errorf("replay customer: %w", err)
+	}
+	// Удалённый клиент не восстанавливается ни на какой момент времени.
+	if customer == nil || customer.IsErased() {
+		return nil, ErrCustomerNotFound
+	}
+
+	return customer, nil
+}
+
+// History возвращает полный поток событий клиента по возрастанию версии.
+func (s *EventStore) History(ctx context.Context, id string) ([]events.Envelope, error) {
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := s.readStream(ctx, conn(ctx, s.pool), tenant, id, 0, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	if len(stream) == 0 {
+		return nil, ErrCustomerNotFound
+	}
+	return stream, nil
+}
+
+// EraseHistory удаляет события удалённого клиента, предшествующие событию
+// удаления, потоки слитых с ним дубликатов и все снимки, после чего сохраняет
+// обезличенный снимок. Вызывается после Append в той же транзакции.
+func (s *EventStore) EraseHistory(ctx context.Context, customer *models.Customer, duplicates []uuid.UUID) error {
+	const (
+		eventsStmt     = `DELETE FROM customer_events WHERE tenant_id = $1 AND aggregate_id = $2 AND version < $3`
+		duplicatesStmt = `DELETE FROM customer_events WHERE tenant_id = $1 AND aggregate_id = ANY($2)`
+		snapshotsStmt  = `DELETE FROM customer_snapshots WHERE tenant_id = $1 AND aggregate_id = ANY($2)`
+	)
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	q := conn(ctx, s.pool)
+
+	if _, err := q.Exec(ctx, eventsStmt, tenant, customer.ID(), customer.Version()); err != nil {
+		return fmt.Errorf("postgres erase customer events: %w", err)
+	}
+	if len(duplicates) > 0 {
+		if _, err := q.Exec(ctx, duplicatesStmt, tenant, duplicates); err != nil {
+			return fmt.Errorf("postgres erase merged customer events: %w", err)
+		}
+	}
+	if _, err := q.Exec(ctx, snapshotsStmt, tenant, append([]uuid.UUID{customer.ID()}, duplicates...)); err != nil {
+		return fmt.Errorf("postgres erase customer snapshots: %w", err)
+	}
+
+	return s.saveSnapshot(ctx, q, tenant, customer.Snapshot())
+}
+
+func (s *EventStore) saveSnapshot(ctx context.Context, q querier, tenant string, snapshot models.CustomerSnapshot) error {
+	const stmt = `INSERT INTO customer_snapshots (aggregate_id, version, state, occurred_at, tenant_id)
+        VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`
+
+	state, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("marshal customer snapshot: %w", err)
+	}
+
+	if _, err := q.Exec(ctx, stmt, snapshot.ID, snapshot.Version, state, snapshot.UpdatedAt, tenant); err != nil {
+		return fmt.Errorf("postgres save customer snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func (s *EventStore) latestSnapshot(ctx context.Context, q querier, tenant, id string, at time.Time) (*models.CustomerSnapshot, error) {
+	query := `SELECT state FROM customer_snapshots WHERE tenant_id = $1 AND aggregate_id = $2 ORDER BY version DESC LIMIT 1`
+	args := []any{tenant, id}
+	if !at.IsZero() {
+		query = `SELECT state FROM customer_snapshots WHERE tenant_id = $1 AND aggregate_id = $2 AND occurred_at <= $3 ORDER BY version DESC LIMIT 1`
+		args = append(args, at)
+	}
+
+	var state []byte
+	if err := q.QueryRow(ctx, query, args...).Scan(&state); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("postgres load customer snapshot: %w", err)
+	}
+
+	var snapshot models.CustomerSnapshot
+	if err := json.Unmarshal(state, &snapshot); err != nil {
+		return nil, fmt.Errorf("unmarshal customer snapshot: %w", err)
+	}
+
+	return &snapshot, nil
+}
+
+func (s *EventStore) readStream(ctx context.Context, q querier, tenant, id string, fromVersion int, at time.Time) ([]events.Envelope, error) {
+	query := `SELECT version, event_type, payload, occurred_at FROM customer_events
+        WHERE tenant_id = $1 AND aggregate_id = $2 AND version > $3 ORDER BY version`
+	args := []any{tenant, id, fromVersion}
+	if !at.IsZero() {
+		query = `SELECT version, event_type, payload, occurred_at FROM customer_events
+        WHERE tenant_id = $1 AND aggregate_id = $2 AND version > $3 AND occurred_at <= $4 ORDER BY version`
+		args = append(args, at)
+	}
+
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres read customer events: %w", err)
+	}
+	defer rows.Close()
+
+	var stream []events.Envelope
+	for rows.Next() {
+		var (
+			event     events.Envelope
+			eventType string
+			payload   []byte
+		)
+		if err := rows.Scan(&event.Version, &eventType, &payload, &event.OccurredAt); err != nil {
+			return nil, fmt.Errorf("postgres scan customer event: %w", err)
+		}
+
+		event.AggregateID = id
+		event.Type = events.Type(eventType)
+		if event.Payload, err = decodeCustomerEvent(event.Type, payload); err != nil {
+			return nil, err
+		}
+
+		stream = append(stream, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres read customer events: %w", err)
+	}
+
+	return stream, nil
+}
+
+func decodeCustomerEvent(eventType events.Type, payload []byte) (interface{}, error) {
+	var (
+		target interface{}
+		err    error
+	)
+
+	switch eventType {
+	case events.TypeCustomerRegistered:
+		var event events.CustomerRegistered
+		err = json.Unmarshal(payload, &event)
+		target = event
+	case events.TypeCustomerEmailChanged:
+		var event events.CustomerEmailChanged
+		err = json.Unmarshal(payload, &event)
+		target = event
+	case events.TypeCustomerPhoneNumberChanged:
+		var event events.CustomerPhoneNumberChanged
+		err = json.Unmarshal(payload, &event)
+		target = event
+	case events.TypeCustomerFullNameChanged:
+		var event events.CustomerFullNameChanged
+		err = json.Unmarshal(payload, &event)
+		target = event
+	case events.TypeCustomersMerged:
+		var event events.CustomersMerged
+		err = json.Unmarshal(payload, &event)
+		target = event
+	case events.TypeConsentChanged:
+		var event events.ConsentChanged
+		err = json.Unmarshal(payload, &event)
+		target = event
+	case events.TypeCustomerPreferencesUpdated:
+		var event events.CustomerPreferencesUpdated
+		err = json.Unmarshal(payload, &event)
+		target = event
+	case events.TypeCustomerReferred:
+		var event events.CustomerReferred
+		err = json.Unmarshal(payload, &event)
+		target = event
+	case events.TypeCustomerErased:
+		var event events.CustomerErased
+		err = json.Unmarshal(payload, &event)
+		target = event
+	default:
+		return nil, fmt.Errorf("unknown customer event type %q", eventType)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal customer event %s: %w", eventType, err)
+	}
+
+	return target, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+)
+
+func TestLoadStreamAfterMerge(t *testing.T) {
+	survivor := newStoreTestCustomer(t, "ann@example.com", "+15550000001")
+	duplicate := newStoreTestCustomer(t, "ann.b@example.com", "+15550000002")
+	if err := duplicate.MergeInto(survivor); err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+
+	// Поток проходит тот же путь, что и в Append/readStream: JSON в payload и обратно.
+	var stream []events.Envelope
+	for _, event := range duplicate.PendingEvents() {
+		payload, err := json.Marshal(event.Payload)
+		if err != nil {
+			t.Fatalf("marshal %s: %v", event.Type, err)
+		}
+		decoded, err := decodeCustomerEvent(event.Type, payload)
+		if err != nil {
+			t.Fatalf("decode %s: %v", event.Type, err)
+		}
+		event.Payload = decoded
+		stream = append(stream, event)
+	}
+
+	loaded, err := models.ReplayCustomer(nil, stream)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	into, merged := loaded.MergedInto()
+	if !merged || into != survivor.ID() {
+		t.Fatalf("expected customer merged into %s, got %s (merged=%v)", survivor.ID(), into, merged)
+	}
+}
+
+func TestLoadStreamAfterErase(t *testing.T) {
+	customer := newStoreTestCustomer(t, "ann@example.com", "+15550000001")
+	customer.UpdateFullName("Ann Jones")
+	customer.Erase()
+
+	// После удаления в хранилище остаются событие удаления и обезличенный снимок.
+	stream := customer.PendingEvents()
+	tombstone := stream[len(stream)-1]
+	payload, err := json.Marshal(tombstone.Payload)
+	if err != nil {
+		t.Fatalf("marshal %s: %v", tombstone.Type, err)
+	}
+	if tombstone.Payload, err = decodeCustomerEvent(tombstone.Type, payload); err != nil {
+		t.Fatalf("decode %s: %v", tombstone.Type, err)
+	}
+
+	state, err := json.Marshal(customer.Snapshot())
+	if err != nil {
+		t.Fatalf("marshal snapshot: %v", err)
+	}
+	var snapshot models.CustomerSnapshot
+	if err := json.Unmarshal(state, &snapshot); err != nil {
+		t.Fatalf("unmarshal snapshot: %v", err)
+	}
+	if snapshot.Email != "" || snapshot.FullName != "" || snapshot.PhoneNumber != "" {
+		t.Fatalf("expected snapshot without personal data, got %s", state)
+	}
+
+	loaded, err := models.ReplayCustomer(&snapshot, []events.Envelope{tombstone})
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if !loaded.IsErased() || loaded.Version() != customer.Version() {
+		t.Fatalf("expected erased customer at version %d, got %+v", customer.Version(), loaded.Snapshot())
+	}
+}
+
+func newStoreTestCustomer(t *testing.T, rawEmail, rawPhone string) *models.Customer {
+	t.Helper()
+
+	email, err := valueobjects.NewEmail(rawEmail)
+	if err != nil {
+		t.Fatalf("email: %v", err)
+	}
+	phone, err := valueobjects.NewPhoneNumber(rawPhone)
+	if err != nil {
+		t.Fatalf("phone: %v", err)
+	}
+	customer, err := models.NewCustomer("Ann Smith", email, phone, time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("new customer: %v", err)
+	}
+	return customer
+}
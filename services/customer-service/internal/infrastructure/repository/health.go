@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Cipher шифрует медицинские данные перед записью в хранилище.
+type Cipher interface {
+	Encrypt(plaintext, aad []byte) ([]byte, error)
+	Decrypt(ciphertext, aad []byte) ([]byte, error)
+}
+
+// healthPayload — зашифрованная часть анкеты.
+type healthPayload struct {
+	Answers   map[string]string `json:"answers"`
+	Signature models.Signature  `json:"signature"`
+}
+
+// HealthRepository хранит определения анкет здоровья и заполненные анкеты.
+type HealthRepository struct {
+	pool   *pgxpool.Pool
+	cipher Cipher
+}
+
+// NewHealthRepository создаёт экземпляр. Без cipher доступно только удаление анкет.
+func NewHealthRepository(pool *pgxpool.Pool, cipher Cipher) *HealthRepository {
+	return &HealthRepository{pool: pool, cipher: cipher}
+}
+
+// SaveDefinition сохраняет новую версию анкеты.
+func (r *HealthRepository) SaveDefinition(ctx context.Context, definition *models.QuestionnaireDefinition) error {
+	const stmt = `INSERT INTO questionnaire_definitions (
+        id, code, version, title, questions, valid_for_seconds, min_accepted_version, published_at, tenant_id
+    ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	questions, err := json.Marshal(definition.Questions)
+	if err != nil {
+		return fmt.Errorf("marshal questions: %w", err)
+	}
+
+	_, err = conn(ctx, r.pool).Exec(ctx, stmt,
+		definition.ID,
+		definition.Code,
+		definition.Version,
+		definition.Title,
+		questions,
+		int64(definition.ValidFor/time.Second),
+		definition.MinAcceptedVersion,
+		definition.PublishedAt,
+		tenant,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres save questionnaire definition: %w", err)
+	}
+
+	return nil
+}
+
+// CurrentDefinition возвращает последнюю опубликованную версию анкеты code.
+func (r *HealthRepository) CurrentDefinition(ctx context.Context, code string) (*models.QuestionnaireDefinition, error) {
+	const query = `SELECT id, code, version, title, questions, valid_for_seconds, min_accepted_version, published_at
+        FROM questionnaire_definitions WHERE tenant_id = $1 AND code = $2
+        ORDER BY version DESC LIMIT 1`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		definition models.QuestionnaireDefinition
+		questions  []byte
+		validFor   int64
+	)
+	err = conn(ctx, r.pool).QueryRow(ctx, query, tenant, code).Scan(
+		&definition.ID,
+		&definition.Code,
+		&definition.Version,
+		&definition.Title,
+		&questions,
+		&validFor,
+		&definition.MinAcceptedVersion,
+		&definition.PublishedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, valueobjects.ErrQuestionnaireNotFound
+		}
+		return nil, fmt.Errorf("postgres current questionnaire definition: %w", err)
+	}
+
+	if err := json.Unmarshal(questions, &definition.Questions); err != nil {
+		return nil, fmt.Errorf("unmarshal questions: %w", err)
+	}
+	definition.ValidFor = time.Duration(validFor) * time.Second
+
+	return &definition, nil
+}
+
+// SaveSubmission шифрует ответы и подпись и сохраняет анкету.
+func (r *HealthRepository) SaveSubmission(ctx context.Context, submission *models.HealthSubmission) error {
+	const stmt = `INSERT INTO health_submissions (
+        id, customer_id, definition_id, code, version, payload, flags, submitted_at, expires_at, tenant_id
+    ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(healthPayload{Answers: submission.Answers(), Signature: submission.Signature()})
+	if err != nil {
+		return fmt.Errorf("marshal health payload: %w", err)
+	}
+
+	payload, err := r.cipher.Encrypt(plaintext, healthAAD(tenant, submission.ID()))
+	if err != nil {
+		return fmt.Errorf("encrypt health payload: %w", err)
+	}
+
+	_, err = conn(ctx, r.pool).Exec(ctx, stmt,
+		submission.ID(),
+		submission.CustomerID(),
+		submission.DefinitionID(),
+		submission.QuestionnaireCode(),
+		submission.Version(),
+		payload,
+		submission.Flags(),
+		submission.SubmittedAt(),
+		submission.ExpiresAt(),
+		tenant,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres save health submission: %w", err)
+	}
+
+	return nil
+}
+
+// LatestSubmission возвращает последнюю анкету клиента по коду анкеты.
+func (r *HealthRepository) LatestSubmission(ctx context.Context, customerID uuid.UUID, code string) (*models.HealthSubmission, error) {
+	const query = `SELECT id, definition_id, version, payload, flags, submitted_at, expires_at
+        FROM health_submissions WHERE tenant_id = $1 AND customer_id = $2 AND code = $3
+        ORDER BY submitted_at DESC LIMIT 1`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		id           uuid.UUID
+		definitionID uuid.UUID
+		version      int
+		payload      []byte
+		flags        []string
+		submittedAt  time.Time
+		expiresAt    time.Time
+	)
+	err = conn(ctx, r.pool).QueryRow(ctx, query, tenant, customerID, code).Scan(&id, &definitionID, &version, &payload, &flags, &submittedAt, &expiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, valueobjects.ErrHealthRecordNotFound
+		}
+		return nil, fmt.Errorf("postgres latest health submission: %w", err)
+	}
+
+	plaintext, err := r.cipher.Decrypt(payload, healthAAD(tenant, id))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt health payload: %w", err)
+	}
+
+	var decoded healthPayload
+	if err := json.Unmarshal(plaintext, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshal health payload: %w", err)
+	}
+
+	return models.RehydrateHealthSubmission(id, customerID, definitionID, code, version, decoded.Answers, decoded.Signature, flags, submittedAt, expiresAt), nil
+}
+
+// EraseCustomer удаляет анкеты клиента и слитых с ним дубликатов. Ключи
+// шифрования для этого не нужны.
+func (r *HealthRepository) EraseCustomer(ctx context.Context, customerID uuid.UUID) error {
+	const stmt = `DELETE FROM health_submissions
+        WHERE tenant_id = $1
+          AND (customer_id = $2 OR customer_id IN (SELECT id FROM customers WHERE merged_into = $2 AND tenant_id = $1))`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn(ctx, r.pool).Exec(ctx, stmt, tenant, customerID); err != nil {
+		return fmt.Errorf("postgres erase health submissions: %w", err)
+	}
+
+	return nil
+}
+
+// healthAAD привязывает шифротекст к арендатору и анкете, чтобы его нельзя
+// было подставить в другую строку.
+func healthAAD(tenant string, id uuid.UUID) []byte {
+	return []byte(tenant + ":" + id.String())
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrHouseholdNotFound возвращается, если домохозяйство не найдено.
+var ErrHouseholdNotFound = errors.New("household not found")
+
+const uniqueViolation = "23505"
+
+// HouseholdRepository хранит домохозяйства и их участников в PostgreSQL.
+type HouseholdRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewHouseholdRepository создаёт экземпляр.
+func NewHouseholdRepository(pool *pgxpool.Pool) *HouseholdRepository {
+	return &HouseholdRepository{pool: pool}
+}
+
+// Save создаёт или обновляет домохозяйство с оптимистичной блокировкой по версии
+// и полностью перезаписывает список участников.
+func (r *HouseholdRepository) Save(ctx context.Context, household *models.Household) error {
+	const (
+		insertStmt = `INSERT INTO households (id, name, primary_payer_id, created_at, updated_at, version, tenant_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)`
+		updateStmt = `UPDATE households SET name = $2, primary_payer_id = $3, updated_at = $4, version = $5
+        WHERE id = $1 AND version = $6 AND tenant_id = $7`
+		deleteMembersStmt = `DELETE FROM household_members WHERE household_id = $1 AND tenant_id = $2`
+		insertMemberStmt  = `INSERT INTO household_members (household_id, customer_id, guardian_id, minor, joined_at, tenant_id)
+        VALUES ($1, $2, $3, $4, $5, $6)`
+	)
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return withinTx(ctx, r.pool, func(ctx context.Context) error {
+		q := conn(ctx, r.pool)
+
+		if household.PersistedVersion() == 0 {
+			if _, err := q.Exec(ctx, insertStmt, household.ID(), household.Name(), household.PrimaryPayerID(), household.CreatedAt(), household.UpdatedAt(), household.Version(), tenant); err != nil {
+				return fmt.Errorf("postgres insert household: %w", err)
+			}
+		} else {
+			tag, err := q.Exec(ctx, updateStmt, household.ID(), household.Name(), household.PrimaryPayerID(), household.UpdatedAt(), household.Version(), household.PersistedVersion(), tenant)
+			if err != nil {
+				return fmt.Errorf("postgres update household: %w", err)
+			}
+			if tag.RowsAffected() == 0 {
+				return ErrConcurrentModification
+			}
+		}
+
+		if _, err := q.Exec(ctx, deleteMembersStmt, household.ID(), tenant); err != nil {
+			return fmt.Errorf("postgres clear household members: %w", err)
+		}
+
+		for _, member := range household.Members() {
+			var guardianID *uuid.UUID
+			if member.GuardianID != uuid.Nil {
+				guardianID = &member.GuardianID
+			}
+
+			if _, err := q.Exec(ctx, insertMemberStmt, household.ID(), member.CustomerID, guardianID, member.Minor, member.JoinedAt, tenant); err != nil {
+				var pgErr *pgconn.PgError
+				if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+					return valueobjects.ErrAlreadyHouseholdMember
+				}
+				return fmt.Errorf("postgres insert household member: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetByID возвращает домохозяйство по идентификатору.
+func (r *HouseholdRepository) GetByID(ctx context.Context, id string) (*models.Household, error) {
+	const query = `SELECT id, name, primary_payer_id, created_at, updated_at, version FROM households WHERE id = $1 AND tenant_id = $2`
+	return r.load(ctx, query, id)
+}
+
+// GetByCustomerID возвращает домохозяйство, в котором состоит клиент.
+func (r *HouseholdRepository) GetByCustomerID(ctx context.Context, customerID string) (*models.Household, error) {
+	const query = `SELECT h.id, h.name, h.primary_payer_id, h.created_at, h.updated_at, h.version
+        FROM households h JOIN household_members m ON m.household_id = h.id
+        WHERE m.customer_id = $1 AND h.tenant_id = $2 AND m.tenant_id = $2`
+	return r.load(ctx, query, customerID)
+}
+
+func (r *HouseholdRepository) load(ctx context.Context, query string, arg string) (*models.Household, error) {
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := conn(ctx, r.pool)
+
+	var (
+		id             uuid.UUID
+		name           string
+		primaryPayerID uuid.UUID
+		createdAt      time.Time
+		updatedAt      time.Time
+		version        int
+	)
+
+	if err := q.QueryRow(ctx, query, arg, tenant).Scan(&id, &name, &primaryPayerID, &createdAt, &updatedAt, &version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrHouseholdNotFound
+		}
+		return nil, fmt.Errorf("postgres get household: %w", err)
+	}
+
+	// Дата рождения нужна, чтобы пересчитать совершеннолетие на момент проверки.
+	rows, err := q.Query(ctx, `SELECT m.customer_id, m.guardian_id, m.minor, m.joined_at,
+            CASE WHEN c.erased_at IS NULL THEN c.birth_date END
+        FROM household_members m
+        LEFT JOIN customers c ON c.id = m.customer_id AND c.tenant_id = m.tenant_id
+        WHERE m.household_id = $1 AND m.tenant_id = $2`, id, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("postgres list household members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []models.HouseholdMember
+	for rows.Next() {
+		var (
+			member     models.HouseholdMember
+			guardianID *uuid.UUID
+			birthDate  *time.Time
+		)
+		if err := rows.Scan(&member.CustomerID, &guardianID, &member.Minor, &member.JoinedAt, &birthDate); err != nil {
+			return nil, fmt.Errorf("postgres scan household member: %w", err)
+		}
+		if guardianID != nil {
+			member.GuardianID = *guardianID
+		}
+		if birthDate != nil {
+			member.BirthDate = *birthDate
+		}
+		members = append(members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres list household members: %w", err)
+	}
+
+	return models.RehydrateHousehold(id, name, primaryPayerID, members, createdAt, updatedAt, version), nil
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// ExistingEmails возвращает множество email из списка, уже занятых клиентами.
+func (r *PostgresRepository) ExistingEmails(ctx context.Context, emails []string) (map[string]bool, error) {
+	const query = `SELECT email FROM customers WHERE tenant_id = $1 AND email = ANY($2)`
+
+	existing := make(map[string]bool)
+	if len(emails) == 0 {
+		return existing, nil
+	}
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenant, emails)
+	if err != nil {
+		return nil, fmt.Errorf("postgres existing emails: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, fmt.Errorf("postgres scan email: %w", err)
+		}
+		existing[email] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres existing emails: %w", err)
+	}
+
+	return existing, nil
+}
+
+// CopyCustomers записывает пачку новых клиентов и их потоки событий через COPY.
+func (r *PostgresRepository) CopyCustomers(ctx context.Context, customers []*models.Customer) error {
+	if len(customers) == 0 {
+		return nil
+	}
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]any, 0, len(customers))
+	for _, customer := range customers {
+		rows = append(rows, []any{
+			customer.ID(),
+			customer.Email().String(),
+			customer.FullName(),
+			customer.PhoneNumber().String(),
+			customer.BirthDate(),
+			customer.CreatedAt(),
+			customer.UpdatedAt(),
+			customer.Version(),
+			tenant,
+			customer.ReferralCode().String(),
+		})
+	}
+
+	columns := []string{"id", "email", "full_name", "phone_number", "birth_date", "created_at", "updated_at", "version", "tenant_id", "referral_code"}
+
+	return withinTx(ctx, r.pool, func(ctx context.Context) error {
+		if _, err := conn(ctx, r.pool).CopyFrom(ctx, pgx.Identifier{"customers"}, columns, pgx.CopyFromRows(rows)); err != nil {
+			return fmt.Errorf("postgres copy customers: %w", err)
+		}
+
+		return r.events.AppendBatch(ctx, customers)
+	})
+}
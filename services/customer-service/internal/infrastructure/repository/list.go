@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// ListCustomers возвращает страницу клиентов с keyset-пагинацией по паре
+// (ключ сортировки, id). Ключ — created_at либо full_name.
+func (r *PostgresRepository) ListCustomers(ctx context.Context, filter models.CustomerListFilter) ([]models.CustomerSummary, error) {
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		conditions []string
+		args       []any
+	)
+
+	addCondition := func(expr string, values ...any) {
+		placeholders := make([]any, 0, len(values))
+		for _, value := range values {
+			args = append(args, value)
+			placeholders = append(placeholders, len(args))
+		}
+		conditions = append(conditions, fmt.Sprintf(expr, placeholders...))
+	}
+
+	addCondition("tenant_id = $%d", tenant)
+	conditions = append(conditions, "erased_at IS NULL")
+	if !filter.CreatedFrom.IsZero() {
+		addCondition("created_at >= $%d", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		addCondition("created_at < $%d", filter.CreatedTo)
+	}
+	if filter.BirthMonth != 0 {
+		addCondition("EXTRACT(MONTH FROM birth_date) = $%d", filter.BirthMonth)
+	}
+	switch filter.Status {
+	case models.CustomerStatusActive:
+		conditions = append(conditions, "merged_into IS NULL")
+	case models.CustomerStatusMerged:
+		conditions = append(conditions, "merged_into IS NOT NULL")
+	}
+
+	sortColumn := "created_at"
+	if filter.Sort == models.SortByName {
+		sortColumn = "full_name"
+	}
+
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if filter.After != nil {
+		var key any = filter.After.CreatedAt
+		if filter.Sort == models.SortByName {
+			key = filter.After.FullName
+		}
+		addCondition("("+sortColumn+", id) "+comparison+" ($%d, $%d)", key, filter.After.ID)
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`SELECT id, email, full_name, phone_number, birth_date, merged_into, created_at, updated_at, version
+        FROM customers WHERE %s ORDER BY %s %s, id %s LIMIT $%d`, strings.Join(conditions, " AND "), sortColumn, direction, direction, len(args))
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres list customers: %w", err)
+	}
+	defer rows.Close()
+
+	var customers []models.CustomerSummary
+	for rows.Next() {
+		var (
+			customer   models.CustomerSummary
+			mergedInto *uuid.UUID
+		)
+		if err := rows.Scan(
+			&customer.ID,
+			&customer.Email,
+			&customer.FullName,
+			&customer.PhoneNumber,
+			&customer.BirthDate,
+			&mergedInto,
+			&customer.CreatedAt,
+			&customer.UpdatedAt,
+			&customer.Version,
+		); err != nil {
+			return nil, fmt.Errorf("postgres scan customer: %w", err)
+		}
+
+		customer.Status = models.CustomerStatusActive
+		if mergedInto != nil {
+			customer.Status = models.CustomerStatusMerged
+		}
+
+		customers = append(customers, customer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres list customers: %w", err)
+	}
+
+	return customers, nil
+}
@@ -14,22 +14,32 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// ErrConcurrentModification возвращается, если агрегат изменили параллельно.
+var ErrConcurrentModification = errors.New("customer was modified concurrently")
+
 // PostgresRepository реализует CustomerRepository поверх PostgreSQL.
 type PostgresRepository struct {
-	pool *pgxpool.Pool
+	pool   *pgxpool.Pool
+	events *EventStore
 }
 
-// NewPostgresRepository создаёт экземпляр.
-func NewPostgresRepository(pool *pgxpool.Pool) *PostgresRepository {
-	return &PostgresRepository{pool: pool}
+// NewPostgresRepository создаёт экземпляр. Изменения агрегата пишутся
+// в таблицу customers и в поток событий в одной транзакции.
+func NewPostgresRepository(pool *pgxpool.Pool, events *EventStore) *PostgresRepository {
+	return &PostgresRepository{pool: pool, events: events}
 }
 
 // ExistsByEmail проверяет наличие клиента по email.
 func (r *PostgresRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
-	const query = `SELECT true FROM customers WHERE email = $1 LIMIT 1`
+	const query = `SELECT true FROM customers WHERE tenant_id = $1 AND email = $2 LIMIT 1`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return false, err
+	}
 
 	var exists bool
-	if err := r.pool.QueryRow(ctx, query, email).Scan(&exists); err != nil {
+	if err := conn(ctx, r.pool).QueryRow(ctx, query, tenant, email).Scan(&exists); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return false, nil
 		}
@@ -41,33 +51,196 @@ func (r *PostgresRepository) ExistsByEmail(ctx context.Context, email string) (b
 
 // Save сохраняет нового клиента.
 func (r *PostgresRepository) Save(ctx context.Context, customer *models.Customer) error {
-	const stmt = `INSERT INTO customers (
-        id, email, full_name, phone_number, birth_date, created_at, updated_at, version
-    ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
-
-	_, err := r.pool.Exec(ctx, stmt,
-		customer.ID(),
-		customer.Email().String(),
-		customer.FullName(),
-		customer.PhoneNumber().String(),
-		customer.BirthDate(),
-		customer.CreatedAt(),
-		customer.UpdatedAt(),
-		customer.Version(),
-	)
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return withinTx(ctx, r.pool, func(ctx context.Context) error {
+		if err := insertCustomer(ctx, tenant, customer); err != nil {
+			return err
+		}
+
+		if err := saveContactSettings(ctx, conn(ctx, r.pool), tenant, customer); err != nil {
+			return err
+		}
+
+		if err := saveReferral(ctx, conn(ctx, r.pool), tenant, customer); err != nil {
+			return err
+		}
+
+		return r.events.Append(ctx, customer)
+	})
+}
+
+// Update сохраняет изменения клиента с оптимистичной блокировкой по версии.
+func (r *PostgresRepository) Update(ctx context.Context, customer *models.Customer) error {
+	const stmt = `UPDATE customers
+        SET email = $2, full_name = $3, phone_number = $4, birth_date = $5, updated_at = $6, version = $7, merged_into = $9
+        WHERE id = $1 AND version = $8 AND tenant_id = $10`
+
+	tenant, err := tenantID(ctx)
 	if err != nil {
-		return fmt.Errorf("postgres save customer: %w", err)
+		return err
+	}
+
+	var mergedInto *uuid.UUID
+	if survivorID, ok := customer.MergedInto(); ok {
+		mergedInto = &survivorID
+	}
+
+	return withinTx(ctx, r.pool, func(ctx context.Context) error {
+		tag, err := conn(ctx, r.pool).Exec(ctx, stmt,
+			customer.ID(),
+			customer.Email().String(),
+			customer.FullName(),
+			customer.PhoneNumber().String(),
+			customer.BirthDate(),
+			customer.UpdatedAt(),
+			customer.Version(),
+			customer.PersistedVersion(),
+			mergedInto,
+			tenant,
+		)
+		if err != nil {
+			return fmt.Errorf("postgres update customer: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrConcurrentModification
+		}
+
+		if err := saveContactSettings(ctx, conn(ctx, r.pool), tenant, customer); err != nil {
+			return err
+		}
+
+		return r.events.Append(ctx, customer)
+	})
+}
+
+// RedirectMerged переносит ранее слитых в fromID клиентов на toID,
+// чтобы перенаправление всегда занимало не более одного шага.
+func (r *PostgresRepository) RedirectMerged(ctx context.Context, fromID, toID string) error {
+	const stmt = `UPDATE customers SET merged_into = $2 WHERE merged_into = $1 AND tenant_id = $3`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn(ctx, r.pool).Exec(ctx, stmt, fromID, toID, tenant); err != nil {
+		return fmt.Errorf("postgres redirect merged customers: %w", err)
 	}
 
 	return nil
 }
 
-// GetByID возвращает клиента по идентификатору.
+// Erase обезличивает строку клиента и слитых с ним дубликатов, удаляет их
+// согласия и реферальные связи и закрывает поток событием удаления.
+// Вызывается после customer.Erase.
+func (r *PostgresRepository) Erase(ctx context.Context, customer *models.Customer) error {
+	const (
+		customerStmt = `UPDATE customers
+        SET email = 'erased:' || id::text, full_name = '', phone_number = '', birth_date = $2,
+            language = '', timezone = '', preferred_channel = '', updated_at = $3, version = $4, erased_at = $3
+        WHERE id = $1 AND version = $5 AND tenant_id = $6`
+		duplicatesQuery = `SELECT id FROM customers WHERE merged_into = $1 AND tenant_id = $2`
+		duplicatesStmt  = `UPDATE customers
+        SET email = 'erased:' || id::text, full_name = '', phone_number = '', birth_date = $2,
+            language = '', timezone = '', preferred_channel = '', erased_at = $3
+        WHERE id = ANY($1) AND tenant_id = $4`
+		consentsStmt  = `DELETE FROM customer_consents WHERE customer_id = ANY($1) AND tenant_id = $2`
+		referralsStmt = `DELETE FROM customer_referrals WHERE (referred_id = ANY($1) OR referrer_id = ANY($1)) AND tenant_id = $2`
+	)
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return withinTx(ctx, r.pool, func(ctx context.Context) error {
+		q := conn(ctx, r.pool)
+
+		tag, err := q.Exec(ctx, customerStmt,
+			customer.ID(),
+			customer.BirthDate(),
+			customer.UpdatedAt(),
+			customer.Version(),
+			customer.PersistedVersion(),
+			tenant,
+		)
+		if err != nil {
+			return fmt.Errorf("postgres erase customer: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrConcurrentModification
+		}
+
+		duplicates, err := r.mergedDuplicates(ctx, q, duplicatesQuery, customer.ID(), tenant)
+		if err != nil {
+			return err
+		}
+		if len(duplicates) > 0 {
+			if _, err := q.Exec(ctx, duplicatesStmt, duplicates, customer.BirthDate(), customer.UpdatedAt(), tenant); err != nil {
+				return fmt.Errorf("postgres erase merged customers: %w", err)
+			}
+		}
+
+		ids := append([]uuid.UUID{customer.ID()}, duplicates...)
+		if _, err := q.Exec(ctx, consentsStmt, ids, tenant); err != nil {
+			return fmt.Errorf("postgres erase customer consents: %w", err)
+		}
+		if _, err := q.Exec(ctx, referralsStmt, ids, tenant); err != nil {
+			return fmt.Errorf("postgres erase customer referrals: %w", err)
+		}
+
+		if err := r.events.Append(ctx, customer); err != nil {
+			return err
+		}
+
+		return r.events.EraseHistory(ctx, customer, duplicates)
+	})
+}
+
+func (r *PostgresRepository) mergedDuplicates(ctx context.Context, q querier, query string, id uuid.UUID, tenant string) ([]uuid.UUID, error) {
+	rows, err := q.Query(ctx, query, id, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("postgres list merged customers: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var duplicateID uuid.UUID
+		if err := rows.Scan(&duplicateID); err != nil {
+			return nil, fmt.Errorf("postgres scan merged customer: %w", err)
+		}
+		ids = append(ids, duplicateID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres list merged customers: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetByID возвращает клиента по идентификатору. Идентификатор слитого
+// дубликата перенаправляется на основного клиента. Удалённый клиент
+// считается ненайденным.
 func (r *PostgresRepository) GetByID(ctx context.Context, id string) (*models.Customer, error) {
-	const query = `SELECT id, email, full_name, phone_number, birth_date, created_at, updated_at, version
-        FROM customers WHERE id = $1`
+	const query = `SELECT id, email, full_name, phone_number, birth_date, created_at, updated_at, version,
+        language, timezone, preferred_channel, referral_code,
+        (SELECT referrer_id FROM customer_referrals cr WHERE cr.referred_id = customers.id AND cr.tenant_id = $2)
+        FROM customers
+        WHERE tenant_id = $2 AND erased_at IS NULL AND id = COALESCE((SELECT merged_into FROM customers WHERE id = $1 AND tenant_id = $2), $1)`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	row := r.pool.QueryRow(ctx, query, id)
+	q := conn(ctx, r.pool)
+	row := q.QueryRow(ctx, query, id, tenant)
 
 	var (
 		customerID uuid.UUID
@@ -78,11 +251,16 @@ func (r *PostgresRepository) GetByID(ctx context.Context, id string) (*models.Cu
 		createdAt  time.Time
 		updatedAt  time.Time
 		version    int
+		language   string
+		timezone   string
+		channel    string
+		code       string
+		referredBy *uuid.UUID
 	)
 
-	if err := row.Scan(&customerID, &emailRaw, &fullName, &phoneRaw, &birthDate, &createdAt, &updatedAt, &version); err != nil {
+	if err := row.Scan(&customerID, &emailRaw, &fullName, &phoneRaw, &birthDate, &createdAt, &updatedAt, &version, &language, &timezone, &channel, &code, &referredBy); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("customer not found: %w", err)
+			return nil, fmt.Errorf("postgres get by id: %w", ErrCustomerNotFound)
 		}
 		return nil, fmt.Errorf("postgres get by id: %w", err)
 	}
@@ -108,5 +286,25 @@ func (r *PostgresRepository) GetByID(ctx context.Context, id string) (*models.Cu
 		version,
 	)
 
-	return customer, nil
+	preferences, err := models.ParsePreferences(language, timezone, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	consents, err := loadConsents(ctx, q, tenant, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	referralCode, err := valueobjects.ParseReferralCode(code)
+	if err != nil {
+		return nil, err
+	}
+	var referrerID uuid.UUID
+	if referredBy != nil {
+		referrerID = *referredBy
+	}
+	customer.WithReferral(referralCode, referrerID)
+
+	return customer.WithContactSettings(preferences, consents), nil
 }
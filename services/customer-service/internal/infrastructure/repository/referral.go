@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// GetByReferralCode возвращает владельца реферального кода. Код слитого
+// дубликата продолжает работать и ведёт на основного клиента.
+func (r *PostgresRepository) GetByReferralCode(ctx context.Context, code valueobjects.ReferralCode) (*models.Customer, error) {
+	const query = `SELECT id FROM customers WHERE tenant_id = $1 AND referral_code = $2 AND erased_at IS NULL`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var id uuid.UUID
+	if err := conn(ctx, r.pool).QueryRow(ctx, query, tenant, code.String()).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, valueobjects.ErrUnknownReferralCode
+		}
+		return nil, fmt.Errorf("postgres get by referral code: %w", err)
+	}
+
+	return r.GetByID(ctx, id.String())
+}
+
+// ListReferrals возвращает приглашённых клиентом в порядке привлечения,
+// начиная после позиции (afterAt, afterID).
+func (r *PostgresRepository) ListReferrals(ctx context.Context, referrerID uuid.UUID, afterAt time.Time, afterID uuid.UUID, limit int) ([]models.Referral, error) {
+	const query = `SELECT cr.referred_id, c.full_name, cr.referral_code, cr.referred_at
+        FROM customer_referrals cr JOIN customers c ON c.id = cr.referred_id
+        WHERE cr.tenant_id = $1 AND c.tenant_id = $1 AND cr.referrer_id = $2
+          AND (cr.referred_at, cr.referred_id) > ($3, $4)
+        ORDER BY cr.referred_at, cr.referred_id LIMIT $5`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, tenant, referrerID, afterAt, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("postgres list referrals: %w", err)
+	}
+	defer rows.Close()
+
+	var referrals []models.Referral
+	for rows.Next() {
+		var referral models.Referral
+		if err := rows.Scan(&referral.ReferredID, &referral.FullName, &referral.ReferralCode, &referral.ReferredAt); err != nil {
+			return nil, fmt.Errorf("postgres scan referral: %w", err)
+		}
+		referrals = append(referrals, referral)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres list referrals: %w", err)
+	}
+
+	return referrals, nil
+}
+
+const (
+	// referralCodeIndex — уникальный индекс кодов из миграции 000009.
+	referralCodeIndex = "customers_tenant_referral_code_idx"
+	// referralCodeAttempts ограничивает число новых кодов при совпадении с занятым.
+	referralCodeAttempts = 5
+)
+
+// insertCustomer вставляет строку клиента. Если реферальный код уже занят в арендаторе,
+// клиент получает новый код и вставка повторяется под точкой сохранения.
+func insertCustomer(ctx context.Context, tenant string, customer *models.Customer) error {
+	const stmt = `INSERT INTO customers (
+        id, email, full_name, phone_number, birth_date, created_at, updated_at, version, tenant_id, referral_code
+    ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	for attempt := 1; ; attempt++ {
+		err := withinSavepoint(ctx, func(q querier) error {
+			_, err := q.Exec(ctx, stmt,
+				customer.ID(),
+				customer.Email().String(),
+				customer.FullName(),
+				customer.PhoneNumber().String(),
+				customer.BirthDate(),
+				customer.CreatedAt(),
+				customer.UpdatedAt(),
+				customer.Version(),
+				tenant,
+				customer.ReferralCode().String(),
+			)
+			return err
+		})
+		if err == nil {
+			return nil
+		}
+		if !isReferralCodeConflict(err) || attempt == referralCodeAttempts {
+			return fmt.Errorf("postgres save customer: %w", err)
+		}
+		if err := customer.RegenerateReferralCode(); err != nil {
+			return err
+		}
+	}
+}
+
+func isReferralCodeConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == referralCodeIndex
+}
+
+func saveReferral(ctx context.Context, q querier, tenant string, customer *models.Customer) error {
+	const stmt = `INSERT INTO customer_referrals (referred_id, referrer_id, referral_code, referred_at, tenant_id)
+        SELECT $1, id, referral_code, $3, tenant_id FROM customers WHERE id = $2 AND tenant_id = $4
+        ON CONFLICT (referred_id) DO NOTHING`
+
+	referrerID, ok := customer.ReferredBy()
+	if !ok {
+		return nil
+	}
+
+	if _, err := q.Exec(ctx, stmt, customer.ID(), referrerID, customer.UpdatedAt(), tenant); err != nil {
+		return fmt.Errorf("postgres save referral: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// EnableTenantSession выставляет app.tenant_id для каждого выданного соединения
+// по арендатору из контекста. Политики RLS сравнивают с ним tenant_id строк,
+// поэтому запрос без арендатора не увидит и не запишет ни одной строки.
+func EnableTenantSession(cfg *pgxpool.Config) {
+	cfg.BeforeAcquire = func(ctx context.Context, c *pgx.Conn) bool {
+		id, _ := tenant.FromContext(ctx)
+		_, err := c.Exec(ctx, `SELECT set_config('app.tenant_id', $1, false)`, id.String())
+		return err == nil
+	}
+}
+
+// tenantID возвращает арендатора запроса; без него репозитории не выполняют запросы.
+func tenantID(ctx context.Context) (string, error) {
+	id, err := tenant.Require(ctx)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
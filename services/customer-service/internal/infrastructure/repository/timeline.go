@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const timelineColumns = `id, customer_id, type, body, author_id, author_role, visibility, pinned, COALESCE(source_id, ''), created_at, updated_at`
+
+// TimelineRepository хранит ленту взаимодействий с клиентами в PostgreSQL.
+type TimelineRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTimelineRepository создаёт экземпляр.
+func NewTimelineRepository(pool *pgxpool.Pool) *TimelineRepository {
+	return &TimelineRepository{pool: pool}
+}
+
+// Add сохраняет новую запись. Повторная системная запись о том же событии игнорируется.
+func (r *TimelineRepository) Add(ctx context.Context, entry *models.TimelineEntry) error {
+	const stmt = `INSERT INTO customer_timeline (
+        id, customer_id, type, body, author_id, author_role, visibility, pinned, source_id, created_at, updated_at, tenant_id
+    ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), $10, $11, $12)
+    ON CONFLICT (tenant_id, customer_id, source_id) WHERE source_id IS NOT NULL DO NOTHING`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn(ctx, r.pool).Exec(ctx, stmt,
+		entry.ID(),
+		entry.CustomerID(),
+		string(entry.Type()),
+		entry.Body(),
+		entry.AuthorID(),
+		entry.AuthorRole(),
+		string(entry.Visibility()),
+		entry.Pinned(),
+		entry.SourceID(),
+		entry.CreatedAt(),
+		entry.UpdatedAt(),
+		tenant,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres add timeline entry: %w", err)
+	}
+
+	return nil
+}
+
+// Update сохраняет изменённую или удалённую запись.
+func (r *TimelineRepository) Update(ctx context.Context, entry *models.TimelineEntry) error {
+	const stmt = `UPDATE customer_timeline
+        SET body = $2, visibility = $3, pinned = $4, updated_at = $5, deleted_at = $6
+        WHERE id = $1 AND tenant_id = $7 AND deleted_at IS NULL`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	var deletedAt *time.Time
+	if at := entry.DeletedAt(); !at.IsZero() {
+		deletedAt = &at
+	}
+
+	tag, err := conn(ctx, r.pool).Exec(ctx, stmt,
+		entry.ID(),
+		entry.Body(),
+		string(entry.Visibility()),
+		entry.Pinned(),
+		entry.UpdatedAt(),
+		deletedAt,
+		tenant,
+	)
+	if err != nil {
+		return fmt.Errorf("postgres update timeline entry: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return valueobjects.ErrTimelineEntryNotFound
+	}
+
+	return nil
+}
+
+// GetByID возвращает неудалённую запись ленты.
+func (r *TimelineRepository) GetByID(ctx context.Context, id string) (*models.TimelineEntry, error) {
+	query := `SELECT ` + timelineColumns + ` FROM customer_timeline
+        WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entryID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, valueobjects.ErrTimelineEntryNotFound
+	}
+
+	entry, err := scanTimelineEntry(conn(ctx, r.pool).QueryRow(ctx, query, entryID, tenant))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, valueobjects.ErrTimelineEntryNotFound
+		}
+		return nil, fmt.Errorf("postgres get timeline entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// ListTimeline возвращает страницу ленты клиента в пределах области видимости.
+// В ленту основного клиента входят записи слитых с ним дубликатов.
+func (r *TimelineRepository) ListTimeline(ctx context.Context, filter models.TimelineFilter) ([]*models.TimelineEntry, error) {
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make([]string, 0, len(filter.Scope.Types))
+	for _, entryType := range filter.Scope.Types {
+		types = append(types, string(entryType))
+	}
+
+	conditions := []string{
+		"tenant_id = $1",
+		"deleted_at IS NULL",
+		"(customer_id = $2 OR customer_id IN (SELECT id FROM customers WHERE merged_into = $2 AND tenant_id = $1))",
+		"type = ANY($3)",
+		"(visibility = 'staff' OR author_id = $4 OR (visibility = 'managers' AND $5))",
+	}
+	args := []any{tenant, filter.CustomerID, types, filter.Scope.ViewerID, filter.Scope.Managers}
+
+	if filter.After != nil {
+		args = append(args, filter.After.Pinned, filter.After.CreatedAt, filter.After.ID)
+		conditions = append(conditions, fmt.Sprintf("(pinned, created_at, id) < ($%d, $%d, $%d)", len(args)-2, len(args)-1, len(args)))
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`SELECT %s FROM customer_timeline WHERE %s
+        ORDER BY pinned DESC, created_at DESC, id DESC LIMIT $%d`, timelineColumns, strings.Join(conditions, " AND "), len(args))
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres list timeline: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.TimelineEntry
+	for rows.Next() {
+		entry, err := scanTimelineEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("postgres scan timeline entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres list timeline: %w", err)
+	}
+
+	return entries, nil
+}
+
+func scanTimelineEntry(row pgx.Row) (*models.TimelineEntry, error) {
+	var (
+		id         uuid.UUID
+		customerID uuid.UUID
+		entryType  string
+		body       string
+		authorID   string
+		authorRole string
+		visibility string
+		pinned     bool
+		sourceID   string
+		createdAt  time.Time
+		updatedAt  time.Time
+	)
+
+	if err := row.Scan(&id, &customerID, &entryType, &body, &authorID, &authorRole, &visibility, &pinned, &sourceID, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	return models.RehydrateTimelineEntry(
+		id,
+		customerID,
+		valueobjects.EntryType(entryType),
+		body,
+		authorID,
+		authorRole,
+		valueobjects.Visibility(visibility),
+		pinned,
+		sourceID,
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+// EraseCustomer удаляет ленту клиента и слитых с ним дубликатов, включая
+// записи, скрытые ранее мягким удалением.
+func (r *TimelineRepository) EraseCustomer(ctx context.Context, customerID uuid.UUID) error {
+	const stmt = `DELETE FROM customer_timeline
+        WHERE tenant_id = $1
+          AND (customer_id = $2 OR customer_id IN (SELECT id FROM customers WHERE merged_into = $2 AND tenant_id = $1))`
+
+	tenant, err := tenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn(ctx, r.pool).Exec(ctx, stmt, tenant, customerID); err != nil {
+		return fmt.Errorf("postgres erase customer timeline: %w", err)
+	}
+
+	return nil
+}
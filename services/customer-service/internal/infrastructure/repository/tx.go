@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type txKey struct{}
+
+// querier объединяет общие методы pgxpool.Pool и pgx.Tx.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+}
+
+// TxManager выполняет операции репозиториев в одной транзакции PostgreSQL.
+type TxManager struct {
+	pool *pgxpool.Pool
+}
+
+// NewTxManager создаёт менеджер транзакций.
+func NewTxManager(pool *pgxpool.Pool) *TxManager {
+	return &TxManager{pool: pool}
+}
+
+// WithinTransaction выполняет fn в транзакции, переданной через контекст.
+// Вложенные вызовы переиспользуют уже открытую транзакцию.
+func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withinTx(ctx, m.pool, fn)
+}
+
+func withinTx(ctx context.Context, pool *pgxpool.Pool, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres begin tx: %w", err)
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// withinSavepoint выполняет fn под точкой сохранения открытой транзакции: ошибка
+// запроса откатывает только его, и транзакцию можно продолжить.
+func withinSavepoint(ctx context.Context, fn func(q querier) error) error {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+	if !ok {
+		return errors.New("postgres savepoint: no active transaction")
+	}
+
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres savepoint: %w", err)
+	}
+
+	if err := fn(savepoint); err != nil {
+		_ = savepoint.Rollback(ctx)
+		return err
+	}
+
+	if err := savepoint.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres release savepoint: %w", err)
+	}
+
+	return nil
+}
+
+// conn возвращает активную транзакцию из контекста или пул соединений.
+func conn(ctx context.Context, pool *pgxpool.Pool) querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return pool
+}
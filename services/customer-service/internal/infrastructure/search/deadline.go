@@ -0,0 +1,25 @@
+package search
+
+import (
+	"net/http"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/deadline"
+)
+
+// DeadlineTransport отмечает этап "opensearch", если HTTP-запрос оборвался по дедлайну вызова.
+func DeadlineTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return deadlineTransport{next: next}
+}
+
+type deadlineTransport struct {
+	next http.RoundTripper
+}
+
+func (t deadlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	deadline.Observe(req.Context(), "opensearch", err)
+	return resp, err
+}
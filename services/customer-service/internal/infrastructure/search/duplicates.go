@@ -0,0 +1,102 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/dedup"
+)
+
+// FindCandidates ищет в индексе клиентов, похожих на probe по телефону,
+// дате рождения или имени. Итоговое ранжирование выполняет вызывающая сторона.
+func (i *Indexer) FindCandidates(ctx context.Context, probe dedup.Profile, size int) ([]dedup.Profile, error) {
+	var should []map[string]interface{}
+
+	if probe.PhoneNormalized != "" {
+		should = append(should, map[string]interface{}{
+			"term": map[string]interface{}{"phone_normalized": probe.PhoneNormalized},
+		})
+	}
+	if !probe.BirthDate.IsZero() {
+		should = append(should, map[string]interface{}{
+			"term": map[string]interface{}{"birth_date": probe.BirthDate.Format("2006-01-02")},
+		})
+	}
+	if probe.FullName != "" {
+		should = append(should, map[string]interface{}{
+			"match": map[string]interface{}{
+				"full_name": map[string]interface{}{"query": probe.FullName, "fuzziness": "AUTO"},
+			},
+		})
+	}
+
+	if len(should) == 0 {
+		return nil, nil
+	}
+
+	query := map[string]interface{}{
+		"size": size,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should":               should,
+				"minimum_should_match": 1,
+			},
+		},
+	}
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, fmt.Errorf("marshal duplicate query: %w", err)
+	}
+
+	alias, _, err := i.tenantAlias(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := i.client.Search(
+		i.client.Search.WithContext(ctx),
+		i.client.Search.WithIndex(alias),
+		i.client.Search.WithBody(bytesReader(body)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("search duplicate candidates: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.IsError() {
+		return nil, fmt.Errorf("search duplicate candidates: status %s", response.Status())
+	}
+
+	var result struct {
+		Hits struct {
+			Hits []struct {
+				Source struct {
+					ID              string    `json:"id"`
+					Email           string    `json:"email"`
+					FullName        string    `json:"full_name"`
+					PhoneNormalized string    `json:"phone_normalized"`
+					BirthDate       time.Time `json:"birth_date"`
+				} `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode duplicate candidates: %w", err)
+	}
+
+	profiles := make([]dedup.Profile, 0, len(result.Hits.Hits))
+	for _, hit := range result.Hits.Hits {
+		profiles = append(profiles, dedup.Profile{
+			CustomerID:      hit.Source.ID,
+			FullName:        hit.Source.FullName,
+			Email:           hit.Source.Email,
+			PhoneNormalized: hit.Source.PhoneNormalized,
+			BirthDate:       hit.Source.BirthDate,
+		})
+	}
+
+	return profiles, nil
+}
@@ -5,15 +5,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"sync"
 
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"github.com/google/uuid"
 	opensearch "github.com/opensearch-project/opensearch-go/v2"
 )
 
 // Indexer отвечает за индексацию клиентов в OpenSearch/Elasticsearch.
+// Все операции идут через псевдоним индекса арендатора.
 type Indexer struct {
-	client *opensearch.Client
-	index  string
+	client  *opensearch.Client
+	index   string
+	aliases sync.Map
 }
 
 // NewIndexer создаёт новый индексатор.
@@ -23,22 +29,17 @@ func NewIndexer(client *opensearch.Client, index string) *Indexer {
 
 // Index публикует клиента в поисковый индекс.
 func (i *Indexer) Index(ctx context.Context, customer *models.Customer) error {
-	payload := map[string]interface{}{
-		"id":           customer.ID().String(),
-		"email":        customer.Email().String(),
-		"full_name":    customer.FullName(),
-		"phone_number": customer.PhoneNumber().String(),
-		"birth_date":   customer.BirthDate(),
-		"created_at":   customer.CreatedAt(),
-		"updated_at":   customer.UpdatedAt(),
+	alias, tenantID, err := i.tenantAlias(ctx)
+	if err != nil {
+		return err
 	}
 
-	body, err := json.Marshal(payload)
+	body, err := json.Marshal(document(customer, tenantID))
 	if err != nil {
 		return fmt.Errorf("marshal search payload: %w", err)
 	}
 
-	response, err := i.client.Index(i.index, bytesReader(body), i.client.Index.WithContext(ctx), i.client.Index.WithDocumentID(customer.ID().String()), i.client.Index.WithRefresh("true"))
+	response, err := i.client.Index(alias, bytesReader(body), i.client.Index.WithContext(ctx), i.client.Index.WithDocumentID(customer.ID().String()), i.client.Index.WithRefresh("true"))
 	if err != nil {
 		return fmt.Errorf("index customer: %w", err)
 	}
@@ -51,6 +52,104 @@ func (i *Indexer) Index(ctx context.Context, customer *models.Customer) error {
 	return nil
 }
 
+// Delete удаляет клиента из поискового индекса. Отсутствие документа не считается ошибкой.
+func (i *Indexer) Delete(ctx context.Context, customerID string) error {
+	alias, _, err := i.tenantAlias(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, err := i.client.Delete(alias, customerID, i.client.Delete.WithContext(ctx), i.client.Delete.WithRefresh("true"))
+	if err != nil {
+		return fmt.Errorf("delete customer from index: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.IsError() && response.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("delete customer from index: status %s", response.Status())
+	}
+
+	return nil
+}
+
+// EraseCustomer удаляет документ клиента из индекса при удалении его данных.
+func (i *Indexer) EraseCustomer(ctx context.Context, customerID uuid.UUID) error {
+	return i.Delete(ctx, customerID.String())
+}
+
+// IndexBatch публикует пачку клиентов одним bulk-запросом.
+func (i *Indexer) IndexBatch(ctx context.Context, customers []*models.Customer) error {
+	if len(customers) == 0 {
+		return nil
+	}
+
+	alias, tenantID, err := i.tenantAlias(ctx)
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, customer := range customers {
+		action := map[string]interface{}{"index": map[string]string{"_index": alias, "_id": customer.ID().String()}}
+		if err := encoder.Encode(action); err != nil {
+			return fmt.Errorf("marshal bulk action: %w", err)
+		}
+		if err := encoder.Encode(document(customer, tenantID)); err != nil {
+			return fmt.Errorf("marshal search payload: %w", err)
+		}
+	}
+
+	response, err := i.client.Bulk(&body, i.client.Bulk.WithContext(ctx), i.client.Bulk.WithRefresh("wait_for"))
+	if err != nil {
+		return fmt.Errorf("bulk index customers: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.IsError() {
+		return fmt.Errorf("bulk index customers: status %s", response.Status())
+	}
+
+	var result struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			ID     string `json:"_id"`
+			Status int    `json:"status"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode bulk response: %w", err)
+	}
+	if !result.Errors {
+		return nil
+	}
+
+	failed := 0
+	for _, item := range result.Items {
+		for _, outcome := range item {
+			if outcome.Status >= http.StatusBadRequest {
+				failed++
+			}
+		}
+	}
+
+	return fmt.Errorf("bulk index customers: %d of %d documents failed", failed, len(customers))
+}
+
+func document(customer *models.Customer, tenantID tenant.ID) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               customer.ID().String(),
+		"tenant_id":        tenantID.String(),
+		"email":            customer.Email().String(),
+		"full_name":        customer.FullName(),
+		"phone_number":     customer.PhoneNumber().String(),
+		"phone_normalized": customer.PhoneNumber().Normalized(),
+		"birth_date":       customer.BirthDate(),
+		"created_at":       customer.CreatedAt(),
+		"updated_at":       customer.UpdatedAt(),
+	}
+}
+
 func bytesReader(b []byte) *bytes.Reader {
 	return bytes.NewReader(b)
 }
@@ -0,0 +1,51 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+)
+
+// tenantAlias возвращает псевдоним индекса арендатора из контекста и при первом
+// обращении создаёт его. Псевдоним фильтрует документы по tenant_id и задаёт
+// routing, поэтому запись и поиск через него не выходят за пределы арендатора.
+func (i *Indexer) tenantAlias(ctx context.Context) (string, tenant.ID, error) {
+	id, err := tenant.Require(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	alias := fmt.Sprintf("%s-%s", i.index, id)
+	if _, ok := i.aliases.Load(alias); ok {
+		return alias, id, nil
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"actions": []map[string]interface{}{{
+			"add": map[string]interface{}{
+				"index":   i.index,
+				"alias":   alias,
+				"filter":  map[string]interface{}{"term": map[string]interface{}{"tenant_id": id.String()}},
+				"routing": id.String(),
+			},
+		}},
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("marshal tenant alias: %w", err)
+	}
+
+	response, err := i.client.Indices.UpdateAliases(bytesReader(body), i.client.Indices.UpdateAliases.WithContext(ctx))
+	if err != nil {
+		return "", "", fmt.Errorf("create tenant alias: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.IsError() {
+		return "", "", fmt.Errorf("create tenant alias %s: status %s", alias, response.Status())
+	}
+
+	i.aliases.Store(alias, struct{}{})
+	return alias, id, nil
+}
@@ -0,0 +1,183 @@
+package spreadsheet
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/application/commands"
+	"github.com/xuri/excelize/v2"
+)
+
+// ErrUnsupportedFormat возвращается для файлов, отличных от CSV и XLSX.
+var ErrUnsupportedFormat = errors.New("unsupported import file format")
+
+// columnAliases сопоставляет заголовки файла с полями строки импорта.
+var columnAliases = map[string]string{
+	"full_name":    "full_name",
+	"name":         "full_name",
+	"fullname":     "full_name",
+	"email":        "email",
+	"e-mail":       "email",
+	"phone_number": "phone_number",
+	"phone":        "phone_number",
+	"birth_date":   "birth_date",
+	"birthdate":    "birth_date",
+	"birthday":     "birth_date",
+}
+
+var requiredColumns = []string{"full_name", "email", "phone_number", "birth_date"}
+
+// rowReader возвращает очередную строку файла как срез ячеек.
+type rowReader func() ([]string, error)
+
+// Source читает строки импорта из табличного файла с заголовком.
+type Source struct {
+	read    rowReader
+	columns map[string]int
+	line    int
+	close   func() error
+}
+
+// Open открывает источник по расширению файла: .csv или .xlsx.
+func Open(name string, r io.Reader) (*Source, error) {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return NewCSVSource(r)
+	case ".xlsx":
+		return NewXLSXSource(r)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, name)
+	}
+}
+
+// NewCSVSource создаёт источник поверх CSV. Разделитель — запятая или точка с запятой.
+func NewCSVSource(r io.Reader) (*Source, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+	if len(header) == 1 && strings.Contains(header[0], ";") {
+		header = strings.Split(header[0], ";")
+		reader.Comma = ';'
+	}
+
+	return newSource(header, reader.Read, nil)
+}
+
+// NewXLSXSource создаёт источник поверх первого листа книги XLSX.
+func NewXLSXSource(r io.Reader) (*Source, error) {
+	book, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("open xlsx: %w", err)
+	}
+
+	rows, err := book.Rows(book.GetSheetName(0))
+	if err != nil {
+		_ = book.Close()
+		return nil, fmt.Errorf("read xlsx sheet: %w", err)
+	}
+
+	read := func() ([]string, error) {
+		if !rows.Next() {
+			if err := rows.Error(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		return rows.Columns()
+	}
+	closeFn := func() error {
+		_ = rows.Close()
+		return book.Close()
+	}
+
+	header, err := read()
+	if err != nil {
+		_ = closeFn()
+		return nil, fmt.Errorf("read xlsx header: %w", err)
+	}
+
+	return newSource(header, read, closeFn)
+}
+
+func newSource(header []string, read rowReader, closeFn func() error) (*Source, error) {
+	columns := make(map[string]int)
+	for i, name := range header {
+		key := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if field, ok := columnAliases[key]; ok {
+			columns[field] = i
+		}
+	}
+
+	for _, column := range requiredColumns {
+		if _, ok := columns[column]; !ok {
+			if closeFn != nil {
+				_ = closeFn()
+			}
+			return nil, fmt.Errorf("missing column %q in header", column)
+		}
+	}
+
+	return &Source{read: read, columns: columns, line: 1, close: closeFn}, nil
+}
+
+// Next возвращает следующую непустую строку; номер строки считается от заголовка.
+func (s *Source) Next(ctx context.Context) (commands.ImportRow, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return commands.ImportRow{}, err
+		}
+
+		cells, err := s.read()
+		if err != nil {
+			return commands.ImportRow{}, err
+		}
+		s.line++
+
+		if isBlank(cells) {
+			continue
+		}
+
+		return commands.ImportRow{
+			Line:        s.line,
+			FullName:    s.cell(cells, "full_name"),
+			Email:       s.cell(cells, "email"),
+			PhoneNumber: s.cell(cells, "phone_number"),
+			BirthDate:   s.cell(cells, "birth_date"),
+		}, nil
+	}
+}
+
+// Close освобождает ресурсы источника.
+func (s *Source) Close() error {
+	if s.close == nil {
+		return nil
+	}
+	return s.close()
+}
+
+func (s *Source) cell(cells []string, column string) string {
+	index := s.columns[column]
+	if index >= len(cells) {
+		return ""
+	}
+	return strings.TrimSpace(cells[index])
+}
+
+func isBlank(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
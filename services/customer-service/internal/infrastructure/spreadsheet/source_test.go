@@ -0,0 +1,85 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+func TestCSVSource(t *testing.T) {
+	input := "Email;Name;Phone;Birthday\njohn@example.com;John Doe;+1234567890;1990-05-10\n;;;\njane@example.com;Jane Doe;+1234567891;1991-06-11\n"
+
+	source, err := NewCSVSource(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	first, err := source.Next(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.Line != 2 || first.Email != "john@example.com" || first.FullName != "John Doe" || first.BirthDate != "1990-05-10" {
+		t.Fatalf("unexpected first row: %+v", first)
+	}
+
+	second, err := source.Next(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second.Line != 4 || second.PhoneNumber != "+1234567891" {
+		t.Fatalf("expected blank line to be skipped, got %+v", second)
+	}
+
+	if _, err := source.Next(context.Background()); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
+
+func TestCSVSource_MissingColumn(t *testing.T) {
+	if _, err := NewCSVSource(strings.NewReader("email,name\n")); err == nil {
+		t.Fatalf("expected missing column error")
+	}
+}
+
+func TestXLSXSource(t *testing.T) {
+	book := excelize.NewFile()
+	sheet := book.GetSheetName(0)
+	rows := [][]interface{}{
+		{"full_name", "email", "phone_number", "birth_date"},
+		{"John Doe", "john@example.com", "+1234567890", "1990-05-10"},
+	}
+	for i, row := range rows {
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := book.SetSheetRow(sheet, cell, &row); err != nil {
+			t.Fatalf("set row: %v", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := book.Write(&buf); err != nil {
+		t.Fatalf("write xlsx: %v", err)
+	}
+
+	source, err := Open("members.xlsx", &buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer source.Close()
+
+	row, err := source.Next(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if row.Line != 2 || row.Email != "john@example.com" || row.PhoneNumber != "+1234567890" {
+		t.Fatalf("unexpected row: %+v", row)
+	}
+
+	if _, err := source.Next(context.Background()); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+// Package gateway публикует CustomerService как REST/JSON API поверх gRPC.
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/api"
+	customerpb "github.com/evgeniySeleznev/nwHS/services/customer-service/api/gen/customer/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// forwardedHeaders — HTTP-заголовки, которые шлюз передаёт в gRPC-метаданные без префикса.
+var forwardedHeaders = map[string]struct{}{
+	"x-tenant-id":   {},
+	"x-actor-id":    {},
+	"x-actor-role":  {},
+	"x-request-id":  {},
+	"authorization": {},
+}
+
+// NewHandler регистрирует HTTP-маршруты CustomerService поверх соединения conn и
+// добавляет /openapi.json, CORS и ответы об ошибках в формате problem+json.
+// Запросы идут через обычный gRPC-сервер, поэтому на них действуют те же интерцепторы.
+func NewHandler(ctx context.Context, conn *grpc.ClientConn, cors CORSConfig, log *zap.Logger) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(matchHeader),
+		runtime.WithOutgoingHeaderMatcher(matchOutgoingHeader),
+		runtime.WithErrorHandler(problemHandler(log)),
+	)
+	if err := customerpb.RegisterCustomerServiceHandler(ctx, mux, conn); err != nil {
+		return nil, fmt.Errorf("gateway: register customer service: %w", err)
+	}
+	if err := mux.HandlePath(http.MethodGet, "/openapi.json", serveOpenAPI); err != nil {
+		return nil, fmt.Errorf("gateway: register openapi: %w", err)
+	}
+	return withCORS(mux, cors), nil
+}
+
+func matchHeader(key string) (string, bool) {
+	if _, ok := forwardedHeaders[strings.ToLower(key)]; ok {
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// matchOutgoingHeader отдаёт retry-after от ограничителя частоты как стандартный Retry-After.
+func matchOutgoingHeader(key string) (string, bool) {
+	if strings.EqualFold(key, "retry-after") {
+		return "Retry-After", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+func serveOpenAPI(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(api.OpenAPI)
+}
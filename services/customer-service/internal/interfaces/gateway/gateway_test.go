@@ -0,0 +1,182 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	customerpb "github.com/evgeniySeleznev/nwHS/services/customer-service/api/gen/customer/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeCustomerServer struct {
+	customerpb.UnimplementedCustomerServiceServer
+	tenant string
+}
+
+func (f *fakeCustomerServer) GetCustomer(ctx context.Context, req *customerpb.GetCustomerRequest) (*customerpb.GetCustomerResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("x-tenant-id"); len(values) > 0 {
+		f.tenant = values[0]
+	}
+	switch req.GetId() {
+	case "missing":
+		return nil, status.Error(codes.NotFound, "customer not found")
+	case "broken":
+		return nil, status.Error(codes.Internal, "pq: connection refused")
+	}
+	return &customerpb.GetCustomerResponse{Id: req.GetId(), FullName: "Иван Петров", Version: 3}, nil
+}
+
+func newTestGateway(t *testing.T, cors CORSConfig) (http.Handler, *fakeCustomerServer) {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	fake := &fakeCustomerServer{}
+	customerpb.RegisterCustomerServiceServer(srv, fake)
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	handler, err := NewHandler(context.Background(), conn, cors, zap.NewNop())
+	if err != nil {
+		t.Fatalf("new handler: %v", err)
+	}
+	return handler, fake
+}
+
+func TestGatewayMapsRoutesAndForwardsHeaders(t *testing.T) {
+	handler, fake := newTestGateway(t, CORSConfig{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/customers/c-1", nil)
+	req.Header.Set("X-Tenant-ID", "t-1")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if body["id"] != "c-1" || body["fullName"] != "Иван Петров" {
+		t.Fatalf("unexpected body %v", body)
+	}
+	if fake.tenant != "t-1" {
+		t.Fatalf("expected tenant header to be forwarded, got %q", fake.tenant)
+	}
+}
+
+func TestGatewayWritesProblemDetails(t *testing.T) {
+	handler, _ := newTestGateway(t, CORSConfig{})
+
+	cases := []struct {
+		path   string
+		status int
+		code   string
+		detail string
+	}{
+		{"/v1/customers/missing", http.StatusNotFound, "NotFound", "customer not found"},
+		{"/v1/customers/broken", http.StatusInternalServerError, "Internal", ""},
+		{"/v1/unknown", http.StatusNotFound, "NotFound", "Not Found"},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		req.Header.Set("X-Request-ID", "req-1")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != tc.status {
+			t.Fatalf("%s: expected %d, got %d", tc.path, tc.status, rec.Code)
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != problemContentType {
+			t.Fatalf("%s: unexpected content type %q", tc.path, ct)
+		}
+		var problem Problem
+		if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+			t.Fatalf("%s: decode: %v", tc.path, err)
+		}
+		if problem.Status != tc.status || problem.Code != tc.code || problem.Detail != tc.detail {
+			t.Fatalf("%s: unexpected problem %+v", tc.path, problem)
+		}
+		if problem.Instance != tc.path || problem.RequestID != "req-1" {
+			t.Fatalf("%s: expected instance and request id, got %+v", tc.path, problem)
+		}
+	}
+}
+
+func TestGatewayServesOpenAPI(t *testing.T) {
+	handler, _ := newTestGateway(t, CORSConfig{})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	var doc struct {
+		Paths map[string]any `json:"paths"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if _, ok := doc.Paths["/v1/customers/{id}"]; !ok {
+		t.Fatalf("expected customer path in openapi document")
+	}
+}
+
+func TestGatewayCORS(t *testing.T) {
+	handler, _ := newTestGateway(t, CORSConfig{
+		AllowedOrigins: []string{"https://admin.example.com"},
+		MaxAge:         10 * time.Minute,
+	})
+
+	preflight := httptest.NewRequest(http.MethodOptions, "/v1/customers/c-1", nil)
+	preflight.Header.Set("Origin", "https://admin.example.com")
+	preflight.Header.Set("Access-Control-Request-Method", http.MethodPatch)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, preflight)
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204 for preflight, got %d", rec.Code)
+	}
+	if rec.Header().Get("Access-Control-Allow-Origin") != "https://admin.example.com" {
+		t.Fatalf("expected origin to be allowed, got %v", rec.Header())
+	}
+	if !strings.Contains(rec.Header().Get("Access-Control-Allow-Methods"), http.MethodPatch) {
+		t.Fatalf("expected PATCH in allowed methods, got %q", rec.Header().Get("Access-Control-Allow-Methods"))
+	}
+	if rec.Header().Get("Access-Control-Max-Age") != "600" {
+		t.Fatalf("expected max age 600, got %q", rec.Header().Get("Access-Control-Max-Age"))
+	}
+
+	foreign := httptest.NewRequest(http.MethodGet, "/v1/customers/c-1", nil)
+	foreign.Header.Set("Origin", "https://evil.example.com")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, foreign)
+
+	if rec.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Fatalf("expected foreign origin to be rejected")
+	}
+}
@@ -0,0 +1,68 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+const problemContentType = "application/problem+json"
+
+// Problem — тело ошибки по RFC 9457.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	// Code — исходный gRPC-код, чтобы клиенты могли различать ошибки с одним HTTP-статусом.
+	Code      string `json:"code"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// problemHandler переводит gRPC-статус в problem+json. Текст внутренних ошибок
+// клиенту не отдаётся, только пишется в лог.
+func problemHandler(log *zap.Logger) runtime.ErrorHandlerFunc {
+	return func(ctx context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		var routing *runtime.HTTPStatusError
+		if errors.As(err, &routing) {
+			err = routing.Err
+		}
+
+		st := status.Convert(err)
+		httpStatus := runtime.HTTPStatusFromCode(st.Code())
+		if routing != nil {
+			httpStatus = routing.HTTPStatus
+		}
+
+		problem := Problem{
+			Type:      "about:blank",
+			Title:     http.StatusText(httpStatus),
+			Status:    httpStatus,
+			Detail:    st.Message(),
+			Instance:  r.URL.Path,
+			Code:      st.Code().String(),
+			RequestID: r.Header.Get("X-Request-ID"),
+		}
+		if httpStatus >= http.StatusInternalServerError {
+			log.Error("gateway request failed",
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.String("code", st.Code().String()),
+				zap.String("error", st.Message()),
+			)
+			problem.Detail = ""
+		}
+
+		w.Header().Del("Trailer")
+		w.Header().Del("Transfer-Encoding")
+		w.Header().Set("Content-Type", problemContentType)
+		w.WriteHeader(httpStatus)
+		_ = json.NewEncoder(w).Encode(problem)
+	}
+}
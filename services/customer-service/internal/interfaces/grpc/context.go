@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/grpc/middleware"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Ключи метаданных, которые проставляет API-шлюз после аутентификации. Если запрос
+// прошёл проверку JWT, инициатор берётся из токена.
+const (
+	metadataActorID   = "x-actor-id"
+	metadataActorRole = "x-actor-role"
+	metadataRequestID = "x-request-id"
+)
+
+// RequestContextInterceptor переносит инициатора и идентификатор запроса из метаданных в контекст.
+func RequestContextInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := withRequestContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RequestContextStreamInterceptor — вариант RequestContextInterceptor для потоковых вызовов.
+func RequestContextStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withRequestContext(stream.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func withRequestContext(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if principal, ok := middleware.PrincipalFromContext(ctx); ok {
+		// Проверенный токен вытесняет метаданные: x-actor-role лишь выбирает одну из ролей субъекта.
+		role := firstMetadataValue(md, metadataActorRole)
+		if !principal.HasRole(role) {
+			role = ""
+			if len(principal.Roles) > 0 {
+				role = principal.Roles[0]
+			}
+		}
+		ctx = audit.WithActor(ctx, audit.Actor{ID: principal.Subject, Role: role})
+
+		if principal.TenantID != "" {
+			claimed, err := tenant.Parse(principal.TenantID)
+			if err != nil {
+				return ctx, status.Error(codes.Unauthenticated, "token has invalid tenant")
+			}
+			ctx = tenant.WithTenant(ctx, claimed)
+		}
+	} else if actorID := firstMetadataValue(md, metadataActorID); actorID != "" {
+		ctx = audit.WithActor(ctx, audit.Actor{
+			ID:   actorID,
+			Role: firstMetadataValue(md, metadataActorRole),
+		})
+	}
+
+	requestID := firstMetadataValue(md, metadataRequestID)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+
+	return audit.WithRequestID(ctx, requestID), nil
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evgeniySeleznev/nwHS/pkg/grpc/middleware"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestContextPrefersPrincipal(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		metadataActorID, "spoofed",
+		metadataActorRole, "manager",
+	))
+	ctx = middleware.WithPrincipal(ctx, middleware.Principal{
+		Subject:  "staff-7",
+		Roles:    []string{"front_desk", "manager"},
+		TenantID: "Brand-A",
+	})
+
+	ctx, err := withRequestContext(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actor := audit.ActorFromContext(ctx); actor.ID != "staff-7" || actor.Role != "manager" {
+		t.Fatalf("expected actor from token with selected role, got %+v", actor)
+	}
+	if id, _ := tenant.FromContext(ctx); id != "brand-a" {
+		t.Fatalf("expected tenant from token, got %q", id)
+	}
+}
+
+func TestRequestContextIgnoresRoleOutsideToken(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(metadataActorRole, "admin"))
+	ctx = middleware.WithPrincipal(ctx, middleware.Principal{Subject: "staff-7", Roles: []string{"trainer"}})
+
+	ctx, err := withRequestContext(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actor := audit.ActorFromContext(ctx); actor.Role != "trainer" {
+		t.Fatalf("expected role from token, got %q", actor.Role)
+	}
+
+	bad := middleware.WithPrincipal(context.Background(), middleware.Principal{Subject: "staff-7", TenantID: "bad tenant!"})
+	if _, err := withRequestContext(bad); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated for invalid tenant claim, got %v", err)
+	}
+}
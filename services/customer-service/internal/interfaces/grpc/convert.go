@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"encoding/json"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// toTimestamp переводит время в Timestamp; нулевое время означает отсутствие значения.
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// fromTimestamp переводит Timestamp во время; незаданное значение даёт нулевое время.
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// toStringValue возвращает nil для пустой строки.
+func toStringValue(value string) *wrapperspb.StringValue {
+	if value == "" {
+		return nil
+	}
+	return wrapperspb.String(value)
+}
+
+// optionalString отличает незаданное поле от пустой строки.
+func optionalString(value *wrapperspb.StringValue) *string {
+	if value == nil {
+		return nil
+	}
+	s := value.GetValue()
+	return &s
+}
+
+func optionalBool(value *wrapperspb.BoolValue) *bool {
+	if value == nil {
+		return nil
+	}
+	b := value.GetValue()
+	return &b
+}
+
+// toValue переводит значение поля аудита в google.protobuf.Value через его JSON-представление.
+func toValue(value interface{}) *structpb.Value {
+	if value == nil {
+		return structpb.NewNullValue()
+	}
+	if converted, err := structpb.NewValue(value); err == nil {
+		return converted
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return structpb.NewNullValue()
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return structpb.NewNullValue()
+	}
+	converted, err := structpb.NewValue(decoded)
+	if err != nil {
+		return structpb.NewNullValue()
+	}
+	return converted
+}
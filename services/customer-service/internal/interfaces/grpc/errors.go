@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/application/queries"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/infrastructure/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorCodes сопоставляет доменные ошибки кодам gRPC; проверка идёт через errors.Is,
+// поэтому обёрнутые ошибки распознаются так же.
+var errorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{repository.ErrCustomerNotFound, codes.NotFound},
+	{repository.ErrHouseholdNotFound, codes.NotFound},
+	{valueobjects.ErrTimelineEntryNotFound, codes.NotFound},
+	{valueobjects.ErrQuestionnaireNotFound, codes.NotFound},
+	{valueobjects.ErrHealthRecordNotFound, codes.NotFound},
+	{valueobjects.ErrAttachmentNotFound, codes.NotFound},
+	{valueobjects.ErrUnknownReferralCode, codes.NotFound},
+
+	{repository.ErrConcurrentModification, codes.Aborted},
+
+	{valueobjects.ErrAlreadyMerged, codes.FailedPrecondition},
+	{valueobjects.ErrCustomerErased, codes.FailedPrecondition},
+	{valueobjects.ErrAlreadyHouseholdMember, codes.FailedPrecondition},
+	{valueobjects.ErrNotHouseholdMember, codes.FailedPrecondition},
+	{valueobjects.ErrPrimaryPayerRemoval, codes.FailedPrecondition},
+	{valueobjects.ErrGuardianHasDependents, codes.FailedPrecondition},
+	{valueobjects.ErrAlreadyReferred, codes.FailedPrecondition},
+	{valueobjects.ErrSystemEntryImmutable, codes.FailedPrecondition},
+	{valueobjects.ErrHealthRecordsDisabled, codes.FailedPrecondition},
+	{valueobjects.ErrAttachmentsDisabled, codes.FailedPrecondition},
+
+	{valueobjects.ErrTimelineAccessDenied, codes.PermissionDenied},
+	{valueobjects.ErrHealthAccessDenied, codes.PermissionDenied},
+	{tenant.ErrCrossTenant, codes.PermissionDenied},
+
+	{valueobjects.ErrInvalidEmail, codes.InvalidArgument},
+	{valueobjects.ErrInvalidPhone, codes.InvalidArgument},
+	{valueobjects.ErrEmptyFullName, codes.InvalidArgument},
+	{valueobjects.ErrInvalidBirthDay, codes.InvalidArgument},
+	{valueobjects.ErrSelfMerge, codes.InvalidArgument},
+	{valueobjects.ErrPayerMustBeAdult, codes.InvalidArgument},
+	{valueobjects.ErrGuardianRequired, codes.InvalidArgument},
+	{valueobjects.ErrInvalidGuardian, codes.InvalidArgument},
+	{valueobjects.ErrInvalidChannel, codes.InvalidArgument},
+	{valueobjects.ErrInvalidLanguage, codes.InvalidArgument},
+	{valueobjects.ErrInvalidTimezone, codes.InvalidArgument},
+	{valueobjects.ErrConsentSourceMissing, codes.InvalidArgument},
+	{valueobjects.ErrLegalTextMissing, codes.InvalidArgument},
+	{valueobjects.ErrInvalidReferralCode, codes.InvalidArgument},
+	{valueobjects.ErrSelfReferral, codes.InvalidArgument},
+	{valueobjects.ErrInvalidEntryType, codes.InvalidArgument},
+	{valueobjects.ErrInvalidVisibility, codes.InvalidArgument},
+	{valueobjects.ErrEmptyEntryBody, codes.InvalidArgument},
+	{valueobjects.ErrInvalidQuestionnaire, codes.InvalidArgument},
+	{valueobjects.ErrUnknownQuestion, codes.InvalidArgument},
+	{valueobjects.ErrMissingAnswer, codes.InvalidArgument},
+	{valueobjects.ErrInvalidAnswer, codes.InvalidArgument},
+	{valueobjects.ErrSignatureRequired, codes.InvalidArgument},
+	{valueobjects.ErrInvalidAttachmentKind, codes.InvalidArgument},
+	{valueobjects.ErrEmptyAttachment, codes.InvalidArgument},
+	{valueobjects.ErrUnsupportedContentType, codes.InvalidArgument},
+	{valueobjects.ErrChecksumMismatch, codes.InvalidArgument},
+	{queries.ErrInvalidPageToken, codes.InvalidArgument},
+	{tenant.ErrMissing, codes.InvalidArgument},
+	{tenant.ErrInvalid, codes.InvalidArgument},
+
+	{valueobjects.ErrAttachmentTooLarge, codes.ResourceExhausted},
+}
+
+// ErrorInterceptor переводит доменные ошибки в gRPC-статусы, чтобы клиенты и REST-шлюз
+// получали осмысленный код вместо Unknown.
+func ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, toStatusError(err)
+	}
+}
+
+// ErrorStreamInterceptor — вариант ErrorInterceptor для потоковых вызовов.
+func ErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return toStatusError(handler(srv, stream))
+	}
+}
+
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	for _, mapping := range errorCodes {
+		if errors.Is(err, mapping.err) {
+			return status.Error(mapping.code, err.Error())
+		}
+	}
+	return err
+}
@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/infrastructure/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusErrorMapsDomainErrors(t *testing.T) {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{fmt.Errorf("get customer: %w", repository.ErrCustomerNotFound), codes.NotFound},
+		{valueobjects.ErrInvalidEmail, codes.InvalidArgument},
+		{repository.ErrConcurrentModification, codes.Aborted},
+		{valueobjects.ErrHealthAccessDenied, codes.PermissionDenied},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{status.Error(codes.Unavailable, "down"), codes.Unavailable},
+		{errors.New("boom"), codes.Unknown},
+	}
+	for _, tc := range cases {
+		if got := status.Code(toStatusError(tc.err)); got != tc.code {
+			t.Fatalf("%v: expected %s, got %s", tc.err, tc.code, got)
+		}
+	}
+	if toStatusError(nil) != nil {
+		t.Fatalf("expected nil error to stay nil")
+	}
+}
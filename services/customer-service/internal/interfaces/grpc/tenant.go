@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadataTenantID задаёт арендатора запроса.
+const metadataTenantID = "x-tenant-id"
+
+// AuditAppender записывает отказы в доступе в журнал аудита.
+type AuditAppender interface {
+	Append(ctx context.Context, record audit.Record) error
+}
+
+// TenantResolver определяет арендатора запроса. Арендатор из утверждений
+// аутентификации (уже лежащий в контексте) имеет приоритет над метаданными;
+// расхождение между ними считается попыткой межарендаторного доступа.
+type TenantResolver struct {
+	auditLog      AuditAppender
+	defaultTenant tenant.ID
+	log           *zap.Logger
+}
+
+// NewTenantResolver создаёт резолвер. Пустой defaultTenant делает арендатора обязательным.
+func NewTenantResolver(auditLog AuditAppender, defaultTenant tenant.ID, log *zap.Logger) *TenantResolver {
+	return &TenantResolver{auditLog: auditLog, defaultTenant: defaultTenant, log: log}
+}
+
+// UnaryInterceptor кладёт арендатора в контекст unary-вызова.
+func (r *TenantResolver) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := r.resolve(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor кладёт арендатора в контекст потокового вызова.
+func (r *TenantResolver) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := r.resolve(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func (r *TenantResolver) resolve(ctx context.Context, method string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	requestedRaw := firstMetadataValue(md, metadataTenantID)
+
+	var requested tenant.ID
+	if requestedRaw != "" {
+		var err error
+		if requested, err = tenant.Parse(requestedRaw); err != nil {
+			return ctx, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if claimed, ok := tenant.FromContext(ctx); ok {
+		if requested != "" && requested != claimed {
+			r.denyCrossTenant(ctx, method, claimed, requestedRaw)
+			return ctx, status.Error(codes.PermissionDenied, tenant.ErrCrossTenant.Error())
+		}
+		return ctx, nil
+	}
+
+	switch {
+	case requested != "":
+		return tenant.WithTenant(ctx, requested), nil
+	case r.defaultTenant != "":
+		return tenant.WithTenant(ctx, r.defaultTenant), nil
+	default:
+		return ctx, status.Error(codes.InvalidArgument, tenant.ErrMissing.Error())
+	}
+}
+
+func (r *TenantResolver) denyCrossTenant(ctx context.Context, method string, claimed tenant.ID, requested string) {
+	actor := audit.ActorFromContext(ctx)
+	r.log.Warn("cross-tenant access denied",
+		zap.String("method", method),
+		zap.String("tenant", claimed.String()),
+		zap.String("requested_tenant", requested),
+		zap.String("actor_id", actor.ID),
+	)
+
+	record := audit.Record{
+		ID:            uuid.New(),
+		Actor:         actor,
+		Action:        audit.ActionCrossTenantDenied,
+		AggregateType: audit.AggregateTenant,
+		AggregateID:   requested,
+		Changes: map[string]audit.Change{
+			"method":           {After: method},
+			"requested_tenant": {After: requested},
+		},
+		RequestID:  audit.RequestIDFromContext(ctx),
+		OccurredAt: time.Now().UTC(),
+	}
+
+	// Запись делается от имени собственного арендатора вызывающего.
+	if err := r.auditLog.Append(tenant.WithTenant(ctx, claimed), record); err != nil && !errors.Is(err, context.Canceled) {
+		r.log.Error("failed to audit cross-tenant access", zap.Error(err))
+	}
+}
+
+// contextStream подменяет контекст серверного потока.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
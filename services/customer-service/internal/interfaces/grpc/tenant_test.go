@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/audit"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeAuditAppender struct {
+	records []audit.Record
+	tenants []tenant.ID
+}
+
+func (f *fakeAuditAppender) Append(ctx context.Context, record audit.Record) error {
+	id, _ := tenant.FromContext(ctx)
+	f.records = append(f.records, record)
+	f.tenants = append(f.tenants, id)
+	return nil
+}
+
+func callWithTenant(t *testing.T, resolver *TenantResolver, ctx context.Context) (tenant.ID, error) {
+	t.Helper()
+
+	var resolved tenant.ID
+	_, err := resolver.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/customer.v1.CustomerService/GetCustomer"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		resolved, _ = tenant.FromContext(ctx)
+		return nil, nil
+	})
+	return resolved, err
+}
+
+func TestTenantResolver_FromMetadata(t *testing.T) {
+	resolver := NewTenantResolver(&fakeAuditAppender{}, "", zap.NewNop())
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(metadataTenantID, "Brand-A"))
+
+	resolved, err := callWithTenant(t, resolver, ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resolved != "brand-a" {
+		t.Fatalf("expected normalized tenant, got %q", resolved)
+	}
+}
+
+func TestTenantResolver_Missing(t *testing.T) {
+	_, err := callWithTenant(t, NewTenantResolver(&fakeAuditAppender{}, "", zap.NewNop()), context.Background())
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+
+	resolved, err := callWithTenant(t, NewTenantResolver(&fakeAuditAppender{}, "default", zap.NewNop()), context.Background())
+	if err != nil || resolved != "default" {
+		t.Fatalf("expected default tenant, got %q, %v", resolved, err)
+	}
+}
+
+func TestTenantResolver_CrossTenantDenied(t *testing.T) {
+	auditLog := &fakeAuditAppender{}
+	resolver := NewTenantResolver(auditLog, "", zap.NewNop())
+
+	ctx := tenant.WithTenant(context.Background(), "brand-a")
+	ctx = audit.WithActor(ctx, audit.Actor{ID: "staff-1", Role: "admin"})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(metadataTenantID, "brand-b"))
+
+	_, err := callWithTenant(t, resolver, ctx)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+	if len(auditLog.records) != 1 || auditLog.records[0].Action != audit.ActionCrossTenantDenied {
+		t.Fatalf("expected cross-tenant attempt to be audited")
+	}
+	if auditLog.tenants[0] != "brand-a" || auditLog.records[0].AggregateID != "brand-b" || auditLog.records[0].Actor.ID != "staff-1" {
+		t.Fatalf("unexpected audit record: %+v", auditLog.records[0])
+	}
+}
@@ -2,37 +2,118 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"io"
 	"net"
 	"time"
 
+	customerpb "github.com/evgeniySeleznev/nwHS/services/customer-service/api/gen/customer/v1"
 	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/application/commands"
 	appqueries "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/application/queries"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/metadata"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
+// Handlers группирует обработчики use-case'ов, доступные через транспорт.
+type Handlers struct {
+	Register       *commands.RegisterCustomerHandler
+	Update         *commands.UpdateCustomerHandler
+	Merge          *commands.MergeCustomersHandler
+	Household      *commands.HouseholdHandler
+	Contact        *commands.ContactSettingsHandler
+	Import         *commands.ImportCustomersHandler
+	Timeline       *commands.TimelineHandler
+	Health         *commands.HealthHandler
+	Attachments    *commands.AttachmentHandler
+	Erase          *commands.EraseCustomerHandler
+	Get            *appqueries.GetCustomerHandler
+	GetAsOf        *appqueries.GetCustomerAsOfHandler
+	History        *appqueries.GetCustomerHistoryHandler
+	AuditLog       *appqueries.QueryAuditLogHandler
+	Dedup          *appqueries.FindDuplicatesHandler
+	GetHousehold   *appqueries.GetCustomerHouseholdHandler
+	Contactable    *appqueries.ListContactableHandler
+	List           *appqueries.ListCustomersHandler
+	Referrals      *appqueries.ListReferralsHandler
+	GetTimeline    *appqueries.GetCustomerTimelineHandler
+	GetHealth      *appqueries.GetHealthHandler
+	GetAttachments *appqueries.GetAttachmentsHandler
+}
+
 // Transport представляет gRPC-адаптер для customer-service.
 type Transport struct {
-	server          *grpc.Server
-	registerHandler *commands.RegisterCustomerHandler
-	getHandler      *appqueries.GetCustomerHandler
-	log             *zap.Logger
+	customerpb.UnimplementedCustomerServiceServer
+
+	server                *grpc.Server
+	registerHandler       *commands.RegisterCustomerHandler
+	updateHandler         *commands.UpdateCustomerHandler
+	mergeHandler          *commands.MergeCustomersHandler
+	householdHandler      *commands.HouseholdHandler
+	contactHandler        *commands.ContactSettingsHandler
+	importHandler         *commands.ImportCustomersHandler
+	timelineHandler       *commands.TimelineHandler
+	healthHandler         *commands.HealthHandler
+	attachmentHandler     *commands.AttachmentHandler
+	eraseHandler          *commands.EraseCustomerHandler
+	getHandler            *appqueries.GetCustomerHandler
+	getAsOfHandler        *appqueries.GetCustomerAsOfHandler
+	historyHandler        *appqueries.GetCustomerHistoryHandler
+	auditHandler          *appqueries.QueryAuditLogHandler
+	dedupHandler          *appqueries.FindDuplicatesHandler
+	getHouseholdHandler   *appqueries.GetCustomerHouseholdHandler
+	contactableHandler    *appqueries.ListContactableHandler
+	listHandler           *appqueries.ListCustomersHandler
+	referralsHandler      *appqueries.ListReferralsHandler
+	getTimelineHandler    *appqueries.GetCustomerTimelineHandler
+	getHealthHandler      *appqueries.GetHealthHandler
+	getAttachmentsHandler *appqueries.GetAttachmentsHandler
+	log                   *zap.Logger
 }
 
 // NewTransport создаёт gRPC сервер и навешивает middlewares (интерцепторы).
-func NewTransport(register *commands.RegisterCustomerHandler, getter *appqueries.GetCustomerHandler, log *zap.Logger, opts ...grpc.ServerOption) *Transport {
+func NewTransport(handlers Handlers, log *zap.Logger, opts ...grpc.ServerOption) *Transport {
 	srv := grpc.NewServer(opts...)
 	t := &Transport{
-		server:          srv,
-		registerHandler: register,
-		getHandler:      getter,
-		log:             log,
+		server:                srv,
+		registerHandler:       handlers.Register,
+		updateHandler:         handlers.Update,
+		mergeHandler:          handlers.Merge,
+		householdHandler:      handlers.Household,
+		contactHandler:        handlers.Contact,
+		importHandler:         handlers.Import,
+		timelineHandler:       handlers.Timeline,
+		healthHandler:         handlers.Health,
+		attachmentHandler:     handlers.Attachments,
+		eraseHandler:          handlers.Erase,
+		getHandler:            handlers.Get,
+		getAsOfHandler:        handlers.GetAsOf,
+		historyHandler:        handlers.History,
+		auditHandler:          handlers.AuditLog,
+		dedupHandler:          handlers.Dedup,
+		getHouseholdHandler:   handlers.GetHousehold,
+		contactableHandler:    handlers.Contactable,
+		listHandler:           handlers.List,
+		referralsHandler:      handlers.Referrals,
+		getTimelineHandler:    handlers.GetTimeline,
+		getHealthHandler:      handlers.GetHealth,
+		getAttachmentsHandler: handlers.GetAttachments,
+		log:                   log,
 	}
-	// TODO: при генерации protobuf зарегистрировать customerpb.RegisterCustomerServiceServer(srv, t)
+	customerpb.RegisterCustomerServiceServer(srv, t)
+	// Reflection позволяет вызывать сервис через grpcurl без .proto-файлов.
+	reflection.Register(srv)
 	return t
 }
 
+// RegisterHealth подключает grpc.health.v1; вызывается до Serve.
+func (t *Transport) RegisterHealth(srv healthpb.HealthServer) {
+	healthpb.RegisterHealthServer(t.server, srv)
+}
+
 // Serve запускает gRPC сервер.
 func (t *Transport) Serve(lis net.Listener) error {
 	t.log.Info("gRPC transport listening", zap.String("addr", lis.Addr().String()))
@@ -45,60 +126,757 @@ func (t *Transport) Stop() {
 }
 
 // RegisterCustomer демонстрирует обработку RPC.
-func (t *Transport) RegisterCustomer(ctx context.Context, req *RegisterCustomerRequest) (*RegisterCustomerResponse, error) {
-	md, _ := metadata.FromIncomingContext(ctx)
-	t.log.Debug("incoming metadata", zap.Any("metadata", md))
-
+func (t *Transport) RegisterCustomer(ctx context.Context, req *customerpb.RegisterCustomerRequest) (*customerpb.RegisterCustomerResponse, error) {
 	id, err := t.registerHandler.Handle(ctx, commands.RegisterCustomer{
+		FullName:     req.FullName,
+		Email:        req.Email,
+		PhoneNumber:  req.PhoneNumber,
+		BirthDate:    fromTimestamp(req.BirthDate),
+		ReferralCode: req.ReferralCode.GetValue(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Возможные дубликаты возвращаются как мягкое предупреждение и не блокируют регистрацию.
+	candidates, err := t.dedupHandler.Handle(ctx, appqueries.FindDuplicates{
+		ExcludeID:   id,
 		FullName:    req.FullName,
-		Email:       req.Email,
 		PhoneNumber: req.PhoneNumber,
-		BirthDate:   req.BirthDate,
+		BirthDate:   fromTimestamp(req.BirthDate),
+	})
+	if err != nil {
+		t.log.Warn("duplicate candidate lookup failed", zap.Error(err), zap.String("customer_id", id))
+	}
+
+	return &customerpb.RegisterCustomerResponse{Id: id, DuplicateCandidates: toDuplicateCandidates(candidates)}, nil
+}
+
+// FindDuplicateCandidates возвращает возможные дубликаты для профиля.
+func (t *Transport) FindDuplicateCandidates(ctx context.Context, req *customerpb.FindDuplicateCandidatesRequest) (*customerpb.FindDuplicateCandidatesResponse, error) {
+	candidates, err := t.dedupHandler.Handle(ctx, appqueries.FindDuplicates{
+		ExcludeID:   req.ExcludeId.GetValue(),
+		FullName:    req.FullName,
+		PhoneNumber: req.PhoneNumber,
+		BirthDate:   fromTimestamp(req.BirthDate),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerpb.FindDuplicateCandidatesResponse{Candidates: toDuplicateCandidates(candidates)}, nil
+}
+
+// MergeCustomers сливает дубликат с основным клиентом.
+func (t *Transport) MergeCustomers(ctx context.Context, req *customerpb.MergeCustomersRequest) (*customerpb.MergeCustomersResponse, error) {
+	if err := t.mergeHandler.Handle(ctx, commands.MergeCustomers{
+		SurvivorID: req.SurvivorId,
+		MergedID:   req.MergedId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &customerpb.MergeCustomersResponse{SurvivorId: req.SurvivorId}, nil
+}
+
+// CreateHousehold создаёт домохозяйство с основным плательщиком.
+func (t *Transport) CreateHousehold(ctx context.Context, req *customerpb.CreateHouseholdRequest) (*customerpb.CreateHouseholdResponse, error) {
+	id, err := t.householdHandler.Create(ctx, commands.CreateHousehold{
+		Name:           req.Name,
+		PrimaryPayerID: req.PrimaryPayerId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerpb.CreateHouseholdResponse{Id: id}, nil
+}
+
+// AddHouseholdMember добавляет клиента в домохозяйство.
+func (t *Transport) AddHouseholdMember(ctx context.Context, req *customerpb.AddHouseholdMemberRequest) (*customerpb.HouseholdMemberResponse, error) {
+	if err := t.householdHandler.AddMember(ctx, commands.AddHouseholdMember{
+		HouseholdID: req.HouseholdId,
+		CustomerID:  req.CustomerId,
+		GuardianID:  req.GuardianId.GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &customerpb.HouseholdMemberResponse{HouseholdId: req.HouseholdId, CustomerId: req.CustomerId}, nil
+}
+
+// RemoveHouseholdMember исключает клиента из домохозяйства.
+func (t *Transport) RemoveHouseholdMember(ctx context.Context, req *customerpb.RemoveHouseholdMemberRequest) (*customerpb.HouseholdMemberResponse, error) {
+	if err := t.householdHandler.RemoveMember(ctx, commands.RemoveHouseholdMember{
+		HouseholdID: req.HouseholdId,
+		CustomerID:  req.CustomerId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &customerpb.HouseholdMemberResponse{HouseholdId: req.HouseholdId, CustomerId: req.CustomerId}, nil
+}
+
+// TransferHouseholdMember переводит клиента в другое домохозяйство.
+func (t *Transport) TransferHouseholdMember(ctx context.Context, req *customerpb.TransferHouseholdMemberRequest) (*customerpb.HouseholdMemberResponse, error) {
+	if err := t.householdHandler.TransferMember(ctx, commands.TransferHouseholdMember{
+		CustomerID:        req.CustomerId,
+		TargetHouseholdID: req.TargetHouseholdId,
+		GuardianID:        req.GuardianId.GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &customerpb.HouseholdMemberResponse{HouseholdId: req.TargetHouseholdId, CustomerId: req.CustomerId}, nil
+}
+
+// GetCustomerHousehold возвращает домохозяйство клиента.
+func (t *Transport) GetCustomerHousehold(ctx context.Context, req *customerpb.GetCustomerHouseholdRequest) (*customerpb.Household, error) {
+	dto, err := t.getHouseholdHandler.Handle(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	household := &customerpb.Household{
+		Id:             dto.ID,
+		Name:           dto.Name,
+		PrimaryPayerId: dto.PrimaryPayerID,
+		Version:        int32(dto.Version),
+	}
+	for _, member := range dto.Members {
+		household.Members = append(household.Members, &customerpb.HouseholdMember{
+			CustomerId: member.CustomerID,
+			FullName:   member.FullName,
+			GuardianId: toStringValue(member.GuardianID),
+			Minor:      member.Minor,
+			JoinedAt:   toTimestamp(member.JoinedAt),
+		})
+	}
+
+	return household, nil
+}
+
+// UpdateConsent фиксирует согласие или отказ клиента от канала коммуникации.
+func (t *Transport) UpdateConsent(ctx context.Context, req *customerpb.UpdateConsentRequest) (*customerpb.UpdateConsentResponse, error) {
+	if err := t.contactHandler.UpdateConsent(ctx, commands.UpdateConsent{
+		CustomerID:       req.CustomerId,
+		Channel:          req.Channel,
+		Granted:          req.Granted,
+		Source:           req.Source,
+		LegalTextVersion: req.LegalTextVersion,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &customerpb.UpdateConsentResponse{CustomerId: req.CustomerId}, nil
+}
+
+// UpdatePreferences изменяет язык, часовой пояс и предпочтительный канал клиента.
+func (t *Transport) UpdatePreferences(ctx context.Context, req *customerpb.UpdatePreferencesRequest) (*customerpb.UpdatePreferencesResponse, error) {
+	if err := t.contactHandler.UpdatePreferences(ctx, commands.UpdatePreferences{
+		CustomerID:       req.CustomerId,
+		Language:         req.Language,
+		Timezone:         req.Timezone,
+		PreferredChannel: req.PreferredChannel,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &customerpb.UpdatePreferencesResponse{CustomerId: req.CustomerId}, nil
+}
+
+// ListContactableCustomers возвращает клиентов с действующим согласием на канал.
+func (t *Transport) ListContactableCustomers(ctx context.Context, req *customerpb.ListContactableCustomersRequest) (*customerpb.ListContactableCustomersResponse, error) {
+	page, err := t.contactableHandler.Handle(ctx, appqueries.ListContactable{
+		Channel:   req.Channel,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &customerpb.ListContactableCustomersResponse{NextPageToken: page.NextPageToken}
+	for _, customer := range page.Customers {
+		resp.Customers = append(resp.Customers, &customerpb.ContactableCustomer{
+			CustomerId:  customer.ID,
+			FullName:    customer.FullName,
+			Email:       customer.Email,
+			PhoneNumber: customer.PhoneNumber,
+			Language:    customer.Language,
+			Timezone:    customer.Timezone,
+		})
+	}
+
+	return resp, nil
+}
+
+// ListReferrals возвращает клиентов, пришедших по коду указанного клиента.
+func (t *Transport) ListReferrals(ctx context.Context, req *customerpb.ListReferralsRequest) (*customerpb.ListReferralsResponse, error) {
+	page, err := t.referralsHandler.Handle(ctx, appqueries.ListReferrals{
+		CustomerID: req.CustomerId,
+		PageSize:   int(req.PageSize),
+		PageToken:  req.PageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &customerpb.ListReferralsResponse{ReferrerId: page.ReferrerID, NextPageToken: page.NextPageToken}
+	for _, referral := range page.Referrals {
+		resp.Referrals = append(resp.Referrals, &customerpb.Referral{
+			CustomerId:   referral.CustomerID,
+			FullName:     referral.FullName,
+			ReferralCode: referral.ReferralCode,
+			ReferredAt:   toTimestamp(referral.ReferredAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// AddTimelineEntry добавляет заметку, звонок или жалобу в ленту клиента.
+func (t *Transport) AddTimelineEntry(ctx context.Context, req *customerpb.AddTimelineEntryRequest) (*customerpb.AddTimelineEntryResponse, error) {
+	id, err := t.timelineHandler.AddEntry(ctx, commands.AddTimelineEntry{
+		CustomerID: req.CustomerId,
+		Type:       req.Type,
+		Body:       req.Body,
+		Visibility: req.Visibility,
+		Pinned:     req.Pinned,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerpb.AddTimelineEntryResponse{EntryId: id}, nil
+}
+
+// EditTimelineEntry изменяет запись ленты.
+func (t *Transport) EditTimelineEntry(ctx context.Context, req *customerpb.EditTimelineEntryRequest) (*customerpb.EditTimelineEntryResponse, error) {
+	err := t.timelineHandler.EditEntry(ctx, commands.EditTimelineEntry{
+		EntryID:    req.EntryId,
+		Body:       optionalString(req.Body),
+		Visibility: optionalString(req.Visibility),
+		Pinned:     optionalBool(req.Pinned),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerpb.EditTimelineEntryResponse{EntryId: req.EntryId}, nil
+}
+
+// DeleteTimelineEntry удаляет запись из ленты.
+func (t *Transport) DeleteTimelineEntry(ctx context.Context, req *customerpb.DeleteTimelineEntryRequest) (*customerpb.DeleteTimelineEntryResponse, error) {
+	if err := t.timelineHandler.DeleteEntry(ctx, commands.DeleteTimelineEntry{EntryID: req.EntryId}); err != nil {
+		return nil, err
+	}
+
+	return &customerpb.DeleteTimelineEntryResponse{EntryId: req.EntryId}, nil
+}
+
+// GetCustomerTimeline возвращает страницу ленты клиента, видимую инициатору.
+func (t *Transport) GetCustomerTimeline(ctx context.Context, req *customerpb.GetCustomerTimelineRequest) (*customerpb.GetCustomerTimelineResponse, error) {
+	page, err := t.getTimelineHandler.Handle(ctx, appqueries.GetCustomerTimeline{
+		CustomerID: req.CustomerId,
+		Types:      req.Types,
+		PageSize:   int(req.PageSize),
+		PageToken:  req.PageToken,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &RegisterCustomerResponse{Id: id}, nil
+	resp := &customerpb.GetCustomerTimelineResponse{NextPageToken: page.NextPageToken}
+	for _, entry := range page.Entries {
+		resp.Entries = append(resp.Entries, &customerpb.TimelineEntry{
+			Id:         entry.ID,
+			CustomerId: entry.CustomerID,
+			Type:       entry.Type,
+			Body:       entry.Body,
+			AuthorId:   entry.AuthorID,
+			AuthorRole: entry.AuthorRole,
+			Visibility: entry.Visibility,
+			Pinned:     entry.Pinned,
+			CreatedAt:  toTimestamp(entry.CreatedAt),
+			UpdatedAt:  toTimestamp(entry.UpdatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// PublishHealthQuestionnaire публикует новую версию анкеты здоровья.
+func (t *Transport) PublishHealthQuestionnaire(ctx context.Context, req *customerpb.PublishHealthQuestionnaireRequest) (*customerpb.PublishHealthQuestionnaireResponse, error) {
+	if t.healthHandler == nil {
+		return nil, valueobjects.ErrHealthRecordsDisabled
+	}
+	questions := make([]models.Question, 0, len(req.Questions))
+	for _, question := range req.Questions {
+		questions = append(questions, models.Question{
+			ID:         question.Id,
+			Text:       question.Text,
+			Kind:       models.QuestionKind(question.Kind),
+			Required:   question.Required,
+			FlagsOnYes: question.FlagsOnYes,
+		})
+	}
+
+	version, err := t.healthHandler.PublishQuestionnaire(ctx, commands.PublishQuestionnaire{
+		Code:             req.Code,
+		Title:            req.Title,
+		Questions:        questions,
+		ValidFor:         time.Duration(req.ValidForDays) * 24 * time.Hour,
+		RequireReconsent: req.RequireReconsent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerpb.PublishHealthQuestionnaireResponse{Code: req.Code, Version: int32(version)}, nil
+}
+
+// SubmitHealthQuestionnaire сохраняет подписанную анкету клиента.
+func (t *Transport) SubmitHealthQuestionnaire(ctx context.Context, req *customerpb.SubmitHealthQuestionnaireRequest) (*customerpb.SubmitHealthQuestionnaireResponse, error) {
+	if t.healthHandler == nil {
+		return nil, valueobjects.ErrHealthRecordsDisabled
+	}
+	id, err := t.healthHandler.Submit(ctx, commands.SubmitHealthQuestionnaire{
+		CustomerID:    req.CustomerId,
+		Code:          req.Code,
+		Answers:       req.Answers,
+		SignerName:    req.SignerName,
+		SignatureData: req.Signature,
+		SignedAt:      fromTimestamp(req.SignedAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerpb.SubmitHealthQuestionnaireResponse{SubmissionId: id}, nil
+}
+
+// GetHealthStatus возвращает статус допуска клиента без медицинских подробностей.
+func (t *Transport) GetHealthStatus(ctx context.Context, req *customerpb.GetHealthStatusRequest) (*customerpb.HealthStatus, error) {
+	if t.getHealthHandler == nil {
+		return nil, valueobjects.ErrHealthRecordsDisabled
+	}
+	status, err := t.getHealthHandler.Status(ctx, appqueries.GetHealthStatus{CustomerID: req.CustomerId, Code: req.Code})
+	if err != nil {
+		return nil, err
+	}
+
+	return toHealthStatus(status), nil
+}
+
+// GetHealthSubmission возвращает ответы и подпись анкеты медицинским ролям.
+func (t *Transport) GetHealthSubmission(ctx context.Context, req *customerpb.GetHealthStatusRequest) (*customerpb.GetHealthSubmissionResponse, error) {
+	if t.getHealthHandler == nil {
+		return nil, valueobjects.ErrHealthRecordsDisabled
+	}
+	submission, err := t.getHealthHandler.Submission(ctx, appqueries.GetHealthStatus{CustomerID: req.CustomerId, Code: req.Code})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerpb.GetHealthSubmissionResponse{
+		Status:       toHealthStatus(submission.HealthStatusDTO),
+		SubmissionId: submission.SubmissionID,
+		Answers:      submission.Answers,
+		SignerName:   submission.SignerName,
+		Signature:    submission.Signature,
+		SignedAt:     toTimestamp(submission.SignedAt),
+	}, nil
+}
+
+func toHealthStatus(status appqueries.HealthStatusDTO) *customerpb.HealthStatus {
+	return &customerpb.HealthStatus{
+		CustomerId:     status.CustomerID,
+		Code:           status.Code,
+		Status:         status.Status,
+		Version:        int32(status.Version),
+		CurrentVersion: int32(status.CurrentVersion),
+		Flags:          status.Flags,
+		SubmittedAt:    toTimestamp(status.SubmittedAt),
+		ExpiresAt:      toTimestamp(status.ExpiresAt),
+	}
+}
+
+// UploadAttachment принимает файл клиента частями. Первое сообщение потока
+// содержит метаданные, остальные — только очередную часть содержимого.
+func (t *Transport) UploadAttachment(stream customerpb.CustomerService_UploadAttachmentServer) error {
+	if t.attachmentHandler == nil {
+		return valueobjects.ErrAttachmentsDisabled
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	attachment, err := t.attachmentHandler.Upload(stream.Context(), commands.UploadAttachment{
+		CustomerID: first.CustomerId,
+		Kind:       first.Kind,
+		FileName:   first.FileName,
+		Checksum:   first.Sha256,
+		Content:    &streamChunkReader{stream: stream, pending: first.Chunk},
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&customerpb.Attachment{
+		Id:          attachment.ID().String(),
+		CustomerId:  attachment.CustomerID().String(),
+		Kind:        string(attachment.Kind()),
+		FileName:    attachment.FileName(),
+		ContentType: attachment.ContentType(),
+		Size:        attachment.Size(),
+		Sha256:      attachment.Checksum(),
+		UploadedBy:  attachment.UploadedBy(),
+		CreatedAt:   toTimestamp(attachment.CreatedAt()),
+	})
+}
+
+// streamChunkReader представляет части файла из клиентского потока как io.Reader.
+type streamChunkReader struct {
+	stream  customerpb.CustomerService_UploadAttachmentServer
+	pending []byte
+	done    bool
+}
+
+func (r *streamChunkReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		req, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			r.done = true
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		r.pending = req.Chunk
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// ListAttachments возвращает вложения клиента.
+func (t *Transport) ListAttachments(ctx context.Context, req *customerpb.ListAttachmentsRequest) (*customerpb.ListAttachmentsResponse, error) {
+	if t.getAttachmentsHandler == nil {
+		return nil, valueobjects.ErrAttachmentsDisabled
+	}
+
+	attachments, err := t.getAttachmentsHandler.List(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &customerpb.ListAttachmentsResponse{}
+	for _, attachment := range attachments {
+		resp.Attachments = append(resp.Attachments, &customerpb.Attachment{
+			Id:          attachment.ID,
+			CustomerId:  attachment.CustomerID,
+			Kind:        attachment.Kind,
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			Size:        attachment.Size,
+			Sha256:      attachment.Checksum,
+			UploadedBy:  attachment.UploadedBy,
+			CreatedAt:   toTimestamp(attachment.CreatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// GetAttachmentURL возвращает временную ссылку на скачивание вложения.
+func (t *Transport) GetAttachmentURL(ctx context.Context, req *customerpb.AttachmentRequest) (*customerpb.GetAttachmentURLResponse, error) {
+	if t.getAttachmentsHandler == nil {
+		return nil, valueobjects.ErrAttachmentsDisabled
+	}
+
+	link, err := t.getAttachmentsHandler.DownloadURL(ctx, appqueries.GetAttachmentURL{CustomerID: req.CustomerId, AttachmentID: req.AttachmentId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerpb.GetAttachmentURLResponse{Url: link.URL, ExpiresAt: toTimestamp(link.ExpiresAt)}, nil
+}
+
+// DeleteAttachment удаляет вложение клиента.
+func (t *Transport) DeleteAttachment(ctx context.Context, req *customerpb.AttachmentRequest) (*customerpb.DeleteAttachmentResponse, error) {
+	if t.attachmentHandler == nil {
+		return nil, valueobjects.ErrAttachmentsDisabled
+	}
+
+	if err := t.attachmentHandler.Delete(ctx, commands.DeleteAttachment{CustomerID: req.CustomerId, AttachmentID: req.AttachmentId}); err != nil {
+		return nil, err
+	}
+
+	return &customerpb.DeleteAttachmentResponse{AttachmentId: req.AttachmentId}, nil
+}
+
+// EraseCustomer удаляет персональные данные клиента по запросу.
+func (t *Transport) EraseCustomer(ctx context.Context, req *customerpb.EraseCustomerRequest) (*customerpb.EraseCustomerResponse, error) {
+	if err := t.eraseHandler.Handle(ctx, commands.EraseCustomer{CustomerID: req.CustomerId, Reason: req.Reason}); err != nil {
+		return nil, err
+	}
+
+	return &customerpb.EraseCustomerResponse{CustomerId: req.CustomerId}, nil
+}
+
+// ImportCustomers принимает поток строк импорта и возвращает построчный отчёт.
+// Режим dry-run задаётся первым сообщением потока.
+func (t *Transport) ImportCustomers(stream customerpb.CustomerService_ImportCustomersServer) error {
+	source := &streamImportSource{stream: stream}
+
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	dryRun := false
+	if first != nil {
+		dryRun = first.DryRun
+		source.pending = first.Rows
+	}
+
+	report, err := t.importHandler.Handle(stream.Context(), commands.ImportCustomers{Source: source, DryRun: dryRun})
+	if err != nil {
+		return err
+	}
+
+	resp := &customerpb.ImportCustomersResponse{
+		DryRun:   report.DryRun,
+		Total:    int32(report.Total),
+		Imported: int32(report.Imported),
+		Failed:   int32(report.Failed),
+	}
+	for _, row := range report.Rows {
+		resp.Rows = append(resp.Rows, &customerpb.ImportRowResult{
+			Line:       int32(row.Line),
+			Email:      row.Email,
+			CustomerId: row.CustomerID,
+			Error:      row.Error,
+		})
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+// streamImportSource читает строки импорта из клиентского потока gRPC.
+type streamImportSource struct {
+	stream  customerpb.CustomerService_ImportCustomersServer
+	pending []*customerpb.ImportRow
+	line    int
+	done    bool
+}
+
+func (s *streamImportSource) Next(ctx context.Context) (commands.ImportRow, error) {
+	for len(s.pending) == 0 {
+		if s.done {
+			return commands.ImportRow{}, io.EOF
+		}
+		req, err := s.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			s.done = true
+			continue
+		}
+		if err != nil {
+			return commands.ImportRow{}, err
+		}
+		s.pending = req.Rows
+	}
+
+	row := s.pending[0]
+	s.pending = s.pending[1:]
+	s.line++
+
+	line := int(row.Line)
+	if line == 0 {
+		line = s.line
+	}
+
+	return commands.ImportRow{
+		Line:        line,
+		FullName:    row.FullName,
+		Email:       row.Email,
+		PhoneNumber: row.PhoneNumber,
+		BirthDate:   row.BirthDate,
+	}, nil
+}
+
+// ListCustomers возвращает страницу клиентов с keyset-пагинацией.
+func (t *Transport) ListCustomers(ctx context.Context, req *customerpb.ListCustomersRequest) (*customerpb.ListCustomersResponse, error) {
+	page, err := t.listHandler.Handle(ctx, toListCustomersQuery(req))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &customerpb.ListCustomersResponse{NextPageToken: page.NextPageToken}
+	for _, customer := range page.Customers {
+		resp.Customers = append(resp.Customers, toCustomerSummary(customer))
+	}
+
+	return resp, nil
+}
+
+// ExportCustomers выгружает всю выборку клиентов серверным потоком.
+// Токен страницы позволяет продолжить прерванную выгрузку.
+func (t *Transport) ExportCustomers(req *customerpb.ListCustomersRequest, stream customerpb.CustomerService_ExportCustomersServer) error {
+	return t.listHandler.Export(stream.Context(), toListCustomersQuery(req), func(customer appqueries.CustomerSummaryDTO) error {
+		return stream.Send(toCustomerSummary(customer))
+	})
+}
+
+func toListCustomersQuery(req *customerpb.ListCustomersRequest) appqueries.ListCustomers {
+	return appqueries.ListCustomers{
+		CreatedFrom: fromTimestamp(req.CreatedFrom),
+		CreatedTo:   fromTimestamp(req.CreatedTo),
+		BirthMonth:  int(req.BirthMonth.GetValue()),
+		Status:      req.Status,
+		SortBy:      req.SortBy,
+		Descending:  req.Descending,
+		PageSize:    int(req.PageSize),
+		PageToken:   req.PageToken,
+	}
+}
+
+func toCustomerSummary(customer appqueries.CustomerSummaryDTO) *customerpb.CustomerSummary {
+	return &customerpb.CustomerSummary{
+		Id:          customer.ID,
+		FullName:    customer.FullName,
+		Email:       customer.Email,
+		PhoneNumber: customer.PhoneNumber,
+		BirthDate:   toTimestamp(customer.BirthDate),
+		Status:      customer.Status,
+		CreatedAt:   toTimestamp(customer.CreatedAt),
+		UpdatedAt:   toTimestamp(customer.UpdatedAt),
+		Version:     int32(customer.Version),
+	}
+}
+
+func toDuplicateCandidates(candidates []appqueries.DuplicateCandidateDTO) []*customerpb.DuplicateCandidate {
+	result := make([]*customerpb.DuplicateCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		result = append(result, &customerpb.DuplicateCandidate{
+			CustomerId: candidate.CustomerID,
+			FullName:   candidate.FullName,
+			Email:      candidate.Email,
+			Score:      candidate.Score,
+			Reasons:    candidate.Reasons,
+		})
+	}
+	return result
 }
 
 // GetCustomer демонстрирует обработку query RPC.
-func (t *Transport) GetCustomer(ctx context.Context, req *GetCustomerRequest) (*GetCustomerResponse, error) {
+func (t *Transport) GetCustomer(ctx context.Context, req *customerpb.GetCustomerRequest) (*customerpb.GetCustomerResponse, error) {
 	dto, err := t.getHandler.Handle(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &GetCustomerResponse{
-		Id:          dto.ID,
-		FullName:    dto.FullName,
-		Email:       dto.Email,
-		PhoneNumber: dto.PhoneNumber,
-	}, nil
+	return toCustomerResponse(dto), nil
+}
+
+func toCustomerResponse(dto appqueries.CustomerDTO) *customerpb.GetCustomerResponse {
+	return &customerpb.GetCustomerResponse{
+		Id:           dto.ID,
+		FullName:     dto.FullName,
+		Email:        dto.Email,
+		PhoneNumber:  dto.PhoneNumber,
+		ReferralCode: dto.ReferralCode,
+		BirthDate:    toTimestamp(dto.BirthDate),
+		UpdatedAt:    toTimestamp(dto.UpdatedAt),
+		Version:      int32(dto.Version),
+	}
 }
 
-// RegisterCustomerRequest описывает входящие данные RPC (заглушка до генерации protobuf).
-type RegisterCustomerRequest struct {
-	FullName    string
-	Email       string
-	PhoneNumber string
-	BirthDate   time.Time
+// UpdateCustomer изменяет профиль клиента.
+func (t *Transport) UpdateCustomer(ctx context.Context, req *customerpb.UpdateCustomerRequest) (*customerpb.UpdateCustomerResponse, error) {
+	version, err := t.updateHandler.Handle(ctx, commands.UpdateCustomer{
+		CustomerID:  req.Id,
+		FullName:    optionalString(req.FullName),
+		Email:       optionalString(req.Email),
+		PhoneNumber: optionalString(req.PhoneNumber),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerpb.UpdateCustomerResponse{Version: int32(version)}, nil
 }
 
-// RegisterCustomerResponse содержит идентификатор созданного клиента.
-type RegisterCustomerResponse struct {
-	Id string
+// GetCustomerAsOf возвращает состояние клиента на момент времени.
+func (t *Transport) GetCustomerAsOf(ctx context.Context, req *customerpb.GetCustomerAsOfRequest) (*customerpb.GetCustomerResponse, error) {
+	dto, err := t.getAsOfHandler.Handle(ctx, req.Id, fromTimestamp(req.AsOf))
+	if err != nil {
+		return nil, err
+	}
+
+	return toCustomerResponse(dto), nil
 }
 
-// GetCustomerRequest содержит ID клиента.
-type GetCustomerRequest struct {
-	Id string
+// GetCustomerHistory возвращает историю изменений клиента.
+func (t *Transport) GetCustomerHistory(ctx context.Context, req *customerpb.GetCustomerHistoryRequest) (*customerpb.GetCustomerHistoryResponse, error) {
+	history, err := t.historyHandler.Handle(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &customerpb.GetCustomerHistoryResponse{}
+	for _, event := range history {
+		resp.Events = append(resp.Events, &customerpb.CustomerEvent{
+			Version:    int32(event.Version),
+			Type:       event.Type,
+			Changes:    event.Changes,
+			OccurredAt: toTimestamp(event.OccurredAt),
+		})
+	}
+
+	return resp, nil
 }
 
-// GetCustomerResponse возвращает DTO клиента.
-type GetCustomerResponse struct {
-	Id          string
-	FullName    string
-	Email       string
-	PhoneNumber string
+// QueryAuditLog возвращает страницу журнала аудита.
+func (t *Transport) QueryAuditLog(ctx context.Context, req *customerpb.QueryAuditLogRequest) (*customerpb.QueryAuditLogResponse, error) {
+	page, err := t.auditHandler.Handle(ctx, appqueries.QueryAuditLog{
+		AggregateID: req.AggregateId.GetValue(),
+		ActorID:     req.ActorId.GetValue(),
+		Action:      req.Action.GetValue(),
+		From:        fromTimestamp(req.From),
+		To:          fromTimestamp(req.To),
+		PageSize:    int(req.PageSize),
+		PageToken:   req.PageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &customerpb.QueryAuditLogResponse{NextPageToken: page.NextPageToken}
+	for _, entry := range page.Entries {
+		changes := make(map[string]*customerpb.AuditChange, len(entry.Changes))
+		for field, change := range entry.Changes {
+			changes[field] = &customerpb.AuditChange{Before: toValue(change.Before), After: toValue(change.After)}
+		}
+
+		resp.Entries = append(resp.Entries, &customerpb.AuditEntry{
+			Id:            entry.ID,
+			ActorId:       entry.ActorID,
+			ActorRole:     entry.ActorRole,
+			Action:        entry.Action,
+			AggregateType: entry.AggregateType,
+			AggregateId:   entry.AggregateID,
+			Changes:       changes,
+			RequestId:     entry.RequestID,
+			TraceId:       entry.TraceID,
+			OccurredAt:    toTimestamp(entry.OccurredAt),
+		})
+	}
+
+	return resp, nil
 }
@@ -0,0 +1,110 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	customerpb "github.com/evgeniySeleznev/nwHS/services/customer-service/api/gen/customer/v1"
+	appqueries "github.com/evgeniySeleznev/nwHS/services/customer-service/internal/application/queries"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/models"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/valueobjects"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestTransportRegistersServiceWithReflection(t *testing.T) {
+	listener := bufconn.Listen(1 << 20)
+	transport := NewTransport(Handlers{}, zap.NewNop())
+	go func() { _ = transport.Serve(listener) }()
+	defer transport.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	if err != nil {
+		t.Fatalf("reflection stream: %v", err)
+	}
+	if err := stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("recv: %v", err)
+	}
+
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		if service.GetName() == "holo.customer.v1.CustomerService" {
+			return
+		}
+	}
+	t.Fatalf("expected customer service to be listed, got %v", resp.GetListServicesResponse().GetService())
+}
+
+type fakeHistoryReader struct {
+	customer *models.Customer
+}
+
+func (f fakeHistoryReader) LoadAsOf(context.Context, string, time.Time) (*models.Customer, error) {
+	return f.customer, nil
+}
+
+func (f fakeHistoryReader) History(context.Context, string) ([]events.Envelope, error) {
+	return f.customer.PendingEvents(), nil
+}
+
+func TestGetCustomerAsOfMapsBirthDate(t *testing.T) {
+	email, err := valueobjects.NewEmail("ann@example.com")
+	if err != nil {
+		t.Fatalf("email: %v", err)
+	}
+	phone, err := valueobjects.NewPhoneNumber("+15550000001")
+	if err != nil {
+		t.Fatalf("phone: %v", err)
+	}
+	birthDate := time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)
+	customer, err := models.NewCustomer("Ann Smith", email, phone, birthDate)
+	if err != nil {
+		t.Fatalf("new customer: %v", err)
+	}
+
+	transport := NewTransport(Handlers{
+		GetAsOf: appqueries.NewGetCustomerAsOfHandler(fakeHistoryReader{customer: customer}),
+	}, zap.NewNop())
+	resp, err := transport.GetCustomerAsOf(context.Background(), &customerpb.GetCustomerAsOfRequest{Id: customer.ID().String()})
+	if err != nil {
+		t.Fatalf("get customer as of: %v", err)
+	}
+	if got := resp.GetBirthDate().AsTime(); !got.Equal(birthDate) {
+		t.Fatalf("expected birth date %v, got %v", birthDate, got)
+	}
+}
+
+func TestHealthRPCsDisabledWithoutKeyring(t *testing.T) {
+	transport := NewTransport(Handlers{}, zap.NewNop())
+
+	_, err := transport.GetHealthStatus(context.Background(), &customerpb.GetHealthStatusRequest{CustomerId: "c", Code: "parq"})
+	if status.Code(toStatusError(err)) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+	_, err = transport.SubmitHealthQuestionnaire(context.Background(), &customerpb.SubmitHealthQuestionnaireRequest{CustomerId: "c", Code: "parq"})
+	if status.Code(toStatusError(err)) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+}
@@ -0,0 +1,225 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/application/commands"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/events"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// Заголовки, которые проставляет публикатор customer-service.
+const (
+	headerEventType = "event_type"
+	headerTenantID  = "tenant_id"
+)
+
+// timelineEvent описывает, как событие попадает в ленту: текст записи
+// и поля полезной нагрузки с идентификаторами затронутых клиентов.
+type timelineEvent struct {
+	summary string
+	fields  []string
+}
+
+var timelineEvents = map[events.Type]timelineEvent{
+	events.TypeCustomerRegistered:           {summary: "Customer registered", fields: []string{"CustomerID"}},
+	events.TypeCustomerEmailChanged:         {summary: "Email changed", fields: []string{"CustomerID"}},
+	events.TypeCustomerPhoneNumberChanged:   {summary: "Phone number changed", fields: []string{"CustomerID"}},
+	events.TypeCustomerFullNameChanged:      {summary: "Full name changed", fields: []string{"CustomerID"}},
+	events.TypeCustomersMerged:              {summary: "Duplicate profile merged", fields: []string{"SurvivorID"}},
+	events.TypeCustomerReferred:             {summary: "Referral attributed", fields: []string{"ReferrerID", "ReferredID"}},
+	events.TypeConsentChanged:               {summary: "Communication consent changed", fields: []string{"CustomerID"}},
+	events.TypeCustomerPreferencesUpdated:   {summary: "Communication preferences updated", fields: []string{"CustomerID"}},
+	events.TypeHealthQuestionnaireSubmitted: {summary: "Health questionnaire submitted", fields: []string{"CustomerID"}},
+	events.TypeHouseholdCreated:             {summary: "Household created", fields: []string{"PrimaryPayerID"}},
+	events.TypeHouseholdMemberAdded:         {summary: "Joined household", fields: []string{"CustomerID"}},
+	events.TypeHouseholdMemberRemoved:       {summary: "Left household", fields: []string{"CustomerID"}},
+	events.TypeHouseholdMemberTransferred:   {summary: "Transferred to another household", fields: []string{"CustomerID"}},
+}
+
+// MessageReader читает сообщения топика в составе группы потребителей.
+type MessageReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// DeadLetterSink сохраняет сообщения, которые не удалось обработать.
+type DeadLetterSink interface {
+	SaveEvent(ctx context.Context, eventType, key string, payload []byte, handleErr error) error
+}
+
+// errMalformedMessage помечает сообщения, повтор которых не поможет.
+var errMalformedMessage = errors.New("malformed message")
+
+// SystemEventRecorder добавляет системные записи в ленту клиента.
+type SystemEventRecorder interface {
+	RecordSystemEvent(ctx context.Context, cmd commands.RecordSystemEvent) error
+}
+
+// TimelineConsumer переносит доменные события из топика клиентов в ленты клиентов.
+type TimelineConsumer struct {
+	reader      MessageReader
+	recorder    SystemEventRecorder
+	deadLetters DeadLetterSink
+	logger      *zap.Logger
+	attempts    int
+	backoff     time.Duration
+}
+
+// NewTimelineConsumer создаёт потребителя. deadLetters может быть nil — тогда
+// необработанное сообщение только логируется.
+func NewTimelineConsumer(reader MessageReader, recorder SystemEventRecorder, deadLetters DeadLetterSink, logger *zap.Logger) *TimelineConsumer {
+	return &TimelineConsumer{
+		reader:      reader,
+		recorder:    recorder,
+		deadLetters: deadLetters,
+		logger:      logger,
+		attempts:    5,
+		backoff:     200 * time.Millisecond,
+	}
+}
+
+// WithRetry задаёт число попыток обработки и начальную паузу между ними.
+func (c *TimelineConsumer) WithRetry(attempts int, backoff time.Duration) *TimelineConsumer {
+	if attempts > 0 {
+		c.attempts = attempts
+	}
+	c.backoff = backoff
+	return c
+}
+
+// Run читает сообщения до отмены контекста. Временные ошибки повторяются с
+// растущей паузой; если попытки исчерпаны, сообщение уходит в DLQ и только после
+// этого подтверждается. Повторная доставка безопасна — запись о событии создаётся один раз.
+func (c *TimelineConsumer) Run(ctx context.Context) error {
+	for {
+		msg, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(ctx.Err(), context.Canceled) {
+				return nil
+			}
+			return fmt.Errorf("fetch message: %w", err)
+		}
+
+		if err := c.process(ctx, msg); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		if err := c.reader.CommitMessages(ctx, msg); err != nil {
+			return fmt.Errorf("commit message: %w", err)
+		}
+	}
+}
+
+// process обрабатывает сообщение с повторами. Ошибка означает, что сообщение
+// нельзя подтверждать: контекст отменён или DLQ недоступна.
+func (c *TimelineConsumer) process(ctx context.Context, msg kafka.Message) error {
+	err := c.Handle(ctx, msg)
+	delay := c.backoff
+	for attempt := 1; err != nil && attempt < c.attempts && !errors.Is(err, errMalformedMessage); attempt++ {
+		c.logger.Warn("failed to record timeline event, retrying",
+			zap.Error(err),
+			zap.Int("attempt", attempt),
+			zap.Int64("offset", msg.Offset),
+		)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		delay *= 2
+
+		err = c.Handle(ctx, msg)
+	}
+	if err == nil {
+		return nil
+	}
+
+	c.logger.Error("failed to record timeline event, moving to dead letters",
+		zap.Error(err),
+		zap.String("topic", msg.Topic),
+		zap.Int("partition", msg.Partition),
+		zap.Int64("offset", msg.Offset),
+	)
+	if c.deadLetters == nil {
+		return nil
+	}
+	if dlqErr := c.deadLetters.SaveEvent(ctx, headerValue(msg, headerEventType), string(msg.Key), msg.Value, err); dlqErr != nil {
+		return fmt.Errorf("dead-letter timeline message at offset %d: %w", msg.Offset, dlqErr)
+	}
+	return nil
+}
+
+// Close закрывает читателя.
+func (c *TimelineConsumer) Close() error {
+	return c.reader.Close()
+}
+
+// Handle превращает одно сообщение в системные записи лент затронутых клиентов.
+func (c *TimelineConsumer) Handle(ctx context.Context, msg kafka.Message) error {
+	eventType := events.Type(headerValue(msg, headerEventType))
+	spec, ok := timelineEvents[eventType]
+	if !ok {
+		return nil
+	}
+
+	id, err := tenant.Parse(headerValue(msg, headerTenantID))
+	if err != nil {
+		return fmt.Errorf("%w: tenant: %v", errMalformedMessage, err)
+	}
+	ctx = tenant.WithTenant(ctx, id)
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(msg.Value, &payload); err != nil {
+		return fmt.Errorf("%w: decode %s payload: %v", errMalformedMessage, eventType, err)
+	}
+
+	occurredAt := msg.Time
+	if raw, ok := payload["OccurredAt"].(string); ok {
+		if parsed, err := time.Parse(time.RFC3339Nano, raw); err == nil {
+			occurredAt = parsed
+		}
+	}
+
+	sourceID := fmt.Sprintf("%s/%d/%d", msg.Topic, msg.Partition, msg.Offset)
+	for _, field := range spec.fields {
+		customerID, _ := payload[field].(string)
+		if customerID == "" {
+			continue
+		}
+
+		err := c.recorder.RecordSystemEvent(ctx, commands.RecordSystemEvent{
+			CustomerID: customerID,
+			Summary:    spec.summary,
+			SourceID:   sourceID,
+			OccurredAt: occurredAt,
+		})
+		if err != nil {
+			return fmt.Errorf("record %s for customer %s: %w", eventType, customerID, err)
+		}
+	}
+
+	return nil
+}
+
+func headerValue(msg kafka.Message, key string) string {
+	for _, header := range msg.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,173 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/application/commands"
+	"github.com/evgeniySeleznev/nwHS/services/customer-service/internal/domain/tenant"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+type fakeRecorder struct {
+	commands []commands.RecordSystemEvent
+	tenants  []tenant.ID
+	failures int
+}
+
+func (f *fakeRecorder) RecordSystemEvent(ctx context.Context, cmd commands.RecordSystemEvent) error {
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("connection reset")
+	}
+	id, _ := tenant.FromContext(ctx)
+	f.tenants = append(f.tenants, id)
+	f.commands = append(f.commands, cmd)
+	return nil
+}
+
+func TestTimelineConsumerHandle(t *testing.T) {
+	recorder := &fakeRecorder{}
+	consumer := NewTimelineConsumer(nil, recorder, nil, zap.NewNop())
+
+	err := consumer.Handle(context.Background(), kafka.Message{
+		Topic:     "customers",
+		Partition: 2,
+		Offset:    42,
+		Value:     []byte(`{"ReferrerID":"a","ReferredID":"b","ReferralCode":"ABCD2345","OccurredAt":"2024-03-01T10:00:00Z"}`),
+		Headers: []kafka.Header{
+			{Key: headerEventType, Value: []byte("customer.referred")},
+			{Key: headerTenantID, Value: []byte("club-1")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(recorder.commands) != 2 {
+		t.Fatalf("expected entries for both customers, got %d", len(recorder.commands))
+	}
+	if recorder.commands[0].CustomerID != "a" || recorder.commands[1].CustomerID != "b" {
+		t.Fatalf("unexpected customers: %+v", recorder.commands)
+	}
+	if recorder.commands[0].SourceID != "customers/2/42" || recorder.commands[0].OccurredAt.Hour() != 10 {
+		t.Fatalf("unexpected source: %+v", recorder.commands[0])
+	}
+	if recorder.tenants[0] != "club-1" {
+		t.Fatalf("expected tenant from header, got %q", recorder.tenants[0])
+	}
+}
+
+func TestTimelineConsumerSkipsUnknownEvents(t *testing.T) {
+	recorder := &fakeRecorder{}
+	consumer := NewTimelineConsumer(nil, recorder, nil, zap.NewNop())
+
+	err := consumer.Handle(context.Background(), kafka.Message{
+		Value:   []byte(`{}`),
+		Headers: []kafka.Header{{Key: headerEventType, Value: []byte("billing.invoice_paid")}},
+	})
+	if err != nil || len(recorder.commands) != 0 {
+		t.Fatalf("expected unknown event to be skipped, got %v, %d", err, len(recorder.commands))
+	}
+}
+
+// fakeReader отдаёт сообщения по очереди, затем отменяет контекст Run.
+type fakeReader struct {
+	messages  []kafka.Message
+	committed []int64
+	cancel    context.CancelFunc
+}
+
+func (f *fakeReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	if len(f.messages) == 0 {
+		f.cancel()
+		return kafka.Message{}, context.Canceled
+	}
+	msg := f.messages[0]
+	f.messages = f.messages[1:]
+	return msg, nil
+}
+
+func (f *fakeReader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	for _, msg := range msgs {
+		f.committed = append(f.committed, msg.Offset)
+	}
+	return nil
+}
+
+func (f *fakeReader) Close() error { return nil }
+
+type fakeDeadLetters struct {
+	saved []string
+	err   error
+}
+
+func (f *fakeDeadLetters) SaveEvent(ctx context.Context, eventType, key string, payload []byte, handleErr error) error {
+	f.saved = append(f.saved, eventType)
+	return f.err
+}
+
+func registeredMessage(offset int64) kafka.Message {
+	return kafka.Message{
+		Offset: offset,
+		Value:  []byte(`{"CustomerID":"a"}`),
+		Headers: []kafka.Header{
+			{Key: headerEventType, Value: []byte("customer.registered")},
+			{Key: headerTenantID, Value: []byte("club-1")},
+		},
+	}
+}
+
+func TestTimelineConsumerRunRetriesAndDeadLetters(t *testing.T) {
+	cases := []struct {
+		name          string
+		failures      int
+		dlqErr        error
+		wantRecorded  int
+		wantDead      int
+		wantCommitted int
+		wantErr       bool
+	}{
+		{name: "transient failure is retried", failures: 2, wantRecorded: 1, wantCommitted: 1},
+		{name: "exhausted retries go to dlq", failures: 10, wantDead: 1, wantCommitted: 1},
+		{name: "dlq failure keeps offset", failures: 10, dlqErr: errors.New("mongo down"), wantDead: 1, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			reader := &fakeReader{messages: []kafka.Message{registeredMessage(7)}, cancel: cancel}
+			recorder := &fakeRecorder{failures: tc.failures}
+			deadLetters := &fakeDeadLetters{err: tc.dlqErr}
+			consumer := NewTimelineConsumer(reader, recorder, deadLetters, zap.NewNop()).WithRetry(3, 0)
+
+			err := consumer.Run(ctx)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected run error: %v", err)
+			}
+			if len(recorder.commands) != tc.wantRecorded || len(deadLetters.saved) != tc.wantDead || len(reader.committed) != tc.wantCommitted {
+				t.Fatalf("expected recorded=%d dead=%d committed=%d, got %d %d %d",
+					tc.wantRecorded, tc.wantDead, tc.wantCommitted, len(recorder.commands), len(deadLetters.saved), len(reader.committed))
+			}
+		})
+	}
+}
+
+func TestTimelineConsumerMalformedMessageSkipsRetries(t *testing.T) {
+	deadLetters := &fakeDeadLetters{}
+	consumer := NewTimelineConsumer(nil, &fakeRecorder{}, deadLetters, zap.NewNop()).WithRetry(3, time.Hour)
+
+	msg := registeredMessage(1)
+	msg.Value = []byte(`not json`)
+	if err := consumer.process(context.Background(), msg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deadLetters.saved) != 1 {
+		t.Fatalf("expected malformed message in dlq, got %v", deadLetters.saved)
+	}
+}
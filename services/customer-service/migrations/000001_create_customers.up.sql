@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS customers (
+    id           UUID PRIMARY KEY,
+    email        TEXT        NOT NULL UNIQUE,
+    full_name    TEXT        NOT NULL,
+    phone_number TEXT        NOT NULL,
+    birth_date   DATE        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL,
+    version      INTEGER     NOT NULL DEFAULT 1
+);
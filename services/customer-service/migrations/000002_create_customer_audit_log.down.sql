@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS customer_audit_log;
+DROP FUNCTION IF EXISTS customer_audit_log_immutable();
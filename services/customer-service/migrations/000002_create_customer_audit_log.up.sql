@@ -0,0 +1,33 @@
+-- Журнал аудита только на добавление: записи пишутся в той же транзакции,
+-- что и изменение агрегата, и никогда не изменяются и не удаляются.
+CREATE TABLE IF NOT EXISTS customer_audit_log (
+    seq            BIGSERIAL PRIMARY KEY,
+    id             UUID        NOT NULL UNIQUE,
+    actor_id       TEXT        NOT NULL,
+    actor_role     TEXT        NOT NULL DEFAULT '',
+    action         TEXT        NOT NULL,
+    aggregate_type TEXT        NOT NULL,
+    aggregate_id   TEXT        NOT NULL,
+    changes        JSONB       NOT NULL DEFAULT '{}'::jsonb,
+    request_id     TEXT        NOT NULL DEFAULT '',
+    trace_id       TEXT        NOT NULL DEFAULT '',
+    occurred_at    TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS customer_audit_log_aggregate_idx ON customer_audit_log (aggregate_id, seq);
+CREATE INDEX IF NOT EXISTS customer_audit_log_actor_idx ON customer_audit_log (actor_id, seq);
+CREATE INDEX IF NOT EXISTS customer_audit_log_occurred_at_idx ON customer_audit_log (occurred_at);
+
+CREATE OR REPLACE FUNCTION customer_audit_log_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'customer_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER customer_audit_log_no_update
+    BEFORE UPDATE OR DELETE ON customer_audit_log
+    FOR EACH ROW EXECUTE FUNCTION customer_audit_log_immutable();
+
+CREATE TRIGGER customer_audit_log_no_truncate
+    BEFORE TRUNCATE ON customer_audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION customer_audit_log_immutable();
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS customer_snapshots;
+DROP TABLE IF EXISTS customer_events;
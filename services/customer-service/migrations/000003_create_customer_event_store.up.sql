@@ -0,0 +1,37 @@
+-- Упорядоченный поток доменных событий по каждому агрегату клиента.
+CREATE TABLE IF NOT EXISTS customer_events (
+    aggregate_id UUID        NOT NULL,
+    version      INTEGER     NOT NULL,
+    event_type   TEXT        NOT NULL,
+    payload      JSONB       NOT NULL,
+    occurred_at  TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (aggregate_id, version)
+);
+
+CREATE INDEX IF NOT EXISTS customer_events_occurred_at_idx ON customer_events (aggregate_id, occurred_at);
+
+-- Периодические снимки ускоряют восстановление агрегата из длинного потока.
+CREATE TABLE IF NOT EXISTS customer_snapshots (
+    aggregate_id UUID        NOT NULL,
+    version      INTEGER     NOT NULL,
+    state        JSONB       NOT NULL,
+    occurred_at  TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (aggregate_id, version)
+);
+
+-- Клиенты, зарегистрированные до появления потока, получают стартовое событие с текущим состоянием.
+INSERT INTO customer_events (aggregate_id, version, event_type, payload, occurred_at)
+SELECT id,
+       version,
+       'customer.registered',
+       jsonb_build_object(
+           'CustomerID', id::text,
+           'Email', email,
+           'FullName', full_name,
+           'PhoneNumber', phone_number,
+           'BirthDate', to_char(birth_date, 'YYYY-MM-DD"T"00:00:00"Z"'),
+           'OccurredAt', to_char(updated_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"')
+       ),
+       updated_at
+FROM customers
+ON CONFLICT DO NOTHING;
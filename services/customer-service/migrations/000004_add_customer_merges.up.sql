@@ -0,0 +1,4 @@
+-- Слитые дубликаты остаются в таблице и перенаправляют чтение на основного клиента.
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS merged_into UUID NULL REFERENCES customers (id);
+
+CREATE INDEX IF NOT EXISTS customers_merged_into_idx ON customers (merged_into) WHERE merged_into IS NOT NULL;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS households (
+    id               UUID PRIMARY KEY,
+    name             TEXT        NOT NULL DEFAULT '',
+    primary_payer_id UUID        NOT NULL REFERENCES customers (id),
+    created_at       TIMESTAMPTZ NOT NULL,
+    updated_at       TIMESTAMPTZ NOT NULL,
+    version          INTEGER     NOT NULL DEFAULT 1
+);
+
+-- Клиент состоит не более чем в одном домохозяйстве.
+CREATE TABLE IF NOT EXISTS household_members (
+    household_id UUID        NOT NULL REFERENCES households (id) ON DELETE CASCADE,
+    customer_id  UUID        NOT NULL UNIQUE REFERENCES customers (id),
+    guardian_id  UUID        NULL REFERENCES customers (id),
+    minor        BOOLEAN     NOT NULL DEFAULT FALSE,
+    joined_at    TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (household_id, customer_id)
+);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS customer_consents;
+
+ALTER TABLE customers
+    DROP COLUMN IF EXISTS language,
+    DROP COLUMN IF EXISTS timezone,
+    DROP COLUMN IF EXISTS preferred_channel;
@@ -0,0 +1,17 @@
+ALTER TABLE customers
+    ADD COLUMN IF NOT EXISTS language          TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS timezone          TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS preferred_channel TEXT NOT NULL DEFAULT '';
+
+-- Текущее согласие клиента по каждому каналу; история изменений хранится в customer_events.
+CREATE TABLE IF NOT EXISTS customer_consents (
+    customer_id        UUID        NOT NULL REFERENCES customers (id),
+    channel            TEXT        NOT NULL,
+    granted            BOOLEAN     NOT NULL,
+    source             TEXT        NOT NULL,
+    legal_text_version TEXT        NOT NULL DEFAULT '',
+    changed_at         TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (customer_id, channel)
+);
+
+CREATE INDEX IF NOT EXISTS customer_consents_contactable_idx ON customer_consents (channel, customer_id) WHERE granted;
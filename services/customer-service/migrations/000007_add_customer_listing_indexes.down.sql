@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS customers_birth_month_idx;
+DROP INDEX IF EXISTS customers_full_name_id_idx;
+DROP INDEX IF EXISTS customers_created_at_id_idx;
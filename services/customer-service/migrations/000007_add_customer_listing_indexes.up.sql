@@ -0,0 +1,4 @@
+-- Индексы под keyset-пагинацию списка клиентов и фильтр по месяцу рождения.
+CREATE INDEX IF NOT EXISTS customers_created_at_id_idx ON customers (created_at, id);
+CREATE INDEX IF NOT EXISTS customers_full_name_id_idx ON customers (full_name, id);
+CREATE INDEX IF NOT EXISTS customers_birth_month_idx ON customers ((EXTRACT(MONTH FROM birth_date)));
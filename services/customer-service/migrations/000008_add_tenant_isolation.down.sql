@@ -0,0 +1,32 @@
+DROP POLICY IF EXISTS tenant_isolation ON household_members;
+DROP POLICY IF EXISTS tenant_isolation ON households;
+DROP POLICY IF EXISTS tenant_isolation ON customer_consents;
+DROP POLICY IF EXISTS tenant_isolation ON customer_audit_log;
+DROP POLICY IF EXISTS tenant_isolation ON customer_snapshots;
+DROP POLICY IF EXISTS tenant_isolation ON customer_events;
+DROP POLICY IF EXISTS tenant_isolation ON customers;
+
+ALTER TABLE household_members  DISABLE ROW LEVEL SECURITY;
+ALTER TABLE households         DISABLE ROW LEVEL SECURITY;
+ALTER TABLE customer_consents  DISABLE ROW LEVEL SECURITY;
+ALTER TABLE customer_audit_log DISABLE ROW LEVEL SECURITY;
+ALTER TABLE customer_snapshots DISABLE ROW LEVEL SECURITY;
+ALTER TABLE customer_events    DISABLE ROW LEVEL SECURITY;
+ALTER TABLE customers          DISABLE ROW LEVEL SECURITY;
+
+DROP INDEX IF EXISTS customer_audit_log_tenant_idx;
+DROP INDEX IF EXISTS customers_tenant_full_name_id_idx;
+DROP INDEX IF EXISTS customers_tenant_created_at_id_idx;
+CREATE INDEX IF NOT EXISTS customers_created_at_id_idx ON customers (created_at, id);
+CREATE INDEX IF NOT EXISTS customers_full_name_id_idx ON customers (full_name, id);
+
+DROP INDEX IF EXISTS customers_tenant_email_idx;
+ALTER TABLE customers ADD CONSTRAINT customers_email_key UNIQUE (email);
+
+ALTER TABLE household_members  DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE households         DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE customer_consents  DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE customer_audit_log DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE customer_snapshots DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE customer_events    DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE customers          DROP COLUMN IF EXISTS tenant_id;
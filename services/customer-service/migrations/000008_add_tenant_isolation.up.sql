@@ -0,0 +1,66 @@
+-- Разделение данных по арендаторам (брендам). Существующие записи относятся к арендатору default.
+ALTER TABLE customers          ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE customer_events    ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE customer_snapshots ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE customer_audit_log ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE customer_consents  ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE households         ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE household_members  ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+
+ALTER TABLE customers          ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE customer_events    ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE customer_snapshots ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE customer_audit_log ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE customer_consents  ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE households         ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE household_members  ALTER COLUMN tenant_id DROP DEFAULT;
+
+-- Email уникален в пределах арендатора.
+ALTER TABLE customers DROP CONSTRAINT IF EXISTS customers_email_key;
+CREATE UNIQUE INDEX IF NOT EXISTS customers_tenant_email_idx ON customers (tenant_id, email);
+
+DROP INDEX IF EXISTS customers_created_at_id_idx;
+DROP INDEX IF EXISTS customers_full_name_id_idx;
+CREATE INDEX IF NOT EXISTS customers_tenant_created_at_id_idx ON customers (tenant_id, created_at, id);
+CREATE INDEX IF NOT EXISTS customers_tenant_full_name_id_idx ON customers (tenant_id, full_name, id);
+CREATE INDEX IF NOT EXISTS customer_audit_log_tenant_idx ON customer_audit_log (tenant_id, seq);
+
+-- Row-level security — страховка на случай запроса без фильтра по арендатору.
+-- Приложение выставляет app.tenant_id при выдаче соединения из пула.
+ALTER TABLE customers          ENABLE ROW LEVEL SECURITY;
+ALTER TABLE customer_events    ENABLE ROW LEVEL SECURITY;
+ALTER TABLE customer_snapshots ENABLE ROW LEVEL SECURITY;
+ALTER TABLE customer_audit_log ENABLE ROW LEVEL SECURITY;
+ALTER TABLE customer_consents  ENABLE ROW LEVEL SECURITY;
+ALTER TABLE households         ENABLE ROW LEVEL SECURITY;
+ALTER TABLE household_members  ENABLE ROW LEVEL SECURITY;
+
+ALTER TABLE customers          FORCE ROW LEVEL SECURITY;
+ALTER TABLE customer_events    FORCE ROW LEVEL SECURITY;
+ALTER TABLE customer_snapshots FORCE ROW LEVEL SECURITY;
+ALTER TABLE customer_audit_log FORCE ROW LEVEL SECURITY;
+ALTER TABLE customer_consents  FORCE ROW LEVEL SECURITY;
+ALTER TABLE households         FORCE ROW LEVEL SECURITY;
+ALTER TABLE household_members  FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY tenant_isolation ON customers
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+CREATE POLICY tenant_isolation ON customer_events
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+CREATE POLICY tenant_isolation ON customer_snapshots
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+CREATE POLICY tenant_isolation ON customer_audit_log
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+CREATE POLICY tenant_isolation ON customer_consents
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+CREATE POLICY tenant_isolation ON households
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+CREATE POLICY tenant_isolation ON household_members
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
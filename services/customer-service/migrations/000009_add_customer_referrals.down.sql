@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS customer_referrals;
+DROP INDEX IF EXISTS customers_tenant_referral_code_idx;
+ALTER TABLE customers DROP COLUMN IF EXISTS referral_code;
@@ -0,0 +1,52 @@
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS referral_code TEXT NULL;
+
+-- Индекс создаётся до заполнения: NULL не конфликтуют, а совпавший код ловится при вставке.
+CREATE UNIQUE INDEX IF NOT EXISTS customers_tenant_referral_code_idx ON customers (tenant_id, referral_code);
+
+-- Существующим клиентам выдаются коды в алфавите Crockford base32, как у valueobjects.NewReferralCode;
+-- при совпадении код генерируется заново. RLS временно ослабляется, чтобы миграция видела
+-- строки всех арендаторов.
+ALTER TABLE customers NO FORCE ROW LEVEL SECURITY;
+DO $$
+DECLARE
+    alphabet CONSTANT TEXT := '0123456789ABCDEFGHJKMNPQRSTVWXYZ';
+    customer_id UUID;
+    code TEXT;
+BEGIN
+    FOR customer_id IN SELECT id FROM customers WHERE referral_code IS NULL LOOP
+        LOOP
+            code := '';
+            FOR i IN 1..8 LOOP
+                code := code || substr(alphabet, 1 + floor(random() * 32)::int, 1);
+            END LOOP;
+            BEGIN
+                UPDATE customers SET referral_code = code WHERE id = customer_id;
+                EXIT;
+            EXCEPTION WHEN unique_violation THEN
+                -- код занят в арендаторе, пробуем следующий
+            END;
+        END LOOP;
+    END LOOP;
+END
+$$;
+ALTER TABLE customers FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE customers ALTER COLUMN referral_code SET NOT NULL;
+
+-- Атрибуция: клиент приходит не более чем по одному приглашению.
+CREATE TABLE IF NOT EXISTS customer_referrals (
+    referred_id   UUID        PRIMARY KEY REFERENCES customers (id),
+    referrer_id   UUID        NOT NULL REFERENCES customers (id),
+    referral_code TEXT        NOT NULL,
+    referred_at   TIMESTAMPTZ NOT NULL,
+    tenant_id     TEXT        NOT NULL,
+    CHECK (referred_id <> referrer_id)
+);
+
+CREATE INDEX IF NOT EXISTS customer_referrals_referrer_idx ON customer_referrals (tenant_id, referrer_id, referred_at, referred_id);
+
+ALTER TABLE customer_referrals ENABLE ROW LEVEL SECURITY;
+ALTER TABLE customer_referrals FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON customer_referrals
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS customer_timeline (
+    id          UUID        PRIMARY KEY,
+    customer_id UUID        NOT NULL REFERENCES customers (id),
+    type        TEXT        NOT NULL,
+    body        TEXT        NOT NULL,
+    author_id   TEXT        NOT NULL,
+    author_role TEXT        NOT NULL DEFAULT '',
+    visibility  TEXT        NOT NULL DEFAULT 'staff',
+    pinned      BOOLEAN     NOT NULL DEFAULT false,
+    source_id   TEXT        NULL,
+    created_at  TIMESTAMPTZ NOT NULL,
+    updated_at  TIMESTAMPTZ NOT NULL,
+    deleted_at  TIMESTAMPTZ NULL,
+    tenant_id   TEXT        NOT NULL
+);
+
+-- Лента читается страницами: закреплённые записи сверху, затем от новых к старым.
+CREATE INDEX IF NOT EXISTS customer_timeline_page_idx
+    ON customer_timeline (tenant_id, customer_id, pinned DESC, created_at DESC, id DESC)
+    WHERE deleted_at IS NULL;
+
+-- Системная запись создаётся не более одного раза на событие и клиента.
+CREATE UNIQUE INDEX IF NOT EXISTS customer_timeline_source_idx
+    ON customer_timeline (tenant_id, customer_id, source_id)
+    WHERE source_id IS NOT NULL;
+
+ALTER TABLE customer_timeline ENABLE ROW LEVEL SECURITY;
+ALTER TABLE customer_timeline FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON customer_timeline
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
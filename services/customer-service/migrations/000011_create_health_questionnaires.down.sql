@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS health_submissions;
+DROP TABLE IF EXISTS questionnaire_definitions;
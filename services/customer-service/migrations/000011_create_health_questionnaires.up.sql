@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS questionnaire_definitions (
+    id                   UUID        PRIMARY KEY,
+    code                 TEXT        NOT NULL,
+    version              INT         NOT NULL,
+    title                TEXT        NOT NULL DEFAULT '',
+    questions            JSONB       NOT NULL,
+    valid_for_seconds    BIGINT      NOT NULL,
+    min_accepted_version INT         NOT NULL,
+    published_at         TIMESTAMPTZ NOT NULL,
+    tenant_id            TEXT        NOT NULL,
+    UNIQUE (tenant_id, code, version)
+);
+
+-- Ответы и подпись хранятся только в зашифрованном виде (payload); флаги
+-- остаются открытыми, чтобы статус допуска читался без доступа к ключам.
+CREATE TABLE IF NOT EXISTS health_submissions (
+    id            UUID        PRIMARY KEY,
+    customer_id   UUID        NOT NULL REFERENCES customers (id),
+    definition_id UUID        NOT NULL REFERENCES questionnaire_definitions (id),
+    code          TEXT        NOT NULL,
+    version       INT         NOT NULL,
+    payload       BYTEA       NOT NULL,
+    flags         TEXT[]      NOT NULL DEFAULT '{}',
+    submitted_at  TIMESTAMPTZ NOT NULL,
+    expires_at    TIMESTAMPTZ NOT NULL,
+    tenant_id     TEXT        NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS health_submissions_customer_idx
+    ON health_submissions (tenant_id, customer_id, code, submitted_at DESC);
+
+ALTER TABLE questionnaire_definitions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE questionnaire_definitions FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON questionnaire_definitions
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE health_submissions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE health_submissions FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON health_submissions
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
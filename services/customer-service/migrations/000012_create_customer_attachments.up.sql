@@ -0,0 +1,23 @@
+-- Метаданные вложений; содержимое хранится в blob-хранилище по storage_key.
+CREATE TABLE IF NOT EXISTS customer_attachments (
+    id           UUID        PRIMARY KEY,
+    customer_id  UUID        NOT NULL REFERENCES customers (id),
+    kind         TEXT        NOT NULL,
+    file_name    TEXT        NOT NULL,
+    content_type TEXT        NOT NULL,
+    size_bytes   BIGINT      NOT NULL,
+    sha256       TEXT        NOT NULL,
+    storage_key  TEXT        NOT NULL,
+    uploaded_by  TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    tenant_id    TEXT        NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS customer_attachments_customer_idx
+    ON customer_attachments (tenant_id, customer_id, created_at DESC);
+
+ALTER TABLE customer_attachments ENABLE ROW LEVEL SECURITY;
+ALTER TABLE customer_attachments FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON customer_attachments
+    USING (tenant_id = current_setting('app.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
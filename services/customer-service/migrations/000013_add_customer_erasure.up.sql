@@ -0,0 +1,3 @@
+-- Строка удалённого клиента остаётся ради внешних ключей и ссылок слияния,
+-- но персональные данные в ней обезличены.
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS erased_at TIMESTAMPTZ NULL;
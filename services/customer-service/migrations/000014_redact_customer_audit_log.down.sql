@@ -0,0 +1,2 @@
+-- Обезличенные значения восстановить нельзя.
+SELECT 1;
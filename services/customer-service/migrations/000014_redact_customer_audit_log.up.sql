@@ -0,0 +1,22 @@
+-- Персональные данные клиента больше не пишутся в журнал аудита: после удаления
+-- данных клиента они остались бы в нём навсегда. Уже записанные значения
+-- заменяются маркером; для этого защита журнала на время снимается.
+ALTER TABLE customer_audit_log NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE customer_audit_log DISABLE TRIGGER customer_audit_log_no_update;
+
+UPDATE customer_audit_log AS log
+SET changes = (
+    SELECT jsonb_object_agg(
+        field,
+        CASE WHEN field IN ('email', 'full_name', 'phone_number', 'birth_date')
+            THEN jsonb_build_object(
+                'before', CASE WHEN change->'before' = 'null'::jsonb THEN 'null'::jsonb ELSE '"[redacted]"'::jsonb END,
+                'after',  CASE WHEN change->'after'  = 'null'::jsonb THEN 'null'::jsonb ELSE '"[redacted]"'::jsonb END)
+            ELSE change
+        END)
+    FROM jsonb_each(log.changes) AS entry(field, change)
+)
+WHERE log.changes ?| ARRAY['email', 'full_name', 'phone_number', 'birth_date'];
+
+ALTER TABLE customer_audit_log ENABLE TRIGGER customer_audit_log_no_update;
+ALTER TABLE customer_audit_log FORCE ROW LEVEL SECURITY;